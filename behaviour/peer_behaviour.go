@@ -47,3 +47,42 @@ type blockPart struct {
 func BlockPart(peerID p2p.ID, explanation string) PeerBehaviour {
 	return PeerBehaviour{peerID: peerID, reason: blockPart{explanation}}
 }
+
+// PeerID returns the ID of the peer which behaved this way.
+func (pb PeerBehaviour) PeerID() p2p.ID {
+	return pb.peerID
+}
+
+// Kind returns the kind of the behaviour (one of the p2p.Behaviour*
+// constants), which determines the policy applied by the Switch. It returns an
+// empty string for unknown behaviours.
+func (pb PeerBehaviour) Kind() string {
+	switch pb.reason.(type) {
+	case badMessage:
+		return p2p.BehaviourBadMessage
+	case messageOutOfOrder:
+		return p2p.BehaviourMessageOutOfOrder
+	case consensusVote:
+		return p2p.BehaviourConsensusVote
+	case blockPart:
+		return p2p.BehaviourBlockPart
+	default:
+		return ""
+	}
+}
+
+// Explanation returns the explanation given by the reactor.
+func (pb PeerBehaviour) Explanation() string {
+	switch reason := pb.reason.(type) {
+	case badMessage:
+		return reason.explanation
+	case messageOutOfOrder:
+		return reason.explanation
+	case consensusVote:
+		return reason.explanation
+	case blockPart:
+		return reason.explanation
+	default:
+		return ""
+	}
+}
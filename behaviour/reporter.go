@@ -13,39 +13,52 @@ type Reporter interface {
 	Report(behaviour PeerBehaviour) error
 }
 
-// SwitchReporter reports peer behaviour to an internal Switch.
+// SwitchReporter reports peer behaviour to an internal Switch, which applies
+// its policies (see p2p.SwitchBehaviourPolicies) to the peer.
 type SwitchReporter struct {
 	sw *p2p.Switch
 }
 
 // NewSwitchReporter return a new SwitchReporter instance which wraps the Switch.
-func NewSwitcReporter(sw *p2p.Switch) *SwitchReporter {
+func NewSwitchReporter(sw *p2p.Switch) *SwitchReporter {
 	return &SwitchReporter{
 		sw: sw,
 	}
 }
 
+// NewSwitcReporter return a new SwitchReporter instance which wraps the Switch.
+//
+// Deprecated: use NewSwitchReporter.
+func NewSwitcReporter(sw *p2p.Switch) *SwitchReporter {
+	return NewSwitchReporter(sw)
+}
+
 // Report reports the behaviour of a peer to the Switch.
 func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
+	kind := behaviour.Kind()
+	if kind == "" {
+		return errors.New("unknown reason reported")
+	}
+
 	peer := spbr.sw.Peers().Get(behaviour.peerID)
 	if peer == nil {
 		return errors.New("peer not found")
 	}
 
-	switch reason := behaviour.reason.(type) {
-	case consensusVote, blockPart:
-		spbr.sw.MarkPeerAsGood(peer)
-	case badMessage:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
-	case messageOutOfOrder:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
-	default:
-		return errors.New("unknown reason reported")
-	}
+	spbr.sw.ReportPeerBehaviour(peer, kind, behaviour.Explanation())
 
 	return nil
 }
 
+// ReportOrStop reports the behaviour b of peer, and stops the peer for reason
+// if the behaviour can't be reported. Peers the switch already removed, e.g.
+// because of its policy for b, aren't stopped again.
+func ReportOrStop(reporter Reporter, sw *p2p.Switch, peer p2p.Peer, b PeerBehaviour, reason interface{}) {
+	if err := reporter.Report(b); err != nil && sw.Peers().Get(peer.ID()) == peer {
+		sw.StopPeerForError(peer, reason)
+	}
+}
+
 // MockReporter is a concrete implementation of the Reporter
 // interface used in reactor tests to ensure reactors report the correct
 // behaviour in manufactured scenarios.
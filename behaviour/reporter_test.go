@@ -1,11 +1,14 @@
 package behaviour_test
 
 import (
+	"errors"
 	"sync"
 	"testing"
 
 	bh "github.com/tendermint/tendermint/behaviour"
+	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/mock"
 )
 
 // TestMockReporter tests the MockReporter's ability to store reported
@@ -199,3 +202,60 @@ func TestMockPeerBehaviourReporterConcurrency(t *testing.T) {
 		}
 	}
 }
+
+// TestPeerBehaviourKind tests that behaviours are mapped to the kinds the
+// Switch has policies for.
+func TestPeerBehaviourKind(t *testing.T) {
+	var peerID p2p.ID = "MockPeer"
+	cases := []struct {
+		behaviour bh.PeerBehaviour
+		kind      string
+	}{
+		{bh.BadMessage(peerID, "bad"), p2p.BehaviourBadMessage},
+		{bh.MessageOutOfOrder(peerID, "bad"), p2p.BehaviourMessageOutOfOrder},
+		{bh.ConsensusVote(peerID, "bad"), p2p.BehaviourConsensusVote},
+		{bh.BlockPart(peerID, "bad"), p2p.BehaviourBlockPart},
+	}
+
+	for _, c := range cases {
+		if c.behaviour.Kind() != c.kind {
+			t.Errorf("Expected kind %s, got %s", c.kind, c.behaviour.Kind())
+		}
+		if c.behaviour.PeerID() != peerID || c.behaviour.Explanation() != "bad" {
+			t.Errorf("Expected %v to keep the peer ID and explanation", c.behaviour)
+		}
+	}
+}
+
+type errorReporter struct{}
+
+func (errorReporter) Report(bh.PeerBehaviour) error {
+	return errors.New("can't report")
+}
+
+// TestReportOrStop tests that peers are stopped only if their behaviour can't
+// be reported.
+func TestReportOrStop(t *testing.T) {
+	sw := p2p.MakeSwitch(config.DefaultP2PConfig(), 1, "testing", "123.123.123",
+		func(_ int, sw *p2p.Switch) *p2p.Switch { return sw })
+	peer := mock.NewPeer(nil)
+	p2p.AddPeerToSwitchPeerSet(sw, peer)
+	badMessage := bh.BadMessage(peer.ID(), "bad message")
+
+	bh.ReportOrStop(bh.NewMockReporter(), sw, peer, badMessage, "bad message")
+	if !sw.Peers().Has(peer.ID()) || !peer.IsRunning() {
+		t.Error("Expected the peer to be kept when its behaviour is reported")
+	}
+
+	bh.ReportOrStop(errorReporter{}, sw, peer, badMessage, "bad message")
+	if sw.Peers().Has(peer.ID()) || peer.IsRunning() {
+		t.Error("Expected the peer to be stopped when its behaviour can't be reported")
+	}
+
+	// a peer which is no longer in the switch is left alone
+	other := mock.NewPeer(nil)
+	bh.ReportOrStop(errorReporter{}, sw, other, bh.BadMessage(other.ID(), "bad message"), "bad message")
+	if !other.IsRunning() {
+		t.Error("Expected a peer which isn't in the switch not to be stopped")
+	}
+}
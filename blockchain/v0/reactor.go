@@ -8,6 +8,8 @@ import (
 
 	amino "github.com/tendermint/go-amino"
 
+	"github.com/tendermint/tendermint/behaviour"
+
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
 	sm "github.com/tendermint/tendermint/state"
@@ -67,6 +69,8 @@ type BlockchainReactor struct {
 
 	requestsCh <-chan BlockRequest
 	errorsCh   <-chan peerError
+
+	reporter behaviour.Reporter
 }
 
 // NewBlockchainReactor returns new reactor instance.
@@ -108,6 +112,12 @@ func (bcR *BlockchainReactor) SetLogger(l log.Logger) {
 	bcR.pool.Logger = l
 }
 
+// SetSwitch implements Reactor by setting the switch on reactor and reporter.
+func (bcR *BlockchainReactor) SetSwitch(sw *p2p.Switch) {
+	bcR.BaseReactor.SetSwitch(sw)
+	bcR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // OnStart implements service.Service.
 func (bcR *BlockchainReactor) OnStart() error {
 	if bcR.fastSync {
@@ -120,6 +130,23 @@ func (bcR *BlockchainReactor) OnStart() error {
 	return nil
 }
 
+// SwitchToFastSync is called by the state sync reactor when switching to fast sync.
+func (bcR *BlockchainReactor) SwitchToFastSync(state sm.State) error {
+	bcR.fastSync = true
+	bcR.initialState = state
+
+	bcR.pool.mtx.Lock()
+	bcR.pool.height = state.LastBlockHeight + 1
+	bcR.pool.mtx.Unlock()
+
+	err := bcR.pool.Start()
+	if err != nil {
+		return err
+	}
+	go bcR.poolRoutine()
+	return nil
+}
+
 // OnStop implements service.Service.
 func (bcR *BlockchainReactor) OnStop() {
 	bcR.pool.Stop()
@@ -140,12 +167,15 @@ func (bcR *BlockchainReactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // AddPeer implements Reactor by sending our state to peer.
 func (bcR *BlockchainReactor) AddPeer(peer p2p.Peer) {
-	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{bcR.store.Height()})
+	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{
+		Base:   bcR.store.Base(),
+		Height: bcR.store.Height(),
+	})
 	peer.Send(BlockchainChannel, msgBytes)
 	// it's OK if send fails. will try later in poolRoutine
 
 	// peer is added to the pool once we receive the first
-	// bcStatusResponseMessage from the peer and call pool.SetPeerHeight
+	// bcStatusResponseMessage from the peer and call pool.SetPeerRange
 }
 
 // RemovePeer implements Reactor by removing peer from the pool.
@@ -177,13 +207,13 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		bcR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		bcR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(bcR.reporter, bcR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		bcR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(bcR.reporter, bcR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 
@@ -196,11 +226,14 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 		bcR.pool.AddBlock(src.ID(), msg.Block, len(msgBytes))
 	case *bcStatusRequestMessage:
 		// Send peer our state.
-		msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{bcR.store.Height()})
+		msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{
+			Base:   bcR.store.Base(),
+			Height: bcR.store.Height(),
+		})
 		src.TrySend(BlockchainChannel, msgBytes)
 	case *bcStatusResponseMessage:
 		// Got a peer status. Unverified.
-		bcR.pool.SetPeerHeight(src.ID(), msg.Height)
+		bcR.pool.SetPeerRange(src.ID(), msg.Base, msg.Height)
 	default:
 		bcR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 	}
@@ -242,6 +275,8 @@ func (bcR *BlockchainReactor) poolRoutine() {
 					bcR.Logger.Debug("Send queue is full, drop block request", "peer", peer.ID(), "height", request.Height)
 				}
 			case err := <-bcR.errorsCh:
+				// timeouts and slow peers are not misbehaviour, so they
+				// aren't reported
 				peer := bcR.Switch.Peers().Get(err.peerID)
 				if peer != nil {
 					bcR.Switch.StopPeerForError(peer, err)
@@ -314,19 +349,14 @@ FOR_LOOP:
 				chainID, firstID, first.Height, second.LastCommit)
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
+				// NOTE: we've already removed the peers' requests, but we
+				// still need to clean up the rest.
+				explanation := fmt.Sprintf("blockchainReactor validation error: %v", err)
 				peerID := bcR.pool.RedoRequest(first.Height)
-				peer := bcR.Switch.Peers().Get(peerID)
-				if peer != nil {
-					// NOTE: we've already removed the peer's request, but we
-					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer, fmt.Errorf("blockchainReactor validation error: %v", err))
-				}
+				bcR.reportBadBlock(peerID, explanation)
 				peerID2 := bcR.pool.RedoRequest(second.Height)
-				peer2 := bcR.Switch.Peers().Get(peerID2)
-				if peer2 != nil && peer2 != peer {
-					// NOTE: we've already removed the peer's request, but we
-					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer2, fmt.Errorf("blockchainReactor validation error: %v", err))
+				if peerID2 != peerID {
+					bcR.reportBadBlock(peerID2, explanation)
 				}
 				continue FOR_LOOP
 			} else {
@@ -338,7 +368,7 @@ FOR_LOOP:
 				// TODO: same thing for app - but we would need a way to
 				// get the hash without persisting the state
 				var err error
-				state, err = bcR.blockExec.ApplyBlock(state, firstID, first)
+				state, _, err = bcR.blockExec.ApplyBlock(state, firstID, first)
 				if err != nil {
 					// TODO This is bad, are we zombie?
 					panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
@@ -362,11 +392,22 @@ FOR_LOOP:
 
 // BroadcastStatusRequest broadcasts `BlockStore` height.
 func (bcR *BlockchainReactor) BroadcastStatusRequest() error {
-	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusRequestMessage{bcR.store.Height()})
+	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusRequestMessage{
+		Base:   bcR.store.Base(),
+		Height: bcR.store.Height(),
+	})
 	bcR.Switch.Broadcast(BlockchainChannel, msgBytes)
 	return nil
 }
 
+// reportBadBlock reports that the peer with the given ID sent an invalid block,
+// stopping the peer if the report fails.
+func (bcR *BlockchainReactor) reportBadBlock(peerID p2p.ID, explanation string) {
+	if peer := bcR.Switch.Peers().Get(peerID); peer != nil {
+		behaviour.ReportOrStop(bcR.reporter, bcR.Switch, peer, behaviour.BadMessage(peerID, explanation), explanation)
+	}
+}
+
 //-----------------------------------------------------------------------------
 // Messages
 
@@ -446,34 +487,48 @@ func (m *bcBlockResponseMessage) String() string {
 
 type bcStatusRequestMessage struct {
 	Height int64
+	Base   int64
 }
 
 // ValidateBasic performs basic validation.
 func (m *bcStatusRequestMessage) ValidateBasic() error {
+	if m.Base < 0 {
+		return errors.New("negative Base")
+	}
 	if m.Height < 0 {
 		return errors.New("negative Height")
 	}
+	if m.Base > m.Height {
+		return fmt.Errorf("base %v cannot be greater than height %v", m.Base, m.Height)
+	}
 	return nil
 }
 
 func (m *bcStatusRequestMessage) String() string {
-	return fmt.Sprintf("[bcStatusRequestMessage %v]", m.Height)
+	return fmt.Sprintf("[bcStatusRequestMessage %v:%v]", m.Base, m.Height)
 }
 
 //-------------------------------------
 
 type bcStatusResponseMessage struct {
 	Height int64
+	Base   int64
 }
 
 // ValidateBasic performs basic validation.
 func (m *bcStatusResponseMessage) ValidateBasic() error {
+	if m.Base < 0 {
+		return errors.New("negative Base")
+	}
 	if m.Height < 0 {
 		return errors.New("negative Height")
 	}
+	if m.Base > m.Height {
+		return fmt.Errorf("base %v cannot be greater than height %v", m.Base, m.Height)
+	}
 	return nil
 }
 
 func (m *bcStatusResponseMessage) String() string {
-	return fmt.Sprintf("[bcStatusResponseMessage %v]", m.Height)
+	return fmt.Sprintf("[bcStatusResponseMessage %v:%v]", m.Base, m.Height)
 }
@@ -13,6 +13,7 @@ import (
 
 type testPeer struct {
 	id     p2p.ID
+	base   int64
 	height int64
 }
 
@@ -70,7 +71,7 @@ func makeBlockPool(bcr *testBcR, height int64, peers []BpPeer, blocks map[int64]
 		if p.Height > maxH {
 			maxH = p.Height
 		}
-		bPool.peers[p.ID] = NewBpPeer(p.ID, p.Height, bcr.sendPeerError, nil)
+		bPool.peers[p.ID] = NewBpPeer(p.ID, p.Base, p.Height, bcr.sendPeerError, nil)
 		bPool.peers[p.ID].SetLogger(bcr.logger)
 
 	}
@@ -123,26 +124,26 @@ func TestBlockPoolUpdatePeer(t *testing.T) {
 		{
 			name:       "add a first short peer",
 			pool:       makeBlockPool(testBcR, 100, []BpPeer{}, map[int64]tPBlocks{}),
-			args:       testPeer{"P1", 50},
+			args:       testPeer{"P1", 0, 50},
 			errWanted:  errPeerTooShort,
 			poolWanted: makeBlockPool(testBcR, 100, []BpPeer{}, map[int64]tPBlocks{}),
 		},
 		{
 			name:       "add a first good peer",
 			pool:       makeBlockPool(testBcR, 100, []BpPeer{}, map[int64]tPBlocks{}),
-			args:       testPeer{"P1", 101},
+			args:       testPeer{"P1", 0, 101},
 			poolWanted: makeBlockPool(testBcR, 100, []BpPeer{{ID: "P1", Height: 101}}, map[int64]tPBlocks{}),
 		},
 		{
 			name:       "increase the height of P1 from 120 to 123",
 			pool:       makeBlockPool(testBcR, 100, []BpPeer{{ID: "P1", Height: 120}}, map[int64]tPBlocks{}),
-			args:       testPeer{"P1", 123},
+			args:       testPeer{"P1", 0, 123},
 			poolWanted: makeBlockPool(testBcR, 100, []BpPeer{{ID: "P1", Height: 123}}, map[int64]tPBlocks{}),
 		},
 		{
 			name:       "decrease the height of P1 from 120 to 110",
 			pool:       makeBlockPool(testBcR, 100, []BpPeer{{ID: "P1", Height: 120}}, map[int64]tPBlocks{}),
-			args:       testPeer{"P1", 110},
+			args:       testPeer{"P1", 0, 110},
 			errWanted:  errPeerLowersItsHeight,
 			poolWanted: makeBlockPool(testBcR, 100, []BpPeer{}, map[int64]tPBlocks{}),
 		},
@@ -151,7 +152,7 @@ func TestBlockPoolUpdatePeer(t *testing.T) {
 			pool: makeBlockPool(testBcR, 100, []BpPeer{{ID: "P1", Height: 105}},
 				map[int64]tPBlocks{
 					100: {"P1", true}, 101: {"P1", true}, 102: {"P1", true}}),
-			args:      testPeer{"P1", 102},
+			args:      testPeer{"P1", 0, 102},
 			errWanted: errPeerLowersItsHeight,
 			poolWanted: makeBlockPool(testBcR, 100, []BpPeer{},
 				map[int64]tPBlocks{}),
@@ -162,7 +163,7 @@ func TestBlockPoolUpdatePeer(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			pool := tt.pool
-			err := pool.UpdatePeer(tt.args.id, tt.args.height)
+			err := pool.UpdatePeer(tt.args.id, tt.args.base, tt.args.height)
 			assert.Equal(t, tt.errWanted, err)
 			assert.Equal(t, tt.poolWanted.blocks, tt.pool.blocks)
 			assertPeerSetsEquivalent(t, tt.poolWanted.peers, tt.pool.peers)
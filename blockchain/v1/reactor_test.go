@@ -123,7 +123,7 @@ func newBlockchainReactor(
 		thisParts := thisBlock.MakePartSet(types.BlockPartSizeBytes)
 		blockID := types.BlockID{Hash: thisBlock.Hash(), PartsHeader: thisParts.Header()}
 
-		state, err = blockExec.ApplyBlock(state, blockID, thisBlock)
+		state, _, err = blockExec.ApplyBlock(state, blockID, thisBlock)
 		if err != nil {
 			panic(errors.Wrap(err, "error apply block"))
 		}
@@ -372,18 +372,22 @@ func TestBcNoBlockResponseMessageValidateBasic(t *testing.T) {
 func TestBcStatusRequestMessageValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName      string
+		requestBase   int64
 		requestHeight int64
 		expectErr     bool
 	}{
-		{"Valid Request Message", 0, false},
-		{"Valid Request Message", 1, false},
-		{"Invalid Request Message", -1, true},
+		{"Valid Request Message", 0, 0, false},
+		{"Valid Request Message", 0, 1, false},
+		{"Valid Request Message", 1, 1, false},
+		{"Invalid Request Message", 0, -1, true},
+		{"Invalid Request Message", -1, 1, true},
+		{"Invalid Request Message", 2, 1, true},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.testName, func(t *testing.T) {
-			request := bcStatusRequestMessage{Height: tc.requestHeight}
+			request := bcStatusRequestMessage{Base: tc.requestBase, Height: tc.requestHeight}
 			assert.Equal(t, tc.expectErr, request.ValidateBasic() != nil, "Validate Basic had an unexpected result")
 		})
 	}
@@ -392,18 +396,22 @@ func TestBcStatusRequestMessageValidateBasic(t *testing.T) {
 func TestBcStatusResponseMessageValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName       string
+		responseBase   int64
 		responseHeight int64
 		expectErr      bool
 	}{
-		{"Valid Response Message", 0, false},
-		{"Valid Response Message", 1, false},
-		{"Invalid Response Message", -1, true},
+		{"Valid Response Message", 0, 0, false},
+		{"Valid Response Message", 0, 1, false},
+		{"Valid Response Message", 1, 1, false},
+		{"Invalid Response Message", 0, -1, true},
+		{"Invalid Response Message", -1, 1, true},
+		{"Invalid Response Message", 2, 1, true},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.testName, func(t *testing.T) {
-			response := bcStatusResponseMessage{Height: tc.responseHeight}
+			response := bcStatusResponseMessage{Base: tc.responseBase, Height: tc.responseHeight}
 			assert.Equal(t, tc.expectErr, response.ValidateBasic() != nil, "Validate Basic had an unexpected result")
 		})
 	}
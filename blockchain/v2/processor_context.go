@@ -31,7 +31,8 @@ func newProcessorContext(st *store.BlockStore, ex *state.BlockExecutor, s *state
 }
 
 func (pc *pContext) applyBlock(state state.State, blockID types.BlockID, block *types.Block) (state.State, error) {
-	return pc.executor.ApplyBlock(state, blockID, block)
+	state, _, err := pc.executor.ApplyBlock(state, blockID, block)
+	return state, err
 }
 
 func (pc *pContext) verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error {
@@ -44,6 +44,7 @@ type bcStatusResponse struct {
 	priorityNormal
 	time   time.Time
 	peerID p2p.ID
+	base   int64
 	height int64
 }
 
@@ -148,14 +149,15 @@ type scPeer struct {
 	// updated to Removed when peer is removed
 	state peerState
 
+	base        int64 // updated when statusResponse is received
 	height      int64 // updated when statusResponse is received
 	lastTouched time.Time
 	lastRate    int64 // last receive rate in bytes
 }
 
 func (p scPeer) String() string {
-	return fmt.Sprintf("{state %v, height %d, lastTouched %v, lastRate %d, id %v}",
-		p.state, p.height, p.lastTouched, p.lastRate, p.peerID)
+	return fmt.Sprintf("{state %v, base %d, height %d, lastTouched %v, lastRate %d, id %v}",
+		p.state, p.base, p.height, p.lastTouched, p.lastRate, p.peerID)
 }
 
 func newScPeer(peerID p2p.ID) *scPeer {
@@ -305,7 +307,7 @@ func (sc *scheduler) addNewBlocks() {
 	}
 }
 
-func (sc *scheduler) setPeerHeight(peerID p2p.ID, height int64) error {
+func (sc *scheduler) setPeerRange(peerID p2p.ID, base int64, height int64) error {
 	peer, ok := sc.peers[peerID]
 	if !ok {
 		return fmt.Errorf("cannot find peer %s", peerID)
@@ -319,6 +321,11 @@ func (sc *scheduler) setPeerHeight(peerID p2p.ID, height int64) error {
 		return fmt.Errorf("cannot move peer height lower. from %d to %d", peer.height, height)
 	}
 
+	if base > height {
+		return fmt.Errorf("cannot set peer base higher than its height. base %d, height %d", base, height)
+	}
+
+	peer.base = base
 	peer.height = height
 	peer.state = peerStateReady
 
@@ -336,13 +343,13 @@ func (sc *scheduler) getStateAtHeight(height int64) blockState {
 	}
 }
 
-func (sc *scheduler) getPeersAtHeightOrAbove(height int64) []p2p.ID {
+func (sc *scheduler) getPeersWithHeight(height int64) []p2p.ID {
 	peers := make([]p2p.ID, 0)
 	for _, peer := range sc.peers {
 		if peer.state != peerStateReady {
 			continue
 		}
-		if peer.height >= height {
+		if peer.base <= height && peer.height >= height {
 			peers = append(peers, peer.peerID)
 		}
 	}
@@ -452,6 +459,11 @@ func (sc *scheduler) markPending(peerID p2p.ID, height int64, time time.Time) er
 			height, peerID, peer.height)
 	}
 
+	if height < peer.base {
+		return fmt.Errorf("cannot request height %d for peer %s with base %d",
+			height, peerID, peer.base)
+	}
+
 	sc.setStateAtHeight(height, blockStatePending)
 	sc.pendingBlocks[height] = peerID
 	// XXX: to make this more accurate we can introduce a message from
@@ -518,7 +530,7 @@ func (sc *scheduler) pendingFrom(peerID p2p.ID) []int64 {
 }
 
 func (sc *scheduler) selectPeer(height int64) (p2p.ID, error) {
-	peers := sc.getPeersAtHeightOrAbove(height)
+	peers := sc.getPeersWithHeight(height)
 	if len(peers) == 0 {
 		return "", fmt.Errorf("cannot find peer for height %d", height)
 	}
@@ -678,7 +690,7 @@ func (sc *scheduler) handleTrySchedule(event trySchedule) (Event, error) {
 }
 
 func (sc *scheduler) handleStatusResponse(event bcStatusResponse) (Event, error) {
-	err := sc.setPeerHeight(event.peerID, event.height)
+	err := sc.setPeerRange(event.peerID, event.base, event.height)
 	if err != nil {
 		return scPeerError{peerID: event.peerID, reason: err}, nil
 	}
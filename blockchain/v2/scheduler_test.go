@@ -677,10 +677,11 @@ func TestScRemovePeer(t *testing.T) {
 	}
 }
 
-func TestScSetPeerHeight(t *testing.T) {
+func TestScSetPeerRange(t *testing.T) {
 
 	type args struct {
 		peerID p2p.ID
+		base   int64
 		height int64
 	}
 	tests := []struct {
@@ -752,14 +753,37 @@ func TestScSetPeerHeight(t *testing.T) {
 				peers:         map[string]*scPeer{"P2": {height: 10000000000, state: peerStateReady}},
 				allB:          []int64{1, 2, 3, 4}},
 		},
+		{
+			name: "add peer with base > height should error",
+			fields: scTestParams{
+				peers: map[string]*scPeer{"P1": {height: 4, state: peerStateReady}},
+				allB:  []int64{1, 2, 3, 4}},
+			args: args{peerID: "P1", base: 6, height: 5},
+			wantFields: scTestParams{
+				peers: map[string]*scPeer{"P1": {height: 4, state: peerStateReady}},
+				allB:  []int64{1, 2, 3, 4}},
+			wantErr: true,
+		},
+		{
+			name: "add peer with base == height is fine",
+			fields: scTestParams{
+				peers:         map[string]*scPeer{"P1": {height: -1, state: peerStateNew}},
+				targetPending: 4,
+			},
+			args: args{peerID: "P1", base: 6, height: 6},
+			wantFields: scTestParams{
+				targetPending: 4,
+				peers:         map[string]*scPeer{"P1": {base: 6, height: 6, state: peerStateReady}},
+				allB:          []int64{1, 2, 3, 4}},
+		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			sc := newTestScheduler(tt.fields)
-			if err := sc.setPeerHeight(tt.args.peerID, tt.args.height); (err != nil) != tt.wantErr {
-				t.Errorf("setPeerHeight() wantErr %v, error = %v", tt.wantErr, err)
+			if err := sc.setPeerRange(tt.args.peerID, tt.args.base, tt.args.height); (err != nil) != tt.wantErr {
+				t.Errorf("setPeerRange() wantErr %v, error = %v", tt.wantErr, err)
 			}
 			wantSc := newTestScheduler(tt.wantFields)
 			assert.Equal(t, wantSc, sc, "wanted peers %v, got %v", wantSc.peers, sc.peers)
@@ -767,7 +791,7 @@ func TestScSetPeerHeight(t *testing.T) {
 	}
 }
 
-func TestScGetPeersAtHeight(t *testing.T) {
+func TestScGetPeersWithHeight(t *testing.T) {
 
 	type args struct {
 		height int64
@@ -839,15 +863,30 @@ func TestScGetPeersAtHeight(t *testing.T) {
 			args:       args{height: 8},
 			wantResult: []p2p.ID{"P2", "P5"},
 		},
+		{
+			name: "multiple mixed peers with base",
+			fields: scTestParams{
+				height: 8,
+				peers: map[string]*scPeer{
+					"P1": {height: -1, state: peerStateNew},
+					"P2": {base: 9, height: 10, state: peerStateReady},
+					"P3": {height: 5, state: peerStateReady},
+					"P4": {height: 20, state: peerStateRemoved},
+					"P5": {base: 8, height: 11, state: peerStateReady}},
+				allB: []int64{8, 9, 10, 11},
+			},
+			args:       args{height: 8},
+			wantResult: []p2p.ID{"P5"},
+		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			sc := newTestScheduler(tt.fields)
-			// getPeersAtHeightOrAbove should not mutate the scheduler
+			// getPeersWithHeight should not mutate the scheduler
 			wantSc := sc
-			res := sc.getPeersAtHeightOrAbove(tt.args.height)
+			res := sc.getPeersWithHeight(tt.args.height)
 			sort.Sort(PeerByID(res))
 			assert.Equal(t, tt.wantResult, res)
 			assert.Equal(t, wantSc, sc)
@@ -19,6 +19,8 @@ func main() {
 		chainID          = flag.String("chain-id", "mychain", "chain id")
 		privValKeyPath   = flag.String("priv-key", "", "priv val key file path")
 		privValStatePath = flag.String("priv-state", "", "priv val state file path")
+		guardStatePath   = flag.String("guard-state", "",
+			"state file shared with other signers of the same key, to refuse conflicting signatures (optional)")
 
 		logger = log.NewTMLogger(
 			log.NewSyncWriter(os.Stdout),
@@ -32,6 +34,7 @@ func main() {
 		"chainID", *chainID,
 		"privKeyPath", *privValKeyPath,
 		"privStatePath", *privValStatePath,
+		"guardStatePath", *guardStatePath,
 	)
 
 	pv := privval.LoadFilePV(*privValKeyPath, *privValStatePath)
@@ -50,7 +53,11 @@ func main() {
 	}
 
 	sd := privval.NewSignerDialerEndpoint(logger, dialer)
-	ss := privval.NewSignerServer(sd, *chainID, pv)
+	var options []privval.SignerServerOption
+	if *guardStatePath != "" {
+		options = append(options, privval.SignerServerGuardStateFile(*guardStatePath))
+	}
+	ss := privval.NewSignerServer(sd, *chainID, pv, options...)
 
 	err := ss.Start()
 	if err != nil {
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+)
+
+// EncryptKeysCmd encrypts this node's private validator and node key files
+// with a passphrase.
+var EncryptKeysCmd = &cobra.Command{
+	Use:   "encrypt_keys",
+	Short: "Encrypt this node's private validator and node key files with a passphrase",
+	Long: `encrypt_keys encrypts the private validator and node key files with the
+passphrase in TM_KEY_PASSPHRASE or key_passphrase_file, or else prompts for it.
+The same passphrase must then be given to "tendermint node".`,
+	RunE: encryptKeys,
+}
+
+// DecryptKeysCmd decrypts this node's private validator and node key files.
+var DecryptKeysCmd = &cobra.Command{
+	Use:   "decrypt_keys",
+	Short: "Decrypt this node's private validator and node key files",
+	Long: `decrypt_keys writes the private validator and node key files as plaintext,
+decrypting them with the passphrase in TM_KEY_PASSPHRASE or key_passphrase_file,
+or else prompts for it.`,
+	RunE: decryptKeys,
+}
+
+func encryptKeys(cmd *cobra.Command, args []string) error {
+	for _, keyFile := range []string{config.PrivValidatorKeyFile(), config.NodeKeyFile()} {
+		if isEncryptedFile(keyFile) {
+			return fmt.Errorf("%s is already encrypted", keyFile)
+		}
+	}
+
+	passphrase, err := loadNewKeyPassphrase()
+	if err != nil {
+		return err
+	}
+	if passphrase == "" {
+		return errors.New("the passphrase can't be empty")
+	}
+	return rewriteKeyFiles("", passphrase)
+}
+
+func decryptKeys(cmd *cobra.Command, args []string) error {
+	passphrase, err := loadKeyPassphrase()
+	if err != nil {
+		return err
+	}
+	return rewriteKeyFiles(passphrase, "")
+}
+
+// rewriteKeyFiles loads the key files with passphrase and saves them with
+// newPassphrase, as plaintext if it is empty.
+func rewriteKeyFiles(passphrase, newPassphrase string) error {
+	if keyFile := config.PrivValidatorKeyFile(); tmos.FileExists(keyFile) {
+		pvKey, err := privval.LoadFilePVKey(keyFile, passphrase)
+		if err != nil {
+			return err
+		}
+		pvKey.SetPassphrase(newPassphrase)
+		pvKey.Save()
+		logger.Info("Rewrote private validator key file", "path", keyFile, "encrypted", newPassphrase != "")
+	}
+
+	if keyFile := config.NodeKeyFile(); tmos.FileExists(keyFile) {
+		nodeKey, err := p2p.LoadNodeKeyWithPassphrase(keyFile, passphrase)
+		if err != nil {
+			return err
+		}
+		if err := nodeKey.SaveAs(keyFile, newPassphrase); err != nil {
+			return errors.Wrap(err, "failed to save node key")
+		}
+		logger.Info("Rewrote node key file", "path", keyFile, "encrypted", newPassphrase != "")
+	}
+
+	return nil
+}
@@ -25,16 +25,24 @@ func initFiles(cmd *cobra.Command, args []string) error {
 }
 
 func initFilesWithConfig(config *cfg.Config) error {
+	// existing key files are decrypted with the passphrase, and new ones
+	// encrypted with it if given
+	passphrase, err := loadKeyPassphrase()
+	if err != nil {
+		return err
+	}
+
 	// private validator
 	privValKeyFile := config.PrivValidatorKeyFile()
 	privValStateFile := config.PrivValidatorStateFile()
 	var pv *privval.FilePV
 	if tmos.FileExists(privValKeyFile) {
-		pv = privval.LoadFilePV(privValKeyFile, privValStateFile)
+		pv = privval.LoadFilePVWithPassphrase(privValKeyFile, privValStateFile, passphrase)
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
 		pv = privval.GenFilePV(privValKeyFile, privValStateFile)
+		pv.Key.SetPassphrase(passphrase)
 		pv.Save()
 		logger.Info("Generated private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
@@ -44,7 +52,7 @@ func initFilesWithConfig(config *cfg.Config) error {
 	if tmos.FileExists(nodeKeyFile) {
 		logger.Info("Found node key", "path", nodeKeyFile)
 	} else {
-		if _, err := p2p.LoadOrGenNodeKey(nodeKeyFile); err != nil {
+		if _, err := p2p.LoadOrGenNodeKeyWithPassphrase(nodeKeyFile, passphrase); err != nil {
 			return err
 		}
 		logger.Info("Generated node key", "path", nodeKeyFile)
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+
+	"github.com/tendermint/tendermint/crypto/armor"
+	tmos "github.com/tendermint/tendermint/libs/os"
+)
+
+// keyPassphraseEnv is the environment variable the passphrase of the key
+// files can be given in.
+const keyPassphraseEnv = "TM_KEY_PASSPHRASE"
+
+// loadKeyPassphrase returns the passphrase of the private validator and node
+// key files, from the TM_KEY_PASSPHRASE environment variable or the
+// key_passphrase_file. If neither is set and one of the key files is
+// encrypted, it prompts for the passphrase.
+func loadKeyPassphrase() (string, error) {
+	if passphrase, ok := os.LookupEnv(keyPassphraseEnv); ok {
+		return passphrase, nil
+	}
+	if config.KeyPassphrase != "" {
+		return config.LoadKeyPassphrase()
+	}
+	if !isEncryptedFile(config.PrivValidatorKeyFile()) && !isEncryptedFile(config.NodeKeyFile()) {
+		return "", nil
+	}
+	return promptPassphrase("Enter the passphrase of the key files: ")
+}
+
+// loadKeyFilePassphrase returns the passphrase of another key file, like
+// loadKeyPassphrase, but prompts for it only if that file is encrypted.
+func loadKeyFilePassphrase(keyFile string) (string, error) {
+	if passphrase, ok := os.LookupEnv(keyPassphraseEnv); ok {
+		return passphrase, nil
+	}
+	if config.KeyPassphrase != "" {
+		return config.LoadKeyPassphrase()
+	}
+	if !isEncryptedFile(keyFile) {
+		return "", nil
+	}
+	return promptPassphrase(fmt.Sprintf("Enter the passphrase of %s: ", keyFile))
+}
+
+// loadNewKeyPassphrase returns the passphrase to encrypt the key files with,
+// like loadKeyPassphrase, but always prompts for it (twice) if it is not
+// given otherwise.
+func loadNewKeyPassphrase() (string, error) {
+	if passphrase, ok := os.LookupEnv(keyPassphraseEnv); ok {
+		return passphrase, nil
+	}
+	if config.KeyPassphrase != "" {
+		return config.LoadKeyPassphrase()
+	}
+
+	passphrase, err := promptPassphrase("Enter a passphrase for the key files: ")
+	if err != nil {
+		return "", err
+	}
+	confirmation, err := promptPassphrase("Repeat the passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirmation {
+		return "", errors.New("passphrases don't match")
+	}
+	return passphrase, nil
+}
+
+func promptPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("no passphrase given: set %s or key_passphrase_file", keyPassphraseEnv)
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	bz, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read passphrase")
+	}
+	return string(bz), nil
+}
+
+func isEncryptedFile(filePath string) bool {
+	if !tmos.FileExists(filePath) {
+		return false
+	}
+	bz, err := ioutil.ReadFile(filePath)
+	return err == nil && armor.IsArmored(bz)
+}
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+)
+
+// MigrateTxIndexCmd migrates the keys of the kv tx index written by earlier
+// versions. The node must be stopped.
+var MigrateTxIndexCmd = &cobra.Command{
+	Use:   "migrate_tx_index",
+	Short: "Migrate the kv tx index written by earlier versions (the node must be stopped)",
+	RunE:  migrateTxIndex,
+}
+
+func migrateTxIndex(cmd *cobra.Command, args []string) error {
+	store, err := node.DefaultDBProvider(&node.DBContext{ID: "tx_index", Config: config})
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	migrated, err := kv.MigrateLegacyKeys(store)
+	if err != nil {
+		return err
+	}
+	logger.Info("Migrated the keys of the tx index", "keys", migrated)
+	return nil
+}
@@ -64,7 +64,12 @@ func ResetAll(dbDir, addrBookFile, privValKeyFile, privValStateFile string, logg
 
 func resetFilePV(privValKeyFile, privValStateFile string, logger log.Logger) {
 	if _, err := os.Stat(privValKeyFile); err == nil {
-		pv := privval.LoadFilePVEmptyState(privValKeyFile, privValStateFile)
+		passphrase, err := loadKeyPassphrase()
+		if err != nil {
+			logger.Error("Error reading the passphrase of the private validator file", "err", err)
+			return
+		}
+		pv := privval.LoadFilePVEmptyStateWithPassphrase(privValKeyFile, privValStateFile, passphrase)
 		pv.Reset()
 		logger.Info("Reset private validator file to genesis state", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
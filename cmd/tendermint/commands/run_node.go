@@ -30,6 +30,10 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"priv_validator_laddr",
 		config.PrivValidatorListenAddr,
 		"Socket address to listen on for connections from external priv_validator process")
+	cmd.Flags().String(
+		"key_passphrase_file",
+		config.KeyPassphrase,
+		"File containing the passphrase of the encrypted key files (or set TM_KEY_PASSPHRASE)")
 
 	// node flags
 	cmd.Flags().Bool("fast_sync", config.FastSyncMode, "Fast blockchain syncing")
@@ -99,6 +103,12 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 		Use:   "node",
 		Short: "Run the tendermint node",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			passphrase, err := loadKeyPassphrase()
+			if err != nil {
+				return err
+			}
+			config.SetKeyPassphrase(passphrase)
+
 			n, err := nodeProvider(config, logger)
 			if err != nil {
 				return fmt.Errorf("failed to create node: %v", err)
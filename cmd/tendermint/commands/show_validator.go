@@ -23,8 +23,15 @@ func showValidator(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("private validator file %s does not exist", keyFilePath)
 	}
 
-	pv := privval.LoadFilePV(keyFilePath, config.PrivValidatorStateFile())
-	bz, err := cdc.MarshalJSON(pv.GetPubKey())
+	passphrase, err := loadKeyPassphrase()
+	if err != nil {
+		return err
+	}
+	pvKey, err := privval.LoadFilePVKey(keyFilePath, passphrase)
+	if err != nil {
+		return err
+	}
+	bz, err := cdc.MarshalJSON(pvKey.PubKey)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal private validator pubkey")
 	}
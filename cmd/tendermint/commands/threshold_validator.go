@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/libs/bytes"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+var (
+	threshold       int
+	nThresholdKeys  int
+	thresholdOutput string
+)
+
+func init() {
+	GenThresholdValidatorCmd.Flags().IntVar(&threshold, "k", 2,
+		"Number of signatures needed to sign")
+	GenThresholdValidatorCmd.Flags().IntVar(&nThresholdKeys, "n", 3,
+		fmt.Sprintf("Number of keys (at most %d)", types.MaxThresholdPubKeys))
+	GenThresholdValidatorCmd.Flags().StringVar(&thresholdOutput, "o", "./threshold-validator",
+		"Directory to store the key files of the signers in")
+
+	ShowThresholdValidatorCmd.Flags().IntVar(&threshold, "k", 2,
+		"Number of signatures needed to sign")
+}
+
+// GenThresholdValidatorCmd allows the generation of the keys of a threshold
+// multisig validator.
+var GenThresholdValidatorCmd = &cobra.Command{
+	Use:   "gen_threshold_validator",
+	Short: "Generate new threshold multisig validator keys",
+	Long: `gen_threshold_validator will create "n" directories (signer0, signer1, ...)
+in "o", each with the key and state files of one signer, and print the threshold
+multisig key of which "k" signatures are needed to sign.
+
+Each signer must be run with its files as a remote signer, and the node with
+priv_validator_threshold set to "k" and priv_validator_laddr set to the
+addresses of the signers, in order.
+
+Example:
+
+	tendermint gen_threshold_validator --k 2 --n 3 --o ./threshold-validator
+	`,
+	RunE: genThresholdValidator,
+}
+
+func genThresholdValidator(cmd *cobra.Command, args []string) error {
+	if nThresholdKeys <= 0 || nThresholdKeys > types.MaxThresholdPubKeys {
+		return fmt.Errorf("invalid number of keys %d, must be between 1 and %d",
+			nThresholdKeys, types.MaxThresholdPubKeys)
+	}
+	if threshold <= 0 || threshold > nThresholdKeys {
+		return fmt.Errorf("invalid threshold %d of %d keys", threshold, nThresholdKeys)
+	}
+
+	pubKeys := make([]crypto.PubKey, nThresholdKeys)
+	for i := 0; i < nThresholdKeys; i++ {
+		dir := filepath.Join(thresholdOutput, fmt.Sprintf("signer%d", i))
+		if err := tmos.EnsureDir(dir, 0700); err != nil {
+			return errors.Wrap(err, "failed to create signer directory")
+		}
+
+		keyFile := filepath.Join(dir, "priv_validator_key.json")
+		stateFile := filepath.Join(dir, "priv_validator_state.json")
+		if tmos.FileExists(keyFile) {
+			return fmt.Errorf("private validator file %s already exists", keyFile)
+		}
+		pv := privval.GenFilePV(keyFile, stateFile)
+		pv.Save()
+		pubKeys[i] = pv.GetPubKey()
+	}
+
+	return printThresholdPubKey(pubKeys)
+}
+
+// ShowThresholdValidatorCmd adds capabilities for showing the threshold
+// multisig key of a set of signers.
+var ShowThresholdValidatorCmd = &cobra.Command{
+	Use:   "show_threshold_validator [key files]",
+	Short: "Show the threshold multisig validator key of the given signers' key files",
+	Long: `show_threshold_validator prints the threshold multisig key of which "k"
+signatures are needed to sign, given the key files of the signers, in order.
+The passphrase of encrypted key files is read from TM_KEY_PASSPHRASE or
+key_passphrase_file, or else prompted for.
+
+Example:
+
+	tendermint show_threshold_validator --k 2 signer0/priv_validator_key.json \
+		signer1/priv_validator_key.json signer2/priv_validator_key.json
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: showThresholdValidator,
+}
+
+func showThresholdValidator(cmd *cobra.Command, args []string) error {
+	if threshold <= 0 || threshold > len(args) {
+		return fmt.Errorf("invalid threshold %d of %d keys", threshold, len(args))
+	}
+
+	pubKeys := make([]crypto.PubKey, len(args))
+	for i, keyFile := range args {
+		if !tmos.FileExists(keyFile) {
+			return fmt.Errorf("private validator file %s does not exist", keyFile)
+		}
+		passphrase, err := loadKeyFilePassphrase(keyFile)
+		if err != nil {
+			return err
+		}
+		pvKey, err := privval.LoadFilePVKey(keyFile, passphrase)
+		if err != nil {
+			return err
+		}
+		pubKeys[i] = pvKey.PubKey
+	}
+
+	return printThresholdPubKey(pubKeys)
+}
+
+func printThresholdPubKey(pubKeys []crypto.PubKey) error {
+	pubKey := multisig.NewPubKeyMultisigThreshold(threshold, pubKeys).(multisig.PubKeyMultisigThreshold)
+	if err := types.ValidateThresholdPubKey(pubKey); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSONIndent(struct {
+		Address bytes.HexBytes `json:"address"`
+		PubKey  crypto.PubKey  `json:"pub_key"`
+	}{pubKey.Address(), pubKey}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal threshold validator pubkey")
+	}
+
+	fmt.Println(string(bz))
+	return nil
+}
@@ -24,9 +24,14 @@ func main() {
 		cmd.ResetAllCmd,
 		cmd.ResetPrivValidatorCmd,
 		cmd.ShowValidatorCmd,
+		cmd.GenThresholdValidatorCmd,
+		cmd.ShowThresholdValidatorCmd,
 		cmd.TestnetFilesCmd,
 		cmd.ShowNodeIDCmd,
 		cmd.GenNodeKeyCmd,
+		cmd.EncryptKeysCmd,
+		cmd.DecryptKeysCmd,
+		cmd.MigrateTxIndexCmd,
 		cmd.VersionCmd,
 		debug.DebugCmd,
 	)
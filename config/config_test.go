@@ -1,11 +1,14 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -61,6 +64,50 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	// a gRPC remote signer requires TLS certificates
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorListenAddr = "grpc://127.0.0.1:26659"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorClientCertificate = "config/client.crt"
+	cfg.PrivValidatorClientKey = "config/client.key"
+	cfg.PrivValidatorRootCA = "config/ca.crt"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// several signers require a threshold no greater than their number
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorListenAddr = "tcp://127.0.0.1:26659, tcp://127.0.0.1:26660"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorThreshold = 3
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorThreshold = 2
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, []string{"tcp://127.0.0.1:26659", "tcp://127.0.0.1:26660"}, cfg.PrivValidatorListenAddrs())
+}
+
+func TestBaseConfigLoadKeyPassphrase(t *testing.T) {
+	cfg := TestBaseConfig()
+	passphrase, err := cfg.LoadKeyPassphrase()
+	require.NoError(t, err)
+	assert.Empty(t, passphrase)
+
+	f, err := ioutil.TempFile("", "key_passphrase")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("passphrase\n")
+	require.NoError(t, err)
+	f.Close()
+
+	cfg.KeyPassphrase = f.Name()
+	passphrase, err = cfg.LoadKeyPassphrase()
+	require.NoError(t, err)
+	assert.Equal(t, "passphrase", passphrase)
+
+	// a passphrase set explicitly takes precedence
+	cfg.SetKeyPassphrase("other passphrase")
+	passphrase, err = cfg.LoadKeyPassphrase()
+	require.NoError(t, err)
+	assert.Equal(t, "other passphrase", passphrase)
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -102,6 +149,11 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Transport = "quic"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Transport = "udp"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -120,6 +172,28 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Version = "v1"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Version = "v2"
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestStateSyncConfigValidateBasic(t *testing.T) {
+	cfg := TestStateSyncConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// enabling requires rpc servers and trust options
+	cfg.Enable = true
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.RPCServers = []string{"127.0.0.1:26657", "127.0.0.2:26657"}
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0123456789ABCDEF"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.TrustHash = "not hex"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestFastSyncConfigValidateBasic(t *testing.T) {
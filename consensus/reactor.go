@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/behaviour"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	"github.com/tendermint/tendermint/libs/bits"
 	tmevents "github.com/tendermint/tendermint/libs/events"
@@ -42,6 +43,7 @@ type Reactor struct {
 	mtx      sync.RWMutex
 	fastSync bool
 	eventBus *types.EventBus
+	reporter behaviour.Reporter
 
 	metrics *Metrics
 }
@@ -66,6 +68,12 @@ func NewReactor(consensusState *State, fastSync bool, options ...ReactorOption)
 	return conR
 }
 
+// SetSwitch implements Reactor by also reporting peer behaviour to the switch.
+func (conR *Reactor) SetSwitch(sw *p2p.Switch) {
+	conR.BaseReactor.SetSwitch(sw)
+	conR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // OnStart implements BaseService by subscribing to events, which later will be
 // broadcasted to other peers and starting state if we're not in fast sync.
 func (conR *Reactor) OnStart() error {
@@ -219,13 +227,13 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		conR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(conR.reporter, conR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		conR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(conR.reporter, conR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 
@@ -257,7 +265,7 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			// Peer claims to have a maj23 for some BlockID at H,R,S,
 			err := votes.SetPeerMaj23(msg.Round, msg.Type, ps.peer.ID(), msg.BlockID)
 			if err != nil {
-				conR.Switch.StopPeerForError(src, err)
+				behaviour.ReportOrStop(conR.reporter, conR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 				return
 			}
 			// Respond with a VoteSetBitsMessage showing which votes we have.
@@ -651,7 +659,12 @@ OUTER_LOOP:
 			// Load the block commit for prs.Height,
 			// which contains precommit signatures for prs.Height.
 			commit := conR.conS.blockStore.LoadBlockCommit(prs.Height)
-			if ps.PickSendVote(commit) {
+			if commit != nil && len(commit.AggregatedSignature) != 0 {
+				// The precommits of an aggregated commit can't be sent, but
+				// the seen commit may still have them.
+				commit = conR.conS.blockStore.LoadSeenCommit(prs.Height)
+			}
+			if commit != nil && len(commit.AggregatedSignature) == 0 && ps.PickSendVote(commit) {
 				logger.Debug("Picked Catchup commit to send", "height", prs.Height)
 				continue OUTER_LOOP
 			}
@@ -844,11 +857,17 @@ func (conR *Reactor) peerStatsRoutine() {
 			switch msg.Msg.(type) {
 			case *VoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					if err := conR.reporter.Report(behaviour.ConsensusVote(peer.ID(),
+						fmt.Sprintf("contributed %d votes", numVotes))); err != nil {
+						conR.Logger.Debug("Can't report peer", "peer", peer, "err", err)
+					}
 				}
 			case *BlockPartMessage:
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					if err := conR.reporter.Report(behaviour.BlockPart(peer.ID(),
+						fmt.Sprintf("contributed %d block parts", numParts))); err != nil {
+						conR.Logger.Debug("Can't report peer", "peer", peer, "err", err)
+					}
 				}
 			}
 		case <-conR.conS.Quit():
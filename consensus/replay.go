@@ -205,6 +205,8 @@ type Handshaker struct {
 	genDoc       *types.GenesisDoc
 	logger       log.Logger
 
+	minABCIVersion proxy.ABCIVersion
+
 	nBlocks int // number of blocks applied to the state
 }
 
@@ -232,6 +234,12 @@ func (h *Handshaker) SetEventBus(eventBus types.BlockEventPublisher) {
 	h.eventBus = eventBus
 }
 
+// SetMinABCIVersion sets the minimum ABCI version the app must speak.
+// If not called, any version the node can work with is accepted.
+func (h *Handshaker) SetMinABCIVersion(v proxy.ABCIVersion) {
+	h.minABCIVersion = v
+}
+
 // NBlocks returns the number of blocks applied to the state.
 func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
@@ -252,11 +260,19 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) error {
 	}
 	appHash := res.LastBlockAppHash
 
+	// Agree on the ABCI version to speak with the app.
+	abciVersion, err := proxy.NegotiateABCIVersion(res.AbciVersion, h.minABCIVersion)
+	if err != nil {
+		return fmt.Errorf("incompatible app: %v", err)
+	}
+	proxyApp.SetABCIVersion(abciVersion)
+
 	h.logger.Info("ABCI Handshake App Info",
 		"height", blockHeight,
 		"hash", fmt.Sprintf("%X", appHash),
 		"software-version", res.Version,
 		"protocol-version", res.AppVersion,
+		"abci-version", abciVersion,
 	)
 
 	// Set AppVersion on the state.
@@ -288,12 +304,15 @@ func (h *Handshaker) ReplayBlocks(
 	appBlockHeight int64,
 	proxyApp proxy.AppConns,
 ) ([]byte, error) {
+	storeBlockBase := h.store.Base()
 	storeBlockHeight := h.store.Height()
 	stateBlockHeight := state.LastBlockHeight
 	h.logger.Info(
 		"ABCI Replay Blocks",
 		"appHeight",
 		appBlockHeight,
+		"storeBase",
+		storeBlockBase,
 		"storeHeight",
 		storeBlockHeight,
 		"stateHeight",
@@ -347,6 +366,10 @@ func (h *Handshaker) ReplayBlocks(
 		assertAppHashEqualsOneFromState(appHash, state)
 		return appHash, nil
 
+	case appBlockHeight < storeBlockBase-1:
+		// the app is too far behind the pruned store (it can be 1 behind since we replay the next)
+		return appHash, sm.ErrAppBlockHeightTooLow{AppHeight: appBlockHeight, StoreBase: storeBlockBase}
+
 	case storeBlockHeight < appBlockHeight:
 		// the app should never be ahead of the store (but this is under app's control)
 		return appHash, sm.ErrAppBlockHeightTooHigh{CoreHeight: storeBlockHeight, AppHeight: appBlockHeight}
@@ -472,7 +495,7 @@ func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.Ap
 	blockExec.SetEventBus(h.eventBus)
 
 	var err error
-	state, err = blockExec.ApplyBlock(state, meta.BlockID, block)
+	state, _, err = blockExec.ApplyBlock(state, meta.BlockID, block)
 	if err != nil {
 		return sm.State{}, err
 	}
@@ -709,7 +709,7 @@ func applyBlock(stateDB dbm.DB, st sm.State, blk *types.Block, proxyApp proxy.Ap
 	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), mempool, evpool)
 
 	blkID := types.BlockID{Hash: blk.Hash(), PartsHeader: blk.MakePartSet(testPartSize).Header()}
-	newState, err := blockExec.ApplyBlock(st, blkID, blk)
+	newState, _, err := blockExec.ApplyBlock(st, blkID, blk)
 	if err != nil {
 		panic(err)
 	}
@@ -1053,14 +1053,16 @@ type mockBlockStore struct {
 	params  types.ConsensusParams
 	chain   []*types.Block
 	commits []*types.Commit
+	base    int64
 }
 
 // TODO: NewBlockStore(db.NewMemDB) ...
 func newMockBlockStore(config *cfg.Config, params types.ConsensusParams) *mockBlockStore {
-	return &mockBlockStore{config, params, nil, nil}
+	return &mockBlockStore{config, params, nil, nil, 0}
 }
 
 func (bs *mockBlockStore) Height() int64                       { return int64(len(bs.chain)) }
+func (bs *mockBlockStore) Base() int64                         { return bs.base }
 func (bs *mockBlockStore) LoadBlock(height int64) *types.Block { return bs.chain[height-1] }
 func (bs *mockBlockStore) LoadBlockByHash(hash []byte) *types.Block {
 	return bs.chain[int64(len(bs.chain))-1]
@@ -1082,6 +1084,17 @@ func (bs *mockBlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
 
+func (bs *mockBlockStore) PruneBlocks(height int64) (uint64, error) {
+	pruned := uint64(0)
+	for i := int64(0); i < height-1; i++ {
+		bs.chain[i] = nil
+		bs.commits[i] = nil
+		pruned++
+	}
+	bs.base = height
+	return pruned, nil
+}
+
 //---------------------------------------
 // Test handshake/init chain
 
@@ -1130,3 +1143,51 @@ func (ica *initChainApp) InitChain(req abci.RequestInitChain) abci.ResponseInitC
 		Validators: ica.vals,
 	}
 }
+
+// reports the given ABCI version on Info
+type abciVersionApp struct {
+	abci.BaseApplication
+	abciVersion string
+}
+
+func (app *abciVersionApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	return abci.ResponseInfo{AbciVersion: app.abciVersion}
+}
+
+func TestHandshakeNegotiatesABCIVersion(t *testing.T) {
+	config := ResetConfig("handshake_test_")
+	defer os.RemoveAll(config.RootDir)
+	privVal := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	genDoc, _ := sm.MakeGenesisDocFromFile(config.GenesisFile())
+
+	testCases := []struct {
+		appVersion string
+		minVersion proxy.ABCIVersion
+		expVersion proxy.ABCIVersion
+		expErr     bool
+	}{
+		{"", proxy.ABCIVersion{}, proxy.NodeABCIVersion, false}, // filled in by the ABCI library
+		{"0.16.0", proxy.ABCIVersion{}, proxy.LegacyABCIVersion, false},
+		{"0.99.0", proxy.ABCIVersion{}, proxy.NodeABCIVersion, false},
+		{"0.16.0", proxy.NodeABCIVersion, proxy.ABCIVersion{}, true},
+		{"0.15.0", proxy.ABCIVersion{}, proxy.ABCIVersion{}, true},
+		{"1.0.0", proxy.ABCIVersion{}, proxy.ABCIVersion{}, true},
+		{"latest", proxy.ABCIVersion{}, proxy.ABCIVersion{}, true},
+	}
+	for _, tc := range testCases {
+		stateDB, state, store := stateAndStore(config, privVal.GetPubKey(), 0x0)
+		handshaker := NewHandshaker(stateDB, state, store, genDoc)
+		handshaker.SetMinABCIVersion(tc.minVersion)
+
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(&abciVersionApp{abciVersion: tc.appVersion}))
+		require.NoError(t, proxyApp.Start())
+
+		err := handshaker.Handshake(proxyApp)
+		if tc.expErr {
+			assert.Error(t, err, tc.appVersion)
+		} else if assert.NoError(t, err, tc.appVersion) {
+			assert.Equal(t, tc.expVersion, proxyApp.ABCIVersion(), tc.appVersion)
+		}
+		proxyApp.Stop()
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 	cfg "github.com/tendermint/tendermint/config"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmevents "github.com/tendermint/tendermint/libs/events"
 	"github.com/tendermint/tendermint/p2p"
 	sm "github.com/tendermint/tendermint/state"
@@ -120,6 +121,10 @@ type State struct {
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
 
+	// keys the signatures of the votes being handled were verified with, in
+	// a batch
+	verifiedVotes map[*types.Vote]crypto.PubKey
+
 	// some functions can be overwritten for testing
 	decideProposal func(height int64, round int)
 	doPrevote      func(height int64, round int)
@@ -490,6 +495,15 @@ func (cs *State) reconstructLastCommit(state sm.State) {
 		return
 	}
 	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
+	if len(seenCommit.AggregatedSignature) != 0 {
+		// The commit of a block we fast synced or state synced to may have an
+		// aggregated signature, whose votes can't be reconstructed. LastCommit
+		// is left to the precommits we receive, and the commit is proposed as
+		// is in the meantime (see createProposalBlock).
+		cs.LastCommit = types.NewVoteSet(state.ChainID, seenCommit.Height, seenCommit.Round,
+			types.PrecommitType, state.LastValidators)
+		return
+	}
 	lastPrecommits := types.CommitToVoteSet(state.ChainID, seenCommit, state.LastValidators)
 	if !lastPrecommits.HasTwoThirdsMajority() {
 		panic("Failed to reconstruct LastCommit: Does not have +2/3 maj")
@@ -638,10 +652,20 @@ func (cs *State) receiveRoutine(maxSteps int) {
 		case <-cs.txNotifier.TxsAvailable():
 			cs.handleTxsAvailable()
 		case mi = <-cs.peerMsgQueue:
-			cs.wal.Write(mi)
-			// handles proposals, block parts, votes
-			// may generate internal events (votes, complete proposals, 2/3 majorities)
-			cs.handleMsg(mi)
+			// The signatures of the votes waiting in the queue are verified
+			// at once, before the messages are handled in order.
+			msgs := []msgInfo{mi}
+			if _, ok := mi.Msg.(*VoteMessage); ok {
+				msgs = cs.drainPeerMsgQueue(msgs)
+				cs.batchVerifyVotes(msgs)
+			}
+			for _, mi := range msgs {
+				cs.wal.Write(mi)
+				// handles proposals, block parts, votes
+				// may generate internal events (votes, complete proposals, 2/3 majorities)
+				cs.handleMsg(mi)
+			}
+			cs.verifiedVotes = nil
 		case mi = <-cs.internalMsgQueue:
 			err := cs.wal.WriteSync(mi) // NOTE: fsync
 			if err != nil {
@@ -670,6 +694,63 @@ func (cs *State) receiveRoutine(maxSteps int) {
 	}
 }
 
+// drainPeerMsgQueue appends the messages waiting in the peer queue to msgs,
+// without waiting for more.
+func (cs *State) drainPeerMsgQueue(msgs []msgInfo) []msgInfo {
+	for n := len(cs.peerMsgQueue); n > 0; n-- {
+		select {
+		case mi := <-cs.peerMsgQueue:
+			msgs = append(msgs, mi)
+		default:
+			return msgs
+		}
+	}
+	return msgs
+}
+
+// batchVerifyVotes verifies the signatures of the votes for this height and
+// the last one in batches, and saves the keys of the valid ones in
+// cs.verifiedVotes so they aren't verified again when they're added.
+func (cs *State) batchVerifyVotes(msgs []msgInfo) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	var (
+		votes   []*types.Vote
+		pubKeys []crypto.PubKey
+	)
+	for _, mi := range msgs {
+		msg, ok := mi.Msg.(*VoteMessage)
+		if !ok {
+			continue
+		}
+		var valSet *types.ValidatorSet
+		switch msg.Vote.Height {
+		case cs.Height:
+			valSet = cs.Validators
+		case cs.Height - 1:
+			valSet = cs.LastValidators
+		}
+		if valSet == nil {
+			continue
+		}
+		if _, val := valSet.GetByIndex(msg.Vote.ValidatorIndex); val != nil {
+			votes = append(votes, msg.Vote)
+			pubKeys = append(pubKeys, val.PubKey)
+		}
+	}
+	if len(votes) < 2 {
+		return
+	}
+
+	cs.verifiedVotes = make(map[*types.Vote]crypto.PubKey, len(votes))
+	for i, err := range types.VerifyVotes(cs.state.ChainID, votes, pubKeys) {
+		if err == nil {
+			cs.verifiedVotes[votes[i]] = pubKeys[i]
+		}
+	}
+}
+
 // state transitions on complete-proposal, 2/3-any, 2/3-one
 func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
@@ -1020,13 +1101,23 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 		// Make the commit from LastCommit
 		commit = cs.LastCommit.MakeCommit()
 	default:
-		// This shouldn't happen.
-		cs.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block.")
-		return
+		// LastCommit can't be reconstructed from an aggregated commit, which
+		// is proposed as is (see reconstructLastCommit).
+		commit = cs.blockStore.LoadSeenCommit(cs.Height - 1)
+		if commit == nil || len(commit.AggregatedSignature) == 0 {
+			// This shouldn't happen.
+			cs.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block.")
+			return
+		}
 	}
 
 	proposerAddr := cs.privValidator.GetPubKey().Address()
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	if err != nil {
+		cs.Logger.Error("enterPropose: Cannot create proposal block", "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1086,6 +1177,15 @@ func (cs *State) defaultDoPrevote(height int64, round int) {
 		return
 	}
 
+	// Ask the app whether it accepts the block
+	err = cs.blockExec.ProcessProposal(cs.ProposalBlock)
+	if err != nil {
+		// ProposalBlock is rejected, prevote nil.
+		logger.Error("enterPrevote: ProposalBlock is rejected", "err", err)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1428,7 +1528,8 @@ func (cs *State) finalizeCommit(height int64) {
 	// Execute and commit the block, update and save the state, and update the mempool.
 	// NOTE The block.AppHash wont reflect these txs until the next block.
 	var err error
-	stateCopy, err = cs.blockExec.ApplyBlock(
+	var retainHeight int64
+	stateCopy, retainHeight, err = cs.blockExec.ApplyBlock(
 		stateCopy,
 		types.BlockID{Hash: block.Hash(), PartsHeader: blockParts.Header()},
 		block)
@@ -1443,6 +1544,16 @@ func (cs *State) finalizeCommit(height int64) {
 
 	fail.Fail() // XXX
 
+	// Prune old heights, if requested by ABCI app.
+	if retainHeight > 0 {
+		pruned, err := cs.pruneBlocks(retainHeight)
+		if err != nil {
+			cs.Logger.Error("Failed to prune blocks", "retainHeight", retainHeight, "err", err)
+		} else {
+			cs.Logger.Info("Pruned blocks", "pruned", pruned, "retainHeight", retainHeight)
+		}
+	}
+
 	// must be called before we update state
 	cs.recordMetrics(height, block)
 
@@ -1461,6 +1572,22 @@ func (cs *State) finalizeCommit(height int64) {
 	// * cs.StartTime is set to when we will start round0.
 }
 
+func (cs *State) pruneBlocks(retainHeight int64) (uint64, error) {
+	base := cs.blockStore.Base()
+	if retainHeight <= base {
+		return 0, nil
+	}
+	pruned, err := cs.blockStore.PruneBlocks(retainHeight)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prune block store")
+	}
+	err = sm.PruneStates(cs.blockExec.DB(), base, retainHeight)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prune state database")
+	}
+	return pruned, nil
+}
+
 func (cs *State) recordMetrics(height int64, block *types.Block) {
 	cs.metrics.Validators.Set(float64(cs.Validators.Size()))
 	cs.metrics.ValidatorsPower.Set(float64(cs.Validators.TotalVotingPower()))
@@ -1699,7 +1826,10 @@ func (cs *State) addVote(
 			// fmt.Errorf("tryAddVote: Wrong height, not a LastCommit straggler commit.")
 			return added, ErrVoteHeightMismatch
 		}
-		added, err = cs.LastCommit.AddVote(vote)
+		if err = cs.verifyVoteExtension(vote, peerID, cs.LastCommit, cs.LastValidators); err != nil {
+			return added, err
+		}
+		added, err = cs.LastCommit.AddPreverifiedVote(vote, cs.verifiedVotes[vote])
 		if !added {
 			return added, err
 		}
@@ -1727,7 +1857,10 @@ func (cs *State) addVote(
 	}
 
 	height := cs.Height
-	added, err = cs.Votes.AddVote(vote, peerID)
+	if err = cs.verifyVoteExtension(vote, peerID, cs.Votes.Precommits(vote.Round), cs.Validators); err != nil {
+		return added, err
+	}
+	added, err = cs.Votes.AddPreverifiedVote(vote, peerID, cs.verifiedVotes[vote])
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
@@ -1835,6 +1968,49 @@ func (cs *State) addVote(
 	return added, err
 }
 
+// verifyVoteExtension asks the app to verify the extension of a precommit for
+// a block from a peer, before it's added to the vote set. The signature is
+// checked first, so the app only sees extensions signed by their validator.
+func (cs *State) verifyVoteExtension(
+	vote *types.Vote,
+	peerID p2p.ID,
+	voteSet *types.VoteSet,
+	valSet *types.ValidatorSet,
+) error {
+	// Our own votes were extended by us.
+	if peerID == "" || vote.Type != types.PrecommitType || vote.BlockID.IsZero() {
+		return nil
+	}
+	// Leave unknown validators and duplicates to the vote set.
+	_, val := valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return nil
+	}
+	if existing := voteSet.GetByIndex(vote.ValidatorIndex); existing != nil &&
+		bytes.Equal(existing.Signature, vote.Signature) {
+		return nil
+	}
+
+	if verifiedKey := cs.verifiedVotes[vote]; verifiedKey == nil || !verifiedKey.Equals(val.PubKey) {
+		if err := vote.Verify(cs.state.ChainID, val.PubKey); err != nil {
+			return err
+		}
+	}
+	if err := cs.blockExec.VerifyVoteExtension(vote, cs.maxVoteExtensionBytes(valSet)); err != nil {
+		return errors.Wrapf(err, "extension of vote %v", vote)
+	}
+	return nil
+}
+
+// maxVoteExtensionBytes returns the maximum size of each vote extension in a
+// commit by the given validators, so that the commit fits in the next block.
+// The latest consensus params are used, as those of the next block are only
+// known once the current block is executed.
+func (cs *State) maxVoteExtensionBytes(valSet *types.ValidatorSet) int64 {
+	params := cs.state.ConsensusParams
+	return types.MaxVoteExtensionBytes(params.Block.MaxBytes, params.Validator, valSet.Size())
+}
+
 func (cs *State) signVote(
 	msgType types.SignedMsgType,
 	hash []byte,
@@ -1856,6 +2032,11 @@ func (cs *State) signVote(
 		Type:             msgType,
 		BlockID:          types.BlockID{Hash: hash, PartsHeader: header},
 	}
+	if msgType == types.PrecommitType && len(hash) != 0 {
+		if err := cs.blockExec.ExtendVote(vote, cs.maxVoteExtensionBytes(cs.Validators)); err != nil {
+			return vote, errors.Wrap(err, "failed to extend vote")
+		}
+	}
 	err := cs.privValidator.SignVote(cs.state.ChainID, vote)
 	return vote, err
 }
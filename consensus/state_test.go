@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
@@ -26,10 +27,12 @@ x * TestProposerSelection2 - round robin ordering, round 2++
 x * TestEnterProposeNoValidator - timeout into prevote round
 x * TestEnterPropose - finish propose without timing out (we have the proposal)
 x * TestBadProposal - 2 vals, bad proposal (bad block state hash), should prevote and precommit nil
+x * TestProposalRejectedByApp - 2 vals, proposal rejected by the app in ProcessProposal, should prevote nil
 FullRoundSuite
 x * TestFullRound1 - 1 val, full successful round
 x * TestFullRoundNil - 1 val, full round of nil
 x * TestFullRound2 - 2 vals, both required for full round
+x * TestFullRoundVoteExtensions - 2 vals, precommits carry extensions verified by the app
 LockSuite
 x * TestLockNoPOL - 2 vals, 4 rounds. one val locked, precommits nil every round except first.
 x * TestLockPOLRelock - 4 vals, one precommits, other 3 polka at next round, so we unlock and precomit the polka
@@ -171,6 +174,35 @@ func TestStateEnterProposeYesPrivValidator(t *testing.T) {
 	ensureNoNewTimeout(timeoutCh, cs.config.TimeoutPropose.Nanoseconds())
 }
 
+func TestStateBatchVerifyVotes(t *testing.T) {
+	cs1, vss := randState(4)
+
+	votes := signVotes(types.PrevoteType, nil, types.PartSetHeader{}, vss[1:]...)
+	votes[1].Signature = append([]byte{}, votes[1].Signature...)
+	votes[1].Signature[7] ^= byte(0x01)
+
+	// The votes waiting in the queue are verified with the first one.
+	msgs := []msgInfo{{&VoteMessage{votes[0]}, "peer"}}
+	for _, vote := range votes[1:] {
+		cs1.peerMsgQueue <- msgInfo{&VoteMessage{vote}, "peer"}
+	}
+	msgs = cs1.drainPeerMsgQueue(msgs)
+	require.Len(t, msgs, len(votes))
+
+	cs1.batchVerifyVotes(msgs)
+	assert.Equal(t, vss[1].GetPubKey(), cs1.verifiedVotes[votes[0]])
+	assert.NotContains(t, cs1.verifiedVotes, votes[1])
+	assert.Equal(t, vss[3].GetPubKey(), cs1.verifiedVotes[votes[2]])
+
+	for _, mi := range msgs {
+		cs1.handleMsg(mi)
+	}
+	prevotes := cs1.Votes.Prevotes(0)
+	assert.NotNil(t, prevotes.GetByIndex(vss[1].Index))
+	assert.Nil(t, prevotes.GetByIndex(vss[2].Index))
+	assert.NotNil(t, prevotes.GetByIndex(vss[3].Index))
+}
+
 func TestStateBadProposal(t *testing.T) {
 	cs1, vss := randState(2)
 	height, round := cs1.Height, cs1.Round
@@ -226,6 +258,57 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, types.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+// proposalApp adds a tx to the blocks it proposes, and rejects the blocks
+// which contain it.
+type proposalApp struct {
+	abci.BaseApplication
+}
+
+func (app *proposalApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	return abci.ResponsePrepareProposal{Txs: append(req.Txs, []byte("rejected"))}
+}
+
+func (app *proposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	for _, tx := range req.Txs {
+		if bytes.Equal(tx, []byte("rejected")) {
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+	}
+	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+}
+
+func TestStateProposalRejectedByApp(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	cs1 := newState(state, privVals[0], &proposalApp{})
+	vs1, vs2 := NewValidatorStub(privVals[0], 0), NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	// the app adds its tx to the block
+	propBlock, propBlockParts := cs1.createProposalBlock()
+	require.NotNil(t, propBlock)
+	assert.Equal(t, types.Tx("rejected"), propBlock.Txs[len(propBlock.Txs)-1])
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vs2)
+
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartsHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+	require.NoError(t, vs2.SignProposal(config.ChainID(), proposal))
+	require.NoError(t, cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"))
+
+	startTestRound(cs1, height, round)
+	ensureProposal(proposalCh, height, round, blockID)
+
+	// the app rejects the block, so we prevote nil
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vs1, nil)
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -251,8 +334,15 @@ func TestStateFullRound1(t *testing.T) {
 
 	ensureNewRound(newRoundCh, height, round)
 
-	ensureNewProposal(propCh, height, round)
-	propBlockHash := cs.GetRoundState().ProposalBlock.Hash()
+	// NOTE: take the block hash from the event, as the state is locked until
+	// we read its prevote
+	var propBlockHash []byte
+	select {
+	case msg := <-propCh:
+		propBlockHash = msg.Data().(types.EventDataCompleteProposal).BlockID.Hash
+	case <-time.After(ensureTimeout):
+		t.Fatal("Timeout expired while waiting for NewProposal event")
+	}
 
 	ensurePrevote(voteCh, height, round) // wait for prevote
 	validatePrevote(t, cs, round, vss[0], propBlockHash)
@@ -319,6 +409,70 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+// voteExtensionApp extends precommits with the height, and only accepts those.
+type voteExtensionApp struct {
+	abci.BaseApplication
+}
+
+func (app *voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: []byte(fmt.Sprintf("height=%d", req.Height))}
+}
+
+func (app *voteExtensionApp) VerifyVoteExtension(
+	req abci.RequestVerifyVoteExtension) abci.ResponseVerifyVoteExtension {
+	if !bytes.Equal(req.VoteExtension, []byte(fmt.Sprintf("height=%d", req.Height))) {
+		return abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}
+	}
+	return abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}
+}
+
+func TestStateFullRoundVoteExtensions(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	cs1 := newState(state, privVals[0], &voteExtensionApp{})
+	vs2 := NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartsHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	signAddVotes(cs1, types.PrevoteType, propBlockHash, propPartsHeader, vs2)
+	ensurePrevote(voteCh, height, round)
+
+	// our precommit is extended by the app
+	ensurePrecommit(voteCh, height, round)
+	ownPrecommit := cs1.GetRoundState().Votes.Precommits(round).GetByIndex(0)
+	require.NotNil(t, ownPrecommit)
+	assert.Equal(t, []byte("height=1"), ownPrecommit.Extension)
+
+	extendedPrecommit := func(ext string) *types.Vote {
+		vote := signVote(vs2, types.PrecommitType, propBlockHash, propPartsHeader)
+		vote.Extension = []byte(ext)
+		require.NoError(t, vs2.SignVote(config.ChainID(), vote))
+		return vote
+	}
+
+	// a precommit with an extension the app rejects is not added
+	cs1.peerMsgQueue <- msgInfo{&VoteMessage{extendedPrecommit("height=2")}, "peer"}
+	ensureNoNewEventOnChannel(voteCh)
+
+	cs1.peerMsgQueue <- msgInfo{&VoteMessage{extendedPrecommit("height=1")}, "peer"}
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	// the extensions are stored with the commit
+	seenCommit := cs1.blockStore.LoadSeenCommit(height)
+	require.NotNil(t, seenCommit)
+	for _, commitSig := range seenCommit.Signatures {
+		assert.Equal(t, []byte("height=1"), commitSig.Extension)
+	}
+}
+
 //------------------------------------------------------------------------------------------
 // LockSuite
 
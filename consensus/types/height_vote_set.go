@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/types"
 )
@@ -111,6 +112,17 @@ func (hvs *HeightVoteSet) addRound(round int) {
 // Duplicate votes return added=false, err=nil.
 // By convention, peerID is "" if origin is self.
 func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
+	return hvs.AddPreverifiedVote(vote, peerID, nil)
+}
+
+// AddPreverifiedVote is like AddVote, except that the signature of the vote
+// isn't verified again if it was verified with the key of its validator, see
+// VoteSet.AddPreverifiedVote.
+func (hvs *HeightVoteSet) AddPreverifiedVote(
+	vote *types.Vote,
+	peerID p2p.ID,
+	verifiedKey crypto.PubKey,
+) (added bool, err error) {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
 	if !types.IsVoteTypeValid(vote.Type) {
@@ -128,7 +140,7 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID) (added bool,
 			return
 		}
 	}
-	added, err = voteSet.AddVote(vote)
+	added, err = voteSet.AddPreverifiedVote(vote, verifiedKey)
 	return
 }
 
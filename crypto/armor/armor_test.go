@@ -18,3 +18,23 @@ func TestSimpleArmor(t *testing.T) {
 	assert.Equal(t, blockType, blockType2)
 	assert.Equal(t, data, data2)
 }
+
+func TestEncryptArmor(t *testing.T) {
+	blockType := "MINT TEST"
+	data := []byte("somedata")
+	armorStr := EncryptArmor(blockType, data, "passphrase")
+	assert.True(t, IsArmored([]byte(armorStr)))
+	assert.False(t, IsArmored(data))
+
+	blockType2, data2, err := UnarmorDecrypt(armorStr, "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, blockType, blockType2)
+	assert.Equal(t, data, data2)
+
+	_, _, err = UnarmorDecrypt(armorStr, "wrong passphrase")
+	assert.Error(t, err)
+
+	// a plain armor has no KDF
+	_, _, err = UnarmorDecrypt(EncodeArmor(blockType, nil, data), "passphrase")
+	assert.Error(t, err)
+}
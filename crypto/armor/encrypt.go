@@ -0,0 +1,76 @@
+package armor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+)
+
+const (
+	kdfScrypt = "scrypt"
+	saltLen   = 16
+	secretLen = 32
+
+	// scrypt parameters recommended for interactive logins in 2017.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// EncryptArmor encrypts data with a secret derived from passphrase with
+// scrypt, and returns the ASCII-armored ciphertext with the given block type.
+// The salt and the KDF are stored in the armor headers.
+func EncryptArmor(blockType string, data []byte, passphrase string) string {
+	salt := crypto.CRandBytes(saltLen)
+	secret, err := deriveSecret(passphrase, salt)
+	if err != nil {
+		panic(fmt.Errorf("could not derive secret: %s", err))
+	}
+	headers := map[string]string{
+		"kdf":  kdfScrypt,
+		"salt": fmt.Sprintf("%X", salt),
+	}
+	return EncodeArmor(blockType, headers, xsalsa20symmetric.EncryptSymmetric(data, secret))
+}
+
+// UnarmorDecrypt decodes an armor produced by EncryptArmor and decrypts its
+// data with passphrase. It returns the block type and the plaintext.
+func UnarmorDecrypt(armorStr string, passphrase string) (blockType string, data []byte, err error) {
+	blockType, headers, ciphertext, err := DecodeArmor(armorStr)
+	if err != nil {
+		return "", nil, err
+	}
+	if headers["kdf"] != kdfScrypt {
+		return "", nil, fmt.Errorf("unrecognized KDF %q", headers["kdf"])
+	}
+	salt, err := hex.DecodeString(headers["salt"])
+	if err != nil || len(salt) == 0 {
+		return "", nil, fmt.Errorf("invalid salt %q", headers["salt"])
+	}
+
+	secret, err := deriveSecret(passphrase, salt)
+	if err != nil {
+		return "", nil, err
+	}
+	data, err = xsalsa20symmetric.DecryptSymmetric(ciphertext, secret)
+	if err != nil {
+		return "", nil, errors.New("invalid passphrase or corrupted data")
+	}
+	return blockType, data, nil
+}
+
+// IsArmored returns true if bz looks like an ASCII armor, e.g. an encrypted
+// key file, rather than JSON.
+func IsArmored(bz []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(bz), []byte("-----BEGIN "))
+}
+
+func deriveSecret(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, secretLen)
+}
@@ -0,0 +1,35 @@
+// Package batch creates the batch verifiers of the key types supporting
+// batch verification.
+//
+// A key type supports it only if its batch verification accepts exactly the
+// signatures its VerifyBytes accepts, or else nodes verifying signatures in
+// batches could disagree with the others on the validity of blocks. Ed25519
+// keys don't: the batch verification equation is cofactored, while
+// VerifyBytes uses the cofactorless one, which rejects some signatures the
+// former accepts.
+package batch
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+// CreateBatchVerifier returns a new batch verifier for the type of the key,
+// and false if the key type doesn't support batch verification.
+func CreateBatchVerifier(pubKey crypto.PubKey) (crypto.BatchVerifier, bool) {
+	switch pubKey.(type) {
+	case sr25519.PubKeySr25519:
+		return sr25519.NewBatchVerifier(), true
+	}
+	return nil, false
+}
+
+// SupportsBatchVerifier returns true if the key type supports batch
+// verification.
+func SupportsBatchVerifier(pubKey crypto.PubKey) bool {
+	switch pubKey.(type) {
+	case sr25519.PubKeySr25519:
+		return true
+	}
+	return false
+}
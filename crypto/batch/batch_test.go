@@ -0,0 +1,129 @@
+package batch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+// batchKeyTypes are the key types supporting batch verification, by name.
+var batchKeyTypes = map[string]func() crypto.PrivKey{
+	"sr25519": func() crypto.PrivKey { return sr25519.GenPrivKey() },
+}
+
+func newBatchVerifier(t testing.TB, genPrivKey func() crypto.PrivKey) crypto.BatchVerifier {
+	bv, ok := CreateBatchVerifier(genPrivKey().PubKey())
+	require.True(t, ok)
+	return bv
+}
+
+func TestCreateBatchVerifier(t *testing.T) {
+	for name, genPrivKey := range batchKeyTypes {
+		assert.True(t, SupportsBatchVerifier(genPrivKey().PubKey()), name)
+	}
+
+	for _, pubKey := range []crypto.PubKey{ed25519.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()} {
+		assert.False(t, SupportsBatchVerifier(pubKey))
+		_, ok := CreateBatchVerifier(pubKey)
+		assert.False(t, ok)
+	}
+}
+
+func TestBatchSafe(t *testing.T) {
+	for name, genPrivKey := range batchKeyTypes {
+		t.Run(name, func(t *testing.T) {
+			v := newBatchVerifier(t, genPrivKey)
+
+			for i := 0; i <= 38; i++ {
+				priv := genPrivKey()
+
+				var msg []byte
+				if i%2 == 0 {
+					msg = []byte("easter")
+				} else {
+					msg = []byte("egg")
+				}
+
+				sig, err := priv.Sign(msg)
+				require.NoError(t, err)
+				require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+			}
+
+			ok, valid := v.Verify()
+			assert.True(t, ok)
+			assert.Len(t, valid, 39)
+		})
+	}
+}
+
+func TestBatchInvalidSignature(t *testing.T) {
+	for name, genPrivKey := range batchKeyTypes {
+		t.Run(name, func(t *testing.T) {
+			v := newBatchVerifier(t, genPrivKey)
+			priv := genPrivKey()
+
+			msgs := [][]byte{[]byte("foo"), []byte("bar"), []byte("baz")}
+			for i, msg := range msgs {
+				sig, err := priv.Sign(msg)
+				require.NoError(t, err)
+				if i == 1 {
+					sig[7] ^= byte(0x01)
+				}
+				require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+			}
+			// A malformed signature is invalid too.
+			require.NoError(t, v.Add(priv.PubKey(), msgs[0], []byte("sig")))
+
+			ok, valid := v.Verify()
+			assert.False(t, ok)
+			assert.Equal(t, []bool{true, false, true, false}, valid)
+
+			// Only keys of the batch verifier's type can be added.
+			assert.Error(t, v.Add(secp256k1.GenPrivKey().PubKey(), msgs[0], nil))
+		})
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	msg := []byte("BatchVerifyTest")
+
+	for name, genPrivKey := range batchKeyTypes {
+		for _, sigsCount := range []int{1, 8, 64, 1024} {
+			genPrivKey, sigsCount := genPrivKey, sigsCount
+			b.Run(fmt.Sprintf("%s-sig-count-%d", name, sigsCount), func(b *testing.B) {
+				// Pre-generate all of the keys, and signatures, so they are not
+				// part of the benchmark.
+				pubs := make([]crypto.PubKey, 0, sigsCount)
+				sigs := make([][]byte, 0, sigsCount)
+				for i := 0; i < sigsCount; i++ {
+					priv := genPrivKey()
+					sig, _ := priv.Sign(msg)
+					pubs = append(pubs, priv.PubKey())
+					sigs = append(sigs, sig)
+				}
+				b.ResetTimer()
+
+				b.ReportAllocs()
+				// NOTE: dividing by n so that metrics are per-signature
+				for i := 0; i < b.N/sigsCount; i++ {
+					v := newBatchVerifier(b, genPrivKey)
+					for i := 0; i < sigsCount; i++ {
+						err := v.Add(pubs[i], msg, sigs[i])
+						require.NoError(b, err)
+					}
+
+					if ok, _ := v.Verify(); !ok {
+						b.Fatal("signature set failed batch verification")
+					}
+				}
+			})
+		}
+	}
+}
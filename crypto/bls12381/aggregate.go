@@ -0,0 +1,72 @@
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+
+	bls "github.com/kilic/bls12-381"
+)
+
+// domain separates the hashes of the messages from other uses of hashing to
+// G2: it's the message augmentation ciphersuite of the BLS signature draft.
+var domain = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_")
+
+var groupOrder = bls.NewG1().Q()
+
+// hashToG2 hashes the message, prefixed with the public key which signs it.
+func hashToG2(g2 *bls.G2, pubKey PubKeyBls12381, msg []byte) (*bls.PointG2, error) {
+	augmented := make([]byte, 0, len(pubKey)+len(msg))
+	augmented = append(augmented, pubKey[:]...)
+	augmented = append(augmented, msg...)
+	return g2.HashToCurve(augmented, domain)
+}
+
+// AggregateSignatures aggregates the given signatures into one signature of
+// the same size, which can be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	g2 := bls.NewG2()
+	agg := g2.Zero()
+	for i, sig := range sigs {
+		p, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature #%d: %v", i, err)
+		}
+		g2.Add(agg, agg, p)
+	}
+	return g2.ToCompressed(agg), nil
+}
+
+// VerifyAggregateSignature verifies an aggregate of the signatures of each
+// message by the public key at the same index. The n+1 pairings are checked
+// at once with a multi-pairing, sharing the final exponentiation.
+// The messages need not be distinct, as each one is augmented with its public
+// key before being signed.
+func VerifyAggregateSignature(pubKeys []PubKeyBls12381, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	engine := bls.NewEngine()
+	aggSig, err := engine.G2.FromCompressed(sig)
+	if err != nil || engine.G2.IsZero(aggSig) {
+		return false
+	}
+
+	// e(g1, sig) == e(pk_1, H(pk_1 || msg_1)) * ... * e(pk_n, H(pk_n || msg_n))
+	engine.AddPairInv(engine.G1.One(), aggSig)
+	for i, pubKey := range pubKeys {
+		p, err := pubKey.point(engine.G1)
+		if err != nil {
+			return false
+		}
+		h, err := hashToG2(engine.G2, pubKey, msgs[i])
+		if err != nil {
+			return false
+		}
+		engine.AddPair(p, h)
+	}
+	return engine.Check()
+}
@@ -0,0 +1,82 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+)
+
+func TestSignAndValidateBls12381(t *testing.T) {
+
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	assert.Len(t, sig, bls12381.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// Another key or message doesn't verify.
+	assert.False(t, bls12381.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+	assert.False(t, pubKey.VerifyBytes(crypto.CRandBytes(128), sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+
+	assert.False(t, pubKey.VerifyBytes(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey := bls12381.GenPrivKeyFromSecret([]byte("secret"))
+	assert.Equal(t, privKey, bls12381.GenPrivKeyFromSecret([]byte("secret")))
+	assert.NotEqual(t, privKey, bls12381.GenPrivKeyFromSecret([]byte("other secret")))
+
+	var zero bls12381.PrivKeyBls12381
+	_, err := zero.Sign([]byte("msg"))
+	assert.Error(t, err)
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 4
+	var (
+		pubKeys = make([]bls12381.PubKeyBls12381, n)
+		msgs    = make([][]byte, n)
+		sigs    = make([][]byte, n)
+	)
+	for i := 0; i < n; i++ {
+		privKey := bls12381.GenPrivKey()
+		pubKeys[i] = privKey.PubKey().(bls12381.PubKeyBls12381)
+		msgs[i] = crypto.CRandBytes(32)
+		// The first two keys sign the same message.
+		if i == 1 {
+			msgs[i] = msgs[0]
+		}
+		sig, err := privKey.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.Len(t, aggSig, bls12381.SignatureSize)
+	assert.True(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, aggSig))
+
+	// A missing signer, a wrong message or a wrong signature doesn't verify.
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msgs[1:], aggSig))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, append([][]byte{msgs[2]}, msgs[1:]...), aggSig))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, sigs[0]))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, msgs[1:], aggSig))
+	assert.False(t, bls12381.VerifyAggregateSignature(nil, nil, aggSig))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	assert.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{sigs[0], []byte("invalid")})
+	assert.Error(t, err)
+}
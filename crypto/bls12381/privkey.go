@@ -0,0 +1,119 @@
+package bls12381
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	bls "github.com/kilic/bls12-381"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// PrivKeyBls12381Size is the number of bytes in a BLS12-381 private key.
+const PrivKeyBls12381Size = 32
+
+// PrivKeyBls12381 implements crypto.PrivKey. It's a big-endian scalar,
+// greater than zero and less than the order of the BLS12-381 groups.
+type PrivKeyBls12381 [PrivKeyBls12381Size]byte
+
+// Bytes marshals the privkey using amino encoding.
+func (privKey PrivKeyBls12381) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign produces a signature on the provided message. The message is
+// augmented with the public key, see VerifyBytes.
+func (privKey PrivKeyBls12381) Sign(msg []byte) ([]byte, error) {
+	sk, err := privKey.scalar()
+	if err != nil {
+		return nil, err
+	}
+	pubKey := privKey.PubKey().(PubKeyBls12381)
+
+	g2 := bls.NewG2()
+	h, err := hashToG2(g2, pubKey, msg)
+	if err != nil {
+		return nil, err
+	}
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, sk)), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+func (privKey PrivKeyBls12381) PubKey() crypto.PubKey {
+	sk, err := privKey.scalar()
+	if err != nil {
+		panic(err)
+	}
+	g1 := bls.NewG1()
+	var pubKey PubKeyBls12381
+	copy(pubKey[:], g1.ToCompressed(g1.MulScalarBig(g1.New(), g1.One(), sk)))
+	return pubKey
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeyBls12381) Equals(other crypto.PrivKey) bool {
+	if otherBls, ok := other.(PrivKeyBls12381); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBls[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeyBls12381) scalar() (*big.Int, error) {
+	sk := new(big.Int).SetBytes(privKey[:])
+	if sk.Sign() == 0 || sk.Cmp(groupOrder) >= 0 {
+		return nil, errors.New("invalid BLS12-381 private key")
+	}
+	return sk, nil
+}
+
+// GenPrivKey generates a new BLS12-381 private key.
+// It uses OS randomness in conjunction with the current global random seed
+// in tendermint/libs/common to generate the private key.
+func GenPrivKey() PrivKeyBls12381 {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeyBls12381 {
+	// Reducing 64 random bytes modulo the group order gives a negligible bias.
+	out := make([]byte, 64)
+	for {
+		_, err := io.ReadFull(rand, out)
+		if err != nil {
+			panic(err)
+		}
+		if privKey, ok := privKeyFromInt(new(big.Int).SetBytes(out)); ok {
+			return privKey
+		}
+	}
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKeyBls12381 {
+	seed := crypto.Sha256(secret) // Not Ripemd160 because we want 32 bytes.
+	for {
+		if privKey, ok := privKeyFromInt(new(big.Int).SetBytes(seed)); ok {
+			return privKey
+		}
+		seed = crypto.Sha256(seed)
+	}
+}
+
+// privKeyFromInt reduces n modulo the group order, and returns false if the
+// result is zero.
+func privKeyFromInt(n *big.Int) (PrivKeyBls12381, bool) {
+	var privKey PrivKeyBls12381
+	n.Mod(n, groupOrder)
+	if n.Sign() == 0 {
+		return privKey, false
+	}
+	bz := n.Bytes()
+	copy(privKey[PrivKeyBls12381Size-len(bz):], bz)
+	return privKey, true
+}
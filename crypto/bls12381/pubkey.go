@@ -0,0 +1,66 @@
+package bls12381
+
+import (
+	"bytes"
+	"fmt"
+
+	bls "github.com/kilic/bls12-381"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+var _ crypto.PubKey = PubKeyBls12381{}
+
+// PubKeyBls12381Size is the number of bytes in a BLS12-381 public key, a
+// compressed G1 point.
+const PubKeyBls12381Size = 48
+
+// PubKeyBls12381 implements crypto.PubKey for the BLS12-381 signature scheme.
+type PubKeyBls12381 [PubKeyBls12381Size]byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKeyBls12381) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey[:]))
+}
+
+// Bytes marshals the PubKey using amino encoding.
+func (pubKey PubKeyBls12381) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies a signature on the message augmented with the public
+// key, which makes it safe to aggregate signatures of the same message by
+// different keys (see VerifyAggregateSignature).
+func (pubKey PubKeyBls12381) VerifyBytes(msg []byte, sig []byte) bool {
+	return VerifyAggregateSignature([]PubKeyBls12381{pubKey}, [][]byte{msg}, sig)
+}
+
+func (pubKey PubKeyBls12381) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey[:])
+}
+
+// Equals - checks that two public keys are the same time
+// Runs in constant time based on length of the keys.
+func (pubKey PubKeyBls12381) Equals(other crypto.PubKey) bool {
+	if otherBls, ok := other.(PubKeyBls12381); ok {
+		return bytes.Equal(pubKey[:], otherBls[:])
+	}
+	return false
+}
+
+// point decodes the public key, which must not be the identity.
+func (pubKey PubKeyBls12381) point(g1 *bls.G1) (*bls.PointG1, error) {
+	p, err := g1.FromCompressed(pubKey[:])
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, fmt.Errorf("public key is the identity")
+	}
+	return p, nil
+}
@@ -33,6 +33,20 @@ type PrivKey interface {
 	Equals(PrivKey) bool
 }
 
+// BatchVerifier verifies many signatures at once, which is faster than
+// verifying them one by one if they are all valid. Key types supporting it
+// are registered in crypto/batch.
+type BatchVerifier interface {
+	// Add appends a signature of the message by the key to the batch. It
+	// returns an error if the key is of another type than the batch
+	// verifier's. Malformed signatures are added, and are invalid.
+	Add(key PubKey, msg []byte, sig []byte) error
+	// Verify returns true if all the signatures of the batch are valid.
+	// Otherwise, the signatures are verified one by one and the returned
+	// slice tells which ones are valid, in the order they were added.
+	Verify() (bool, []bool)
+}
+
 type Symmetric interface {
 	Keygen() []byte
 	Encrypt(plaintext []byte, secret []byte) (ciphertext []byte)
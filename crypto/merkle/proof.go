@@ -22,6 +22,18 @@ type ProofOperator interface {
 	ProofOp() ProofOp
 }
 
+// RangeProofOperator is a ProofOperator which takes the values of all the
+// keys of a tree in a range as arguments. The keys are not part of the key
+// path, and GetKey returns nil.
+// GetRange() returns the range [start, end) of keys it proves, where a nil
+// end means the range has no upper bound, and GetKeys() the keys in the
+// range, in the order of the arguments of Run().
+type RangeProofOperator interface {
+	ProofOperator
+	GetRange() (start, end []byte)
+	GetKeys() [][]byte
+}
+
 //----------------------------------------
 // Operations on a list of ProofOperators
 
@@ -66,6 +78,40 @@ func (poz ProofOperators) Verify(root []byte, keypath string, args [][]byte) (er
 	return nil
 }
 
+// VerifyRange verifies that keys are all the keys in [start, end) of the
+// innermost tree, whose values are values. The first operator must be a
+// RangeProofOperator, and keypath is the key path of the tree, without the
+// keys of the range.
+func (poz ProofOperators) VerifyRange(root []byte, keypath string, start, end []byte,
+	keys [][]byte, values [][]byte) error {
+	if len(poz) == 0 {
+		return errors.New("empty proof")
+	}
+	rop, ok := poz[0].(RangeProofOperator)
+	if !ok {
+		return errors.Errorf("expected a range proof operator, got %T", poz[0])
+	}
+
+	opStart, opEnd := rop.GetRange()
+	if !bytes.Equal(opStart, start) || !bytes.Equal(opEnd, end) || (opEnd == nil) != (end == nil) {
+		return errors.Errorf("range mismatch: expected %X-%X but got %X-%X", start, end, opStart, opEnd)
+	}
+	opKeys := rop.GetKeys()
+	if len(opKeys) != len(keys) {
+		return errors.Errorf("expected %v keys but got %v", len(keys), len(opKeys))
+	}
+	for i, key := range keys {
+		if !bytes.Equal(opKeys[i], key) {
+			return errors.Errorf("key mismatch at #%d: expected %X but got %X", i, key, opKeys[i])
+		}
+	}
+	if len(values) != len(keys) {
+		return errors.Errorf("expected %v values but got %v", len(keys), len(values))
+	}
+
+	return poz.Verify(root, keypath, values)
+}
+
 //----------------------------------------
 // ProofRuntime - main entrypoint
 
@@ -119,6 +165,18 @@ func (prt *ProofRuntime) VerifyAbsence(proof *Proof, root []byte, keypath string
 	return prt.Verify(proof, root, keypath, nil)
 }
 
+// VerifyRange verifies that keys are all the keys in [start, end) of the
+// innermost tree of the proof, with the given values. See
+// ProofOperators.VerifyRange.
+func (prt *ProofRuntime) VerifyRange(proof *Proof, root []byte, keypath string, start, end []byte,
+	keys [][]byte, values [][]byte) (err error) {
+	poz, err := prt.DecodeProof(proof)
+	if err != nil {
+		return errors.Wrap(err, "decoding proof")
+	}
+	return poz.VerifyRange(root, keypath, start, end, keys, values)
+}
+
 func (prt *ProofRuntime) Verify(proof *Proof, root []byte, keypath string, args [][]byte) (err error) {
 	poz, err := prt.DecodeProof(proof)
 	if err != nil {
@@ -128,11 +186,27 @@ func (prt *ProofRuntime) Verify(proof *Proof, root []byte, keypath string, args
 }
 
 // DefaultProofRuntime only knows about Simple value
-// proofs.
+// and range proofs.
 // To use e.g. IAVL proofs, register op-decoders as
 // defined in the IAVL package.
 func DefaultProofRuntime() (prt *ProofRuntime) {
 	prt = NewProofRuntime()
 	prt.RegisterOpDecoder(ProofOpSimpleValue, SimpleValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpSimpleRange, SimpleRangeOpDecoder)
 	return
 }
+
+// EncodeRangeValues encodes the values of the keys proven by a range proof,
+// e.g. to return them in an ABCI query response.
+func EncodeRangeValues(values [][]byte) []byte {
+	return cdc.MustMarshalBinaryBare(values)
+}
+
+// DecodeRangeValues decodes values encoded with EncodeRangeValues.
+func DecodeRangeValues(bz []byte) ([][]byte, error) {
+	var values [][]byte
+	if err := cdc.UnmarshalBinaryBare(bz, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
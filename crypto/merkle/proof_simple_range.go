@@ -0,0 +1,261 @@
+package merkle
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	ProofOpSimpleRange = "simple:r"
+
+	// maxRangeProofLeaves is the maximum number of leaves of the tree of a
+	// SimpleRangeOp, so that the indices of its leaves can't overflow.
+	maxRangeProofLeaves = math.MaxInt32
+)
+
+// SimpleRangeOp takes the values of all the keys of a SimpleMap tree in the
+// range [Start, End) as arguments and produces the root hash. A nil End
+// means the range has no upper bound.
+//
+// The leaves of the keys in the range are contiguous, as the tree is sorted
+// by key. The leaves right before and after them, if any, are included (as
+// the key and the hash of the value) to prove that no other key is in the
+// range, along with the hashes of the subtrees of the other leaves.
+//
+// If the produced root hash matches the expected hash, the proof is good.
+type SimpleRangeOp struct {
+	Start []byte   `json:"start"`
+	End   []byte   `json:"end"`
+	Keys  [][]byte `json:"keys"`
+
+	Total int `json:"total"` // Total number of leaves of the tree.
+	Index int `json:"index"` // Index of the leaf of the first key in the range.
+
+	LeftKey        []byte `json:"left_key"`
+	LeftValueHash  []byte `json:"left_value_hash"`
+	RightKey       []byte `json:"right_key"`
+	RightValueHash []byte `json:"right_value_hash"`
+
+	// Hashes of the subtrees without any of the leaves above, in depth-first
+	// order.
+	Siblings [][]byte `json:"siblings"`
+}
+
+var _ RangeProofOperator = SimpleRangeOp{}
+
+func SimpleRangeOpDecoder(pop ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpSimpleRange {
+		return nil, errors.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpSimpleRange)
+	}
+	var op SimpleRangeOp
+	err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding ProofOp.Data into SimpleRangeOp")
+	}
+	return op, nil
+}
+
+// SimpleRangeProofFromMap returns the root hash of the SimpleMap tree of m,
+// and a proof of the values of all its keys in [start, end), along with the
+// values, in the order of the keys of the proof.
+func SimpleRangeProofFromMap(m map[string][]byte, start, end []byte) (rootHash []byte, op SimpleRangeOp, values [][]byte) {
+	sm := newSimpleMap()
+	for k, v := range m {
+		sm.Set(k, v)
+	}
+	sm.Sort()
+	kvs := sm.kvs
+
+	items := make([][]byte, len(kvs))
+	for i, kvp := range kvs {
+		items[i] = KVPair(kvp).Bytes()
+	}
+
+	op = SimpleRangeOp{
+		Start: start,
+		End:   end,
+		Total: len(kvs),
+	}
+	for op.Index < len(kvs) && bytes.Compare(kvs[op.Index].Key, start) < 0 {
+		op.Index++
+	}
+	hi := op.Index
+	for hi < len(kvs) && keyInRange(kvs[hi].Key, start, end) {
+		op.Keys = append(op.Keys, kvs[hi].Key)
+		values = append(values, m[string(kvs[hi].Key)])
+		hi++
+	}
+
+	lo := op.Index
+	if lo > 0 {
+		lo--
+		op.LeftKey, op.LeftValueHash = kvs[lo].Key, kvs[lo].Value
+	}
+	if hi < len(kvs) {
+		op.RightKey, op.RightValueHash = kvs[hi].Key, kvs[hi].Value
+		hi++
+	}
+
+	rootHash = rangeSiblingsFromByteSlices(items, 0, lo, hi, &op.Siblings)
+	return rootHash, op, values
+}
+
+func (op SimpleRangeOp) ProofOp() ProofOp {
+	bz := cdc.MustMarshalBinaryLengthPrefixed(op)
+	return ProofOp{
+		Type: ProofOpSimpleRange,
+		Data: bz,
+	}
+}
+
+func (op SimpleRangeOp) String() string {
+	return fmt.Sprintf("SimpleRangeOp{%X-%X, %d keys}", op.Start, op.End, len(op.Keys))
+}
+
+func (op SimpleRangeOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != len(op.Keys) {
+		return nil, errors.Errorf("expected %v args, got %v", len(op.Keys), len(args))
+	}
+	if err := op.validateBasic(); err != nil {
+		return nil, err
+	}
+	if op.Total == 0 {
+		if len(op.Siblings) != 0 {
+			return nil, errors.New("unexpected siblings in a proof of an empty tree")
+		}
+		return [][]byte{SimpleHashFromByteSlices(nil)}, nil
+	}
+
+	leafHashes := make([][]byte, 0, len(op.Keys)+2)
+	lo := op.Index
+	if lo > 0 {
+		lo--
+		leafHashes = append(leafHashes, kvLeafHash(op.LeftKey, op.LeftValueHash))
+	}
+	for i, key := range op.Keys {
+		leafHashes = append(leafHashes, kvLeafHash(key, tmhash.Sum(args[i])))
+	}
+	if op.Index+len(op.Keys) < op.Total {
+		leafHashes = append(leafHashes, kvLeafHash(op.RightKey, op.RightValueHash))
+	}
+
+	next := 0
+	root, err := computeRangeHash(op.Total, 0, lo, leafHashes, op.Siblings, &next)
+	if err != nil {
+		return nil, err
+	}
+	if next != len(op.Siblings) {
+		return nil, errors.Errorf("expected %v siblings, got %v", next, len(op.Siblings))
+	}
+	return [][]byte{root}, nil
+}
+
+// GetKey returns nil: the keys of the range aren't part of the key path.
+func (op SimpleRangeOp) GetKey() []byte {
+	return nil
+}
+
+func (op SimpleRangeOp) GetRange() (start, end []byte) {
+	return op.Start, op.End
+}
+
+func (op SimpleRangeOp) GetKeys() [][]byte {
+	return op.Keys
+}
+
+// validateBasic checks that the keys are sorted and in the range, and that
+// the leaves right before and after them are out of the range.
+func (op SimpleRangeOp) validateBasic() error {
+	if op.Total < 0 || op.Total > maxRangeProofLeaves {
+		return errors.Errorf("invalid number of leaves %v", op.Total)
+	}
+	// NOTE: not op.Index+len(op.Keys) > op.Total, which may overflow.
+	if op.Index < 0 || op.Index > op.Total || len(op.Keys) > op.Total-op.Index {
+		return errors.Errorf("invalid index %v of %v keys in a tree of %v leaves", op.Index, len(op.Keys), op.Total)
+	}
+	if len(op.Siblings) > 2*MaxAunts {
+		return errors.Errorf("too many siblings: %v", len(op.Siblings))
+	}
+	for i, key := range op.Keys {
+		if !keyInRange(key, op.Start, op.End) {
+			return errors.Errorf("key %X is out of range", key)
+		}
+		if i > 0 && bytes.Compare(op.Keys[i-1], key) >= 0 {
+			return errors.New("keys are not sorted")
+		}
+	}
+	if op.Index > 0 && bytes.Compare(op.LeftKey, op.Start) >= 0 {
+		return errors.Errorf("left key %X is in the range", op.LeftKey)
+	}
+	if op.Index+len(op.Keys) < op.Total && keyInRange(op.RightKey, op.Start, op.End) {
+		return errors.Errorf("right key %X is in the range", op.RightKey)
+	}
+	return nil
+}
+
+// keyInRange returns true if start <= key < end, or start <= key if end is
+// nil.
+func keyInRange(key, start, end []byte) bool {
+	return bytes.Compare(key, start) >= 0 && (end == nil || bytes.Compare(key, end) < 0)
+}
+
+func kvLeafHash(key, valueHash []byte) []byte {
+	bz := new(bytes.Buffer)
+	encodeByteSlice(bz, key)       // does not error
+	encodeByteSlice(bz, valueHash) // does not error
+	return leafHash(bz.Bytes())
+}
+
+// computeRangeHash returns the hash of the subtree of total leaves starting
+// at offset, given the hashes of the contiguous leaves starting at lo and the
+// hashes of the other subtrees in depth-first order.
+func computeRangeHash(total, offset, lo int, leafHashes [][]byte, siblings [][]byte, next *int) ([]byte, error) {
+	hi := lo + len(leafHashes)
+	if offset+total <= lo || offset >= hi {
+		if *next >= len(siblings) {
+			return nil, errors.New("missing siblings")
+		}
+		hash := siblings[*next]
+		*next++
+		return hash, nil
+	}
+	if total == 1 {
+		return leafHashes[offset-lo], nil
+	}
+	k := getSplitPoint(total)
+	left, err := computeRangeHash(k, offset, lo, leafHashes, siblings, next)
+	if err != nil {
+		return nil, err
+	}
+	right, err := computeRangeHash(total-k, offset+k, lo, leafHashes, siblings, next)
+	if err != nil {
+		return nil, err
+	}
+	return innerHash(left, right), nil
+}
+
+// rangeSiblingsFromByteSlices returns the hash of the tree of items starting
+// at offset, appending to siblings the hashes of its subtrees without any of
+// the items in [lo, hi), in depth-first order.
+func rangeSiblingsFromByteSlices(items [][]byte, offset, lo, hi int, siblings *[][]byte) []byte {
+	switch {
+	case len(items) == 0:
+		return SimpleHashFromByteSlices(nil)
+	case offset+len(items) <= lo || offset >= hi:
+		hash := SimpleHashFromByteSlices(items)
+		*siblings = append(*siblings, hash)
+		return hash
+	case len(items) == 1:
+		return leafHash(items[0])
+	default:
+		k := getSplitPoint(len(items))
+		left := rangeSiblingsFromByteSlices(items[:k], offset, lo, hi, siblings)
+		right := rangeSiblingsFromByteSlices(items[k:], offset+k, lo, hi, siblings)
+		return innerHash(left, right)
+	}
+}
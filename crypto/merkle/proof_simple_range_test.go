@@ -0,0 +1,132 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeRangeProof returns a proof of the keys of m in [start, end), in a store
+// "store" of a root SimpleMap tree, and the root hash of that tree.
+func makeRangeProof(m map[string][]byte, start, end []byte) (root []byte, proof *Proof, op SimpleRangeOp,
+	values [][]byte) {
+	storeHash, op, values := SimpleRangeProofFromMap(m, start, end)
+	root, proofs, _ := SimpleProofsFromMap(map[string][]byte{"store": storeHash, "other": []byte("other")})
+	proof = &Proof{Ops: []ProofOp{
+		op.ProofOp(),
+		NewSimpleValueOp([]byte("store"), proofs["store"]).ProofOp(),
+	}}
+	return root, proof, op, values
+}
+
+func TestSimpleRangeOp(t *testing.T) {
+	prt := DefaultProofRuntime()
+
+	for _, n := range []int{0, 1, 2, 7, 16} {
+		m := make(map[string][]byte, n)
+		for i := 0; i < n; i++ {
+			m[fmt.Sprintf("key%02d", i)] = []byte(fmt.Sprintf("value%d", i))
+		}
+
+		ranges := [][2][]byte{
+			{nil, nil},
+			{bz("key"), bz("key00")},
+			{bz("key00"), bz("key01")},
+			{bz("key01"), bz("key05")},
+			{bz("key02"), bz("key02x")},
+			{bz("key03"), nil},
+			{bz("key15"), bz("key99")},
+			{bz("key99"), nil},
+		}
+		for _, r := range ranges {
+			start, end := r[0], r[1]
+			root, proof, op, values := makeRangeProof(m, start, end)
+
+			var keys [][]byte
+			for k := range m {
+				if keyInRange([]byte(k), start, end) {
+					keys = append(keys, []byte(k))
+				}
+			}
+			require.Len(t, op.Keys, len(keys), "%d keys in %q-%q", n, start, end)
+			for i, key := range op.Keys {
+				assert.Equal(t, m[string(key)], values[i])
+			}
+
+			err := prt.VerifyRange(proof, root, "/store", start, end, op.Keys, values)
+			require.NoError(t, err, "%d keys in %q-%q", n, start, end)
+
+			if len(values) > 0 {
+				// wrong value
+				badValues := append([][]byte{}, values...)
+				badValues[0] = []byte("bad")
+				assert.Error(t, prt.VerifyRange(proof, root, "/store", start, end, op.Keys, badValues))
+
+				// missing key
+				badOp := op
+				badOp.Keys = op.Keys[1:]
+				badProof := &Proof{Ops: []ProofOp{badOp.ProofOp(), proof.Ops[1]}}
+				assert.Error(t, prt.VerifyRange(badProof, root, "/store", start, end, badOp.Keys, values[1:]))
+			}
+
+			// wrong range or key path
+			assert.Error(t, prt.VerifyRange(proof, root, "/store", start, bz("other"), op.Keys, values))
+			assert.Error(t, prt.VerifyRange(proof, root, "/other", start, end, op.Keys, values))
+		}
+	}
+}
+
+func TestSimpleRangeOpOutOfRangeNeighbor(t *testing.T) {
+	m := map[string][]byte{"a": bz("1"), "b": bz("2"), "c": bz("3")}
+	root, proof, op, values := makeRangeProof(m, bz("b"), bz("c"))
+	require.NoError(t, DefaultProofRuntime().VerifyRange(proof, root, "/store", bz("b"), bz("c"), op.Keys, values))
+
+	// claiming a wider range than proven must fail, as "c" is in it
+	op.End = bz("d")
+	badProof := &Proof{Ops: []ProofOp{op.ProofOp(), proof.Ops[1]}}
+	err := DefaultProofRuntime().VerifyRange(badProof, root, "/store", bz("b"), bz("d"), op.Keys, values)
+	assert.Error(t, err)
+}
+
+func TestSimpleRangeOpForgedIndex(t *testing.T) {
+	m := map[string][]byte{"a": bz("1"), "b": bz("2"), "c": bz("3")}
+	storeHash, _, _ := SimpleRangeProofFromMap(m, nil, nil)
+	root, proofs, _ := SimpleProofsFromMap(map[string][]byte{"store": storeHash, "other": []byte("other")})
+	storeOp := NewSimpleValueOp([]byte("store"), proofs["store"]).ProofOp()
+
+	// an index overflowing with the number of keys would make the whole tree
+	// out of the range, so the root would be the sibling
+	maxInt := int(^uint(0) >> 1)
+	for _, index := range []int{maxInt, maxInt - 1, 4} {
+		op := SimpleRangeOp{
+			Keys:     [][]byte{bz("x"), bz("y")},
+			Total:    3,
+			Index:    index,
+			Siblings: [][]byte{storeHash},
+		}
+		values := [][]byte{bz("forged"), bz("forged")}
+		proof := &Proof{Ops: []ProofOp{op.ProofOp(), storeOp}}
+		err := DefaultProofRuntime().VerifyRange(proof, root, "/store", nil, nil, op.Keys, values)
+		assert.Error(t, err, "index %d", index)
+	}
+
+	// so would too many leaves
+	total := maxRangeProofLeaves
+	total++
+	op := SimpleRangeOp{Total: total, Index: total, Siblings: [][]byte{storeHash}}
+	proof := &Proof{Ops: []ProofOp{op.ProofOp(), storeOp}}
+	assert.Error(t, DefaultProofRuntime().VerifyRange(proof, root, "/store", nil, nil, nil, nil))
+}
+
+func TestRangeValuesEncoding(t *testing.T) {
+	for _, values := range [][][]byte{nil, {bz("a")}, {bz("a"), bz("bc")}} {
+		decoded, err := DecodeRangeValues(EncodeRangeValues(values))
+		require.NoError(t, err)
+		assert.Equal(t, len(values), len(decoded))
+		for i := range values {
+			assert.Equal(t, values[i], decoded[i])
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package sr25519
+
+import (
+	"fmt"
+
+	"github.com/oasisprotocol/curve25519-voi/primitives/sr25519"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// signingCtx is the signing context of the signatures, as in VerifyBytes.
+var signingCtx = sr25519.NewSigningContext([]byte{})
+
+// BatchVerifier implements crypto.BatchVerifier for Sr25519 keys.
+type BatchVerifier struct {
+	bv      *sr25519.BatchVerifier
+	pubKeys []PubKeySr25519
+	msgs    [][]byte
+	sigs    [][]byte
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{bv: sr25519.NewBatchVerifier()}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg []byte, sig []byte) error {
+	pubKey, ok := key.(PubKeySr25519)
+	if !ok {
+		return fmt.Errorf("pubkey is not Sr25519: %T", key)
+	}
+
+	// Malformed keys and signatures are left uninitialized, which makes the
+	// batch invalid.
+	var (
+		pk        sr25519.PublicKey
+		signature sr25519.Signature
+	)
+	_ = pk.UnmarshalBinary(pubKey[:])
+	_ = signature.UnmarshalBinary(sig)
+	b.bv.Add(&pk, signingCtx.NewTranscriptBytes(msg), &signature)
+
+	b.pubKeys = append(b.pubKeys, pubKey)
+	b.msgs = append(b.msgs, msg)
+	b.sigs = append(b.sigs, sig)
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier. The signatures are verified one by
+// one with VerifyBytes if the batch doesn't verify.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.sigs))
+	if len(b.sigs) > 0 && b.bv.VerifyBatchOnly(crypto.CReader()) {
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	allValid := len(b.sigs) > 0
+	for i, pubKey := range b.pubKeys {
+		valid[i] = pubKey.VerifyBytes(b.msgs[i], b.sigs[i])
+		allValid = allValid && valid[i]
+	}
+	return allValid, valid
+}
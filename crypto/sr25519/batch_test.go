@@ -0,0 +1,106 @@
+package sr25519
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// scalarOrder is the order of the Ristretto group, in little-endian.
+var scalarOrder = [32]byte{
+	0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+}
+
+// TestBatchAgreesWithVerifyBytes checks that the batch verifier, which uses
+// another library than VerifyBytes, gives the same results for non-canonical
+// and malformed signatures and keys, and that the batch equation accepts
+// none of the signatures VerifyBytes rejects.
+func TestBatchAgreesWithVerifyBytes(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey().(PubKeySr25519)
+	msg := []byte("message")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	withSig := func(f func(sig []byte)) []byte {
+		bz := append([]byte{}, sig...)
+		f(bz)
+		return bz
+	}
+	zeroPubKey := PubKeySr25519{}
+	// Both the public key and R are the identity, and s is zero.
+	identitySig := make([]byte, SignatureSize)
+	identitySig[63] |= 128
+
+	testCases := []struct {
+		name   string
+		pubKey PubKeySr25519
+		msg    []byte
+		sig    []byte
+
+		valid      bool // accepted by VerifyBytes
+		batchValid bool // accepted by the batch equation
+	}{
+		{"valid", pubKey, msg, sig, true, true},
+		{"wrong message", pubKey, []byte("other message"), sig, false, false},
+		{"short", pubKey, msg, sig[:63], false, false},
+		// VerifyBytes doesn't check the marker of Sr25519 signatures, so the
+		// batch fails and the signatures are verified one by one.
+		{"unmarked", pubKey, msg, withSig(func(sig []byte) { sig[63] &= 127 }), true, false},
+		{"non-canonical s", pubKey, msg, withSig(func(sig []byte) {
+			// s + l < 2^255, as s < l < 2^253
+			sig[63] &= 127
+			carry := 0
+			for i := range scalarOrder {
+				sum := int(sig[32+i]) + int(scalarOrder[i]) + carry
+				sig[32+i], carry = byte(sum), sum>>8
+			}
+			sig[63] |= 128
+		}), false, false},
+		{"non-canonical R", pubKey, msg, withSig(func(sig []byte) {
+			for i := 0; i < 31; i++ {
+				sig[i] = 0xff
+			}
+			sig[31] = 0x7f
+		}), false, false},
+		{"negative R", pubKey, msg, withSig(func(sig []byte) {
+			copy(sig[:32], []byte{1})
+			for i := 1; i < 32; i++ {
+				sig[i] = 0
+			}
+		}), false, false},
+		{"malformed public key", PubKeySr25519{1}, msg, sig, false, false},
+		{"identity public key", zeroPubKey, msg, identitySig, true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			expected := tc.pubKey.VerifyBytes(tc.msg, tc.sig)
+			require.Equal(t, tc.valid, expected)
+
+			// alone, and with valid signatures
+			for _, n := range []int{0, 2} {
+				v := NewBatchVerifier()
+				require.NoError(t, v.Add(tc.pubKey, tc.msg, tc.sig))
+				for i := 0; i < n; i++ {
+					require.NoError(t, v.Add(pubKey, msg, sig))
+				}
+
+				// the batch equation alone
+				assert.Equal(t, tc.batchValid, v.bv.VerifyBatchOnly(crypto.CReader()))
+
+				ok, valid := v.Verify()
+				assert.Equal(t, expected, ok)
+				assert.Equal(t, expected, valid[0])
+				for i := 0; i < n; i++ {
+					assert.True(t, valid[1+i])
+				}
+			}
+		})
+	}
+}
@@ -94,8 +94,21 @@ func (evpool *Pool) Update(block *types.Block, state sm.State) {
 	evpool.MarkEvidenceAsCommitted(block.Height, block.Time, block.Evidence.Evidence)
 }
 
-// AddEvidence checks the evidence is valid and adds it to the pool.
+// AddEvidence checks the evidence is valid and adds it to the pool. Composite
+// evidence (e.g. ConflictingHeadersEvidence) is split up, and the evidence
+// against each validator is added instead.
 func (evpool *Pool) AddEvidence(evidence types.Evidence) (err error) {
+	// check the evidence is well-formed and recent before loading anything
+	if err := evidence.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid evidence: %v", err)
+	}
+	if err := sm.VerifyEvidenceAge(evpool.State(), evidence); err != nil {
+		return err
+	}
+
+	if ce, ok := evidence.(types.CompositeEvidence); ok {
+		return evpool.addCompositeEvidence(ce)
+	}
 
 	// TODO: check if we already have evidence for this
 	// validator at this height so we dont get spammed
@@ -124,6 +137,31 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) (err error) {
 	return nil
 }
 
+func (evpool *Pool) addCompositeEvidence(evidence types.CompositeEvidence) error {
+	valset, err := sm.LoadValidators(evpool.stateDB, evidence.Height())
+	if err != nil {
+		return err
+	}
+
+	if err := evidence.VerifyComposite(evpool.State().ChainID, valset); err != nil {
+		return err
+	}
+
+	evList := evidence.Split(valset)
+	if len(evList) == 0 {
+		return fmt.Errorf("no validator can be held accountable for %v", evidence)
+	}
+
+	evpool.logger.Info("Splitting composite evidence", "evidence", evidence, "n", len(evList))
+	for _, ev := range evList {
+		if err := evpool.AddEvidence(ev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarkEvidenceAsCommitted marks all the evidence as committed and removes it from the queue.
 func (evpool *Pool) MarkEvidenceAsCommitted(height int64, lastBlockTime time.Time, evidence []types.Evidence) {
 	// make a map of committed evidence to remove from the clist
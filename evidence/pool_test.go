@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -148,3 +150,94 @@ func TestAddEvidence(t *testing.T) {
 		}
 	}
 }
+
+func TestAddConflictingHeadersEvidence(t *testing.T) {
+	const chainID = "test_chain"
+	var (
+		height           = int64(10)
+		valSet, privVals = types.RandValidatorSet(3, 10)
+		stateDB          = dbm.NewMemDB()
+		evidenceDB       = dbm.NewMemDB()
+	)
+
+	state := sm.State{
+		ChainID:                     chainID,
+		LastBlockTime:               tmtime.Now(),
+		Validators:                  valSet,
+		NextValidators:              valSet.CopyIncrementProposerPriority(1),
+		LastHeightValidatorsChanged: 1,
+		ConsensusParams: types.ConsensusParams{
+			Evidence: types.EvidenceParams{
+				MaxAgeNumBlocks: 10000,
+				MaxAgeDuration:  48 * time.Hour,
+			},
+		},
+	}
+	for i := int64(0); i <= height; i++ {
+		state.LastBlockHeight = i
+		sm.SaveState(stateDB, state)
+	}
+	pool := NewPool(stateDB, evidenceDB)
+
+	ev := &types.ConflictingHeadersEvidence{
+		H1: makeSignedHeader(t, chainID, height, valSet, privVals, []byte("app_hash1")),
+		H2: makeSignedHeader(t, chainID, height, valSet, privVals, []byte("app_hash2")),
+	}
+	require.NoError(t, pool.AddEvidence(ev))
+
+	// the evidence was split into one piece per validator
+	pending := pool.PendingEvidence(-1)
+	require.Len(t, pending, 3)
+	for _, pev := range pending {
+		assert.IsType(t, &types.DuplicateVoteEvidence{}, pev)
+		assert.True(t, valSet.HasAddress(pev.Address()))
+	}
+
+	// evidence for another chain is rejected
+	ev = &types.ConflictingHeadersEvidence{
+		H1: makeSignedHeader(t, "other_chain", height, valSet, privVals, []byte("app_hash1")),
+		H2: makeSignedHeader(t, "other_chain", height, valSet, privVals, []byte("app_hash2")),
+	}
+	assert.Error(t, pool.AddEvidence(ev))
+
+	// malformed evidence is rejected without panicking
+	for _, ev := range []*types.ConflictingHeadersEvidence{
+		{},
+		{H1: makeSignedHeader(t, chainID, height, valSet, privVals, []byte("app_hash1"))},
+		{H1: &types.SignedHeader{}, H2: &types.SignedHeader{}},
+	} {
+		err := pool.AddEvidence(ev)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "invalid evidence")
+		}
+	}
+
+	// and so is expired evidence, before looking up the validators
+	h1 := makeSignedHeader(t, chainID, height+1, valSet, privVals, []byte("app_hash1"))
+	h2 := makeSignedHeader(t, chainID, height+1, valSet, privVals, []byte("app_hash2"))
+	h1.Time = state.LastBlockTime.Add(-49 * time.Hour)
+	err := pool.AddEvidence(&types.ConflictingHeadersEvidence{H1: h1, H2: h2})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "has expired")
+	}
+	assert.Len(t, pool.PendingEvidence(-1), 3)
+}
+
+func makeSignedHeader(t *testing.T, chainID string, height int64, valSet *types.ValidatorSet,
+	privVals []types.PrivValidator, appHash []byte) *types.SignedHeader {
+	header := &types.Header{
+		ChainID:        chainID,
+		Height:         height,
+		Time:           tmtime.Now(),
+		ValidatorsHash: valSet.Hash(),
+		AppHash:        appHash,
+	}
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := types.NewVoteSet(chainID, height, 0, types.PrecommitType, valSet)
+	commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	return &types.SignedHeader{Header: header, Commit: commit}
+}
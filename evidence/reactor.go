@@ -7,6 +7,8 @@ import (
 
 	amino "github.com/tendermint/go-amino"
 
+	"github.com/tendermint/tendermint/behaviour"
+
 	clist "github.com/tendermint/tendermint/libs/clist"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
@@ -27,6 +29,7 @@ type Reactor struct {
 	p2p.BaseReactor
 	evpool   *Pool
 	eventBus *types.EventBus
+	reporter behaviour.Reporter
 }
 
 // NewReactor returns a new Reactor with the given config and evpool.
@@ -44,6 +47,13 @@ func (evR *Reactor) SetLogger(l log.Logger) {
 	evR.evpool.SetLogger(l)
 }
 
+// SetSwitch implements Reactor.
+// It reports peers which send invalid evidence to the given switch.
+func (evR *Reactor) SetSwitch(sw *p2p.Switch) {
+	evR.BaseReactor.SetSwitch(sw)
+	evR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // GetChannels implements Reactor.
 // It returns the list of channels for this reactor.
 func (evR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
@@ -66,13 +76,13 @@ func (evR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		evR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(evR.reporter, evR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		evR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		evR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(evR.reporter, evR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 
@@ -85,7 +95,7 @@ func (evR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			if err != nil {
 				evR.Logger.Info("Evidence is not valid", "evidence", msg.Evidence, "err", err)
 				// punish peer
-				evR.Switch.StopPeerForError(src, err)
+				behaviour.ReportOrStop(evR.reporter, evR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 			}
 		}
 	default:
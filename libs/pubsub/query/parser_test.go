@@ -84,6 +84,22 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"account.balance=100 OR slashing.amount EXISTS", true},
+		{"account.balance=100 OR", false},
+		{"OR account.balance=100", false},
+		{"NOT account.balance=100", true},
+		{"NOT(account.balance=100)", true},
+		{"NOT NOT account.balance=100", true},
+		{"NOT", false},
+		{"account.balance=100 AND NOT", false},
+		{"(account.balance=100)", true},
+		{"( account.balance=100 OR account.balance=200 ) AND tm.event='Tx'", true},
+		{"NOT (account.balance=100 OR (slashing EXISTS AND tm.event='Tx'))", true},
+		{"(account.balance=100", false},
+		{"account.balance=100)", false},
+		{"()", false},
+		{"NOT = 5", true},
 	}
 
 	for _, c := range cases {
@@ -2,6 +2,11 @@
 //
 //		abci.invoice.number=22 AND abci.invoice.owner=Ivan
 //
+// Conditions can be combined with AND, OR and NOT, and grouped with
+// parentheses. NOT binds tighter than AND, which binds tighter than OR:
+//
+//		tm.event='Tx' AND (transfer.sender='Ivan' OR NOT transfer.amount < 10)
+//
 // See query.peg for the grammar, which is a https://en.wikipedia.org/wiki/Parsing_expression_grammar.
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
 //
@@ -23,10 +28,10 @@ var (
 	numRegex = regexp.MustCompile(`([0-9\.]+)`)
 )
 
-// Query holds the query string and the query parser.
+// Query holds the query string and the parsed query expression.
 type Query struct {
-	str    string
-	parser *QueryParser
+	str  string
+	expr expression
 }
 
 // Condition represents a single condition within a query and consists of composite key
@@ -45,7 +50,11 @@ func New(s string) (*Query, error) {
 	if err := p.Parse(); err != nil {
 		return nil, err
 	}
-	return &Query{str: s, parser: p}, nil
+	expr, err := compile(p.AST(), p.buffer)
+	if err != nil {
+		return nil, err
+	}
+	return &Query{str: s, expr: expr}, nil
 }
 
 // MustParse turns the given string into a query or panics; for tests or others
@@ -91,106 +100,68 @@ const (
 	TimeLayout = time.RFC3339
 )
 
-// Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
+// Conditions returns a list of all conditions in the query, in the order they
+// appear, regardless of how they are combined. It returns an error if there is
+// any error with the provided grammar in the Query.
 func (q *Query) Conditions() ([]Condition, error) {
-	var (
-		eventAttr string
-		op        Operator
-	)
-
 	conditions := make([]Condition, 0)
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-
-		case ruleexists:
-			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
+	q.expr.walk(func(c Condition) {
+		conditions = append(conditions, c)
+	})
+	return conditions, nil
+}
 
-				conditions = append(conditions, Condition{eventAttr, op, value})
+// RequiredConditions returns the conditions joined by AND at the top level of
+// the query, which any match must satisfy. complete is true if the query
+// consists only of these conditions, i.e. it has no OR or NOT at the top level;
+// otherwise, candidates must still be checked with Matches.
+func (q *Query) RequiredConditions() (conditions []Condition, complete bool, err error) {
+	switch e := q.expr.(type) {
+	case Condition:
+		return []Condition{e}, true, nil
+	case and:
+		conditions = make([]Condition, 0, len(e))
+		complete = true
+		for _, operand := range e {
+			if c, ok := operand.(Condition); ok {
+				conditions = append(conditions, c)
 			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return nil, err
+				complete = false
 			}
+		}
+		return conditions, complete, nil
+	default:
+		return []Condition{}, false, nil
+	}
+}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
-
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return nil, err
-			}
+// NegatedConditions returns the conditions of the query which are negated by
+// NOT, in the order they appear.
+func (q *Query) NegatedConditions() []Condition {
+	conditions := make([]Condition, 0)
+	walkNegated(q.expr, false, func(c Condition) {
+		conditions = append(conditions, c)
+	})
+	return conditions
+}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+func walkNegated(e expression, negated bool, fn func(Condition)) {
+	switch e := e.(type) {
+	case and:
+		for _, operand := range e {
+			walkNegated(operand, negated, fn)
+		}
+	case or:
+		for _, operand := range e {
+			walkNegated(operand, negated, fn)
+		}
+	case not:
+		walkNegated(e.operand, !negated, fn)
+	case Condition:
+		if negated {
+			fn(e)
 		}
 	}
-
-	return conditions, nil
 }
 
 // Matches returns true if the query matches against any event in the given set
@@ -204,161 +175,232 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 	if len(events) == 0 {
 		return false, nil
 	}
+	return q.expr.matches(events)
+}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
+// expression is a node of a parsed query: a Condition, or a combination of
+// expressions with AND, OR or NOT.
+type expression interface {
+	matches(events map[string][]string) (bool, error)
+	// walk calls fn for every condition in the expression, in order.
+	walk(fn func(Condition))
+}
 
-	buffer, begin, end := q.parser.Buffer, 0, 0
+type and []expression
 
-	// tokens must be in the following order:
+func (e and) matches(events map[string][]string) (bool, error) {
+	for _, operand := range e {
+		match, err := operand.matches(events)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+	return true, nil
+}
 
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
+func (e and) walk(fn func(Condition)) {
+	for _, operand := range e {
+		operand.walk(fn)
+	}
+}
 
-		case ruletag:
-			eventAttr = buffer[begin:end]
+type or []expression
 
-		case rulele:
-			op = OpLessEqual
+// matches returns true if any operand matches. Operands which fail with an
+// error don't match, and the first error is returned only if none matches.
+func (e or) matches(events map[string][]string) (bool, error) {
+	var firstErr error
+	for _, operand := range e {
+		match, err := operand.matches(events)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, firstErr
+}
 
-		case rulege:
-			op = OpGreaterEqual
+func (e or) walk(fn func(Condition)) {
+	for _, operand := range e {
+		operand.walk(fn)
+	}
+}
 
-		case rulel:
-			op = OpLess
+type not struct {
+	operand expression
+}
 
-		case ruleg:
-			op = OpGreater
+func (e not) matches(events map[string][]string) (bool, error) {
+	match, err := e.operand.matches(events)
+	if err != nil {
+		return false, err
+	}
+	return !match, nil
+}
 
-		case ruleequal:
-			op = OpEqual
+func (e not) walk(fn func(Condition)) {
+	e.operand.walk(fn)
+}
 
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
+func (c Condition) matches(events map[string][]string) (bool, error) {
+	return c.Matches(events)
+}
 
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
-			}
+func (c Condition) walk(fn func(Condition)) {
+	fn(c)
+}
 
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
+// compile converts the syntax tree of a query into an expression.
+func compile(node *node32, buffer []rune) (expression, error) {
+	text := func(n *node32) string {
+		// use the captured text (e.g. without quotes or the "TIME " prefix), if any
+		if n.up != nil && n.up.pegRule == rulePegText {
+			n = n.up
+		}
+		return string(buffer[n.begin:n.end])
+	}
 
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
-				return false, err
+	switch node.pegRule {
+	case rulee, rulegroup:
+		for child := node.up; child != nil; child = child.next {
+			if child.pegRule == ruledisjunction {
+				return compile(child, buffer)
 			}
+		}
+		return nil, errors.New("missing expression")
 
-			if !match {
-				return false, nil
+	case ruledisjunction, ruleconjunction:
+		operands := make([]expression, 0)
+		for child := node.up; child != nil; child = child.next {
+			if child.pegRule == ruleor || child.pegRule == ruleand {
+				continue
 			}
+			operand, err := compile(child, buffer)
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, operand)
+		}
+		switch {
+		case len(operands) == 1:
+			return operands[0], nil
+		case node.pegRule == ruledisjunction:
+			return or(operands), nil
+		default:
+			return and(operands), nil
+		}
 
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
+	case ruleterm:
+		child := node.up
+		if child.pegRule == rulenot {
+			operand, err := compile(child.next, buffer)
+			if err != nil {
+				return nil, err
+			}
+			return not{operand}, nil
+		}
+		return compile(child, buffer)
+
+	case rulecondition:
+		var c Condition
+		for child := node.up; child != nil; child = child.next {
+			switch child.pegRule {
+			case ruletag:
+				c.CompositeKey = text(child)
+			case rulele:
+				c.Op = OpLessEqual
+			case rulege:
+				c.Op = OpGreaterEqual
+			case rulel:
+				c.Op = OpLess
+			case ruleg:
+				c.Op = OpGreater
+			case ruleequal:
+				c.Op = OpEqual
+			case rulecontains:
+				c.Op = OpContains
+			case ruleexists:
+				c.Op = OpExists
+			case rulevalue:
+				// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
+				value := text(child)
+				c.Operand = value[1 : len(value)-1]
+			case rulenumber:
+				number := text(child)
+				if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
+					value, err := strconv.ParseFloat(number, 64)
+					if err != nil {
+						return nil, fmt.Errorf(
+							"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
+							err, number,
+						)
+					}
+					c.Operand = value
+				} else {
+					value, err := strconv.ParseInt(number, 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf(
+							"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
+							err, number,
+						)
+					}
+					c.Operand = value
 				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
+			case ruletime:
+				value, err := time.Parse(TimeLayout, text(child))
 				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
+					return nil, fmt.Errorf(
+						"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
+						err, text(child),
 					)
-					return false, err
 				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
+				c.Operand = value
+			case ruledate:
+				value, err := time.Parse(DateLayout, text(child))
 				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
+					return nil, fmt.Errorf(
+						"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
+						err, text(child),
+					)
 				}
+				c.Operand = value
 			}
+		}
+		return c, nil
 
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
-
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
-
-			if !match {
-				return false, nil
-			}
-
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+	default:
+		return nil, fmt.Errorf("unexpected %v in query", rul3s[node.pegRule])
+	}
+}
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+// Matches returns true if the condition matches any value of its composite key
+// in the given set of events. See Query.Matches for the matching rules.
+func (c Condition) Matches(events map[string][]string) (bool, error) {
+	if c.Op == OpExists {
+		return exists(c.CompositeKey, events), nil
+	}
+	return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
+}
 
-			if !match {
-				return false, nil
-			}
+// exists returns true if the given attribute ("type.attribute") or event type
+// ("type") is present in the events.
+func exists(eventAttr string, events map[string][]string) bool {
+	if strings.Contains(eventAttr, ".") {
+		// Searching for a full "type.attribute" event.
+		_, ok := events[eventAttr]
+		return ok
+	}
+	for compositeKey := range events {
+		if strings.HasPrefix(compositeKey, eventAttr+".") {
+			return true
 		}
 	}
-
-	return true, nil
+	return false
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
@@ -118,10 +118,11 @@ func TestMatches(t *testing.T) {
 			true,
 			false,
 		},
+		// event types are matched as a whole
 		{"sl EXISTS",
 			map[string][]string{"slash.reason": {"missing_signature"}, "slash.power": {"6000"}},
 			false,
-			true,
+			false,
 			false,
 		},
 		{"slash EXISTS",
@@ -150,6 +151,52 @@ func TestMatches(t *testing.T) {
 			false,
 			false,
 		},
+		{"tx.gas < 7 OR tx.gas > 7", map[string][]string{"tx.gas": {"8"}}, false, true, false},
+		{"tx.gas < 7 OR tx.gas > 8", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"NOT tx.gas = 8", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"NOT tx.gas = 7", map[string][]string{"tx.gas": {"8"}}, false, true, false},
+		{"NOT slash EXISTS", map[string][]string{"tx.gas": {"8"}}, false, true, false},
+		{
+			"tm.event = 'Tx' AND (transfer.sender = 'Ivan' OR transfer.recipient = 'Ivan')",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.sender": {"Igor"}, "transfer.recipient": {"Ivan"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"tm.event = 'Tx' AND (transfer.sender = 'Ivan' OR transfer.recipient = 'Ivan')",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.sender": {"Igor"}, "transfer.recipient": {"Pavel"}},
+			false,
+			false,
+			false,
+		},
+		{
+			// AND binds tighter than OR
+			"tm.event = 'NewBlock' AND transfer.sender = 'Ivan' OR transfer.recipient = 'Ivan'",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.recipient": {"Ivan"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"tm.event = 'NewBlock' AND (transfer.sender = 'Ivan' OR transfer.recipient = 'Ivan')",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.recipient": {"Ivan"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"NOT (tx.gas > 7 AND tx.gas < 9) AND tm.event = 'Tx'",
+			map[string][]string{"tm.event": {"Tx"}, "tx.gas": {"9"}},
+			false,
+			true,
+			false,
+		},
+		{"NOT tx.gas > 7", map[string][]string{"tx.gas": {"abc"}}, false, false, true},
+		// operands of OR which fail don't prevent the others from matching
+		{"tx.gas > 7 OR tm.event = 'Tx'", map[string][]string{"tm.event": {"Tx"}, "tx.gas": {"abc"}}, false, true, false},
+		{"tx.gas > 7 OR tm.event = 'Tx'", map[string][]string{"tm.event": {"NewBlock"}, "tx.gas": {"abc"}},
+			false, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -171,6 +218,46 @@ func TestMatches(t *testing.T) {
 	}
 }
 
+func TestRequiredConditions(t *testing.T) {
+	testCases := []struct {
+		s          string
+		conditions []query.Condition
+		complete   bool
+	}{
+		{
+			"tx.gas > 7 AND tx.gas < 9",
+			[]query.Condition{
+				{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)},
+				{CompositeKey: "tx.gas", Op: query.OpLess, Operand: int64(9)},
+			},
+			true,
+		},
+		{
+			"tm.event = 'Tx' AND (tx.gas > 7 OR tx.gas < 3) AND NOT slashing EXISTS",
+			[]query.Condition{
+				{CompositeKey: "tm.event", Op: query.OpEqual, Operand: "Tx"},
+			},
+			false,
+		},
+		{"tx.gas > 7 OR tx.gas < 3", []query.Condition{}, false},
+		{"NOT slashing EXISTS", []query.Condition{}, false},
+		{
+			"(tx.gas > 7)",
+			[]query.Condition{
+				{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		conditions, complete, err := query.MustParse(tc.s).RequiredConditions()
+		require.NoError(t, err)
+		assert.Equal(t, tc.conditions, conditions, tc.s)
+		assert.Equal(t, tc.complete, complete, tc.s)
+	}
+}
+
 func TestMustParse(t *testing.T) {
 	assert.Panics(t, func() { query.MustParse("=") })
 	assert.NotPanics(t, func() { query.MustParse("tm.events.type='NewBlock'") })
@@ -209,6 +296,14 @@ func TestConditions(t *testing.T) {
 				{CompositeKey: "slashing", Op: query.OpExists},
 			},
 		},
+		{
+			s: "NOT (tx.gas > 7 OR slashing EXISTS) AND tm.events.type='NewBlock'",
+			conditions: []query.Condition{
+				{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)},
+				{CompositeKey: "slashing", Op: query.OpExists},
+				{CompositeKey: "tm.events.type", Op: query.OpEqual, Operand: "NewBlock"},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -220,3 +315,44 @@ func TestConditions(t *testing.T) {
 		assert.Equal(t, tc.conditions, c)
 	}
 }
+
+func TestNegatedConditions(t *testing.T) {
+	q := query.MustParse("NOT (tx.gas > 7 OR slashing EXISTS) AND tm.event = 'Tx' AND NOT NOT account.owner = 'Ivan'")
+	assert.Equal(t, []query.Condition{
+		{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)},
+		{CompositeKey: "slashing", Op: query.OpExists},
+	}, q.NegatedConditions())
+}
+
+func TestConditionMatches(t *testing.T) {
+	events := map[string][]string{
+		"tx.gas":         {"7"},
+		"account.owner":  {"Ivan", "Igor"},
+		"slashing.power": {"6"},
+	}
+
+	testCases := []struct {
+		s       string
+		matches bool
+	}{
+		{"tx.gas = 7", true},
+		{"tx.gas > 7", false},
+		{"account.owner = 'Igor'", true},
+		{"account.owner CONTAINS 'va'", true},
+		{"account.owner = 'Vlad'", false},
+		{"slashing EXISTS", true},
+		{"slash EXISTS", false},
+		{"slashing.reason EXISTS", false},
+		{"tx.height = 1", false},
+	}
+
+	for _, tc := range testCases {
+		c, err := query.MustParse(tc.s).Conditions()
+		require.NoError(t, err)
+		require.Len(t, c, 1)
+
+		matches, err := c[0].Matches(events)
+		require.NoError(t, err)
+		assert.Equal(t, tc.matches, matches, tc.s)
+	}
+}
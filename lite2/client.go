@@ -609,11 +609,6 @@ func (c *Client) VerifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 		return errors.Errorf("header at more recent height #%d exists", c.trustedHeader.Height)
 	}
 
-	if err := c.compareNewHeaderWithWitnesses(newHeader); err != nil {
-		c.logger.Error("Error when comparing new header with one from a witness", "err", err)
-		return err
-	}
-
 	var err error
 	switch c.verificationMode {
 	case sequential:
@@ -627,6 +622,11 @@ func (c *Client) VerifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 		return err
 	}
 
+	if err := c.compareNewHeaderWithWitnesses(newHeader, newVals); err != nil {
+		c.logger.Error("Error when comparing new header with one from a witness", "err", err)
+		return err
+	}
+
 	// Update trusted header and vals.
 	nextVals, err := c.validatorSetFromPrimary(newHeader.Height + 1)
 	if err != nil {
@@ -907,7 +907,7 @@ func (c *Client) backwards(toHeight int64, fromHeader *types.SignedHeader, now t
 }
 
 // compare header with all witnesses provided.
-func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader) error {
+func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader, vals *types.ValidatorSet) error {
 	c.providerMutex.Lock()
 	defer c.providerMutex.Unlock()
 	// 0. Check witnesses exist
@@ -926,18 +926,43 @@ func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader) error {
 		}
 
 		// 3. Compare hashes.
-		if !bytes.Equal(h.Hash(), altH.Hash()) {
-			// TODO: One of the providers is lying. Send the evidence to fork
-			// accountability server.
-			return errors.Errorf(
-				"header hash %X does not match one %X from the witness %v",
+		if bytes.Equal(h.Hash(), altH.Hash()) {
+			continue
+		}
+
+		// 4. If the witness' header is signed by more than 1/3 of the
+		// validators (h is already verified), this is a fork: report it to all
+		// providers. Otherwise, the witness is faulty.
+		if err := altH.ValidateBasic(c.chainID); err != nil {
+			return errors.Wrapf(err, "invalid header #%d from the witness %v", h.Height, witness)
+		}
+		err = vals.VerifyCommitTrusting(c.chainID, altH.Commit.BlockID, altH.Height, altH.Commit,
+			tmmath.Fraction{Numerator: 1, Denominator: 3})
+		if err != nil {
+			return errors.Wrapf(err,
+				"header hash %X does not match one %X from the witness %v, which is not signed by enough validators",
 				h.Hash(), altH.Hash(), witness)
 		}
+
+		ev := &types.ConflictingHeadersEvidence{H1: h, H2: altH}
+		c.reportEvidence(ev)
+		return ErrConflictingHeaders{H1: h, H2: altH, Witness: witness}
 	}
 
 	return nil
 }
 
+// reportEvidence sends the evidence to the primary and all witnesses.
+// NOTE: requires providerMutex to be locked.
+func (c *Client) reportEvidence(ev types.Evidence) {
+	for _, p := range append([]provider.Provider{c.primary}, c.witnesses...) {
+		if err := p.ReportEvidence(ev); err != nil {
+			c.logger.Error("Failed to report evidence", "evidence", ev, "provider", p, "err", err)
+		}
+	}
+	c.logger.Info("Reported evidence", "evidence", ev)
+}
+
 func (c *Client) removeNoLongerTrustedHeadersRoutine() {
 	defer c.routinesWaitGroup.Done()
 
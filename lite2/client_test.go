@@ -1033,3 +1033,78 @@ func Test_NewClientFromTrustedStore(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, 1, h.Height)
 }
+
+func TestClient_ReportsConflictingHeaders(t *testing.T) {
+	const (
+		chainID = "TestClient_ReportsConflictingHeaders"
+	)
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+		primary = mockp.New(
+			chainID,
+			map[int64]*types.SignedHeader{
+				1: header,
+				2: keys.GenSignedHeader(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+					[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+			},
+			map[int64]*types.ValidatorSet{
+				1: vals,
+				2: vals,
+				3: vals,
+			},
+		)
+		// the same validators signed a different header at height 2
+		witness = mockp.New(
+			chainID,
+			map[int64]*types.SignedHeader{
+				1: header,
+				2: keys.GenSignedHeader(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+					[]byte("app_hash2"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+			},
+			map[int64]*types.ValidatorSet{
+				1: vals,
+				2: vals,
+				3: vals,
+			},
+		)
+	)
+
+	c, err := NewClient(
+		chainID,
+		TrustOptions{
+			Period: 4 * time.Hour,
+			Height: 1,
+			Hash:   header.Hash(),
+		},
+		primary,
+		[]provider.Provider{witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		UpdatePeriod(0),
+		Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+	err = c.Start()
+	require.NoError(t, err)
+	defer c.Stop()
+
+	_, err = c.VerifyHeaderAtHeight(2, bTime.Add(2*time.Hour))
+	require.Error(t, err)
+	assert.IsType(t, ErrConflictingHeaders{}, err)
+
+	// both the primary and the witness received the evidence
+	for _, p := range []*mockp.Mock{primary, witness} {
+		evList := p.Evidence()
+		require.Len(t, evList, 1)
+		assert.IsType(t, &types.ConflictingHeadersEvidence{}, evList[0])
+	}
+
+	// the conflicting header was not saved
+	h, err := c.TrustedHeader(2, bTime.Add(2*time.Hour))
+	assert.Error(t, err)
+	assert.Nil(t, h)
+}
@@ -90,6 +90,14 @@ Example usage:
 		}
 		fmt.Println("got header", h)
 
+Each newly verified header is compared with the headers of the same height
+returned by the witnesses. If a witness returns a different header signed by
+more than 1/3 of the trusted validators, the chain has forked (or the light
+client is under attack): ConflictingHeadersEvidence is sent to the primary and
+all witnesses, and ErrConflictingHeaders is returned. Full nodes split such
+evidence into DuplicateVoteEvidence against each validator which signed both
+headers.
+
 ## 2. Pure functions to verify a new header (see verifier.go)
 
 Verify function verifies a new header against some trusted header. See
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/lite2/provider"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -28,3 +29,18 @@ type ErrNewValSetCantBeTrusted struct {
 func (e ErrNewValSetCantBeTrusted) Error() string {
 	return fmt.Sprintf("cant trust new val set: %v", e.Reason)
 }
+
+// ErrConflictingHeaders means the witness returned a different header than
+// the primary (H1) for the same height, which is also signed by more than 1/3
+// of the validators, i.e. there is a fork. ConflictingHeadersEvidence has been
+// reported to the primary and the witnesses.
+type ErrConflictingHeaders struct {
+	H1      *types.SignedHeader
+	H2      *types.SignedHeader
+	Witness provider.Provider
+}
+
+func (e ErrConflictingHeaders) Error() string {
+	return fmt.Sprintf("header hash %X does not match one %X from the witness %v",
+		e.H1.Hash(), e.H2.Hash(), e.Witness)
+}
@@ -1,4 +1,4 @@
-package lite
+package lite_test
 
 import (
 	"fmt"
@@ -12,6 +12,7 @@ import (
 
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	"github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
 	"github.com/tendermint/tendermint/lite2/provider"
 	httpp "github.com/tendermint/tendermint/lite2/provider/http"
 	dbs "github.com/tendermint/tendermint/lite2/store/db"
@@ -49,9 +50,9 @@ func TestExample_Client_AutoUpdate(t *testing.T) {
 		stdlog.Fatal(err)
 	}
 
-	c, err := NewClient(
+	c, err := lite.NewClient(
 		chainID,
-		TrustOptions{
+		lite.TrustOptions{
 			Period: 504 * time.Hour, // 21 days
 			Height: 2,
 			Hash:   header.Hash(),
@@ -59,8 +60,8 @@ func TestExample_Client_AutoUpdate(t *testing.T) {
 		primary,
 		[]provider.Provider{primary}, // TODO: primary should not be used here
 		dbs.New(db, chainID),
-		UpdatePeriod(1*time.Second),
-		Logger(log.TestingLogger()),
+		lite.UpdatePeriod(1*time.Second),
+		lite.Logger(log.TestingLogger()),
 	)
 	if err != nil {
 		stdlog.Fatal(err)
@@ -116,9 +117,9 @@ func TestExample_Client_ManualUpdate(t *testing.T) {
 		stdlog.Fatal(err)
 	}
 
-	c, err := NewClient(
+	c, err := lite.NewClient(
 		chainID,
-		TrustOptions{
+		lite.TrustOptions{
 			Period: 504 * time.Hour, // 21 days
 			Height: 2,
 			Hash:   header.Hash(),
@@ -126,8 +127,8 @@ func TestExample_Client_ManualUpdate(t *testing.T) {
 		primary,
 		[]provider.Provider{primary}, // TODO: primary should not be used here
 		dbs.New(db, chainID),
-		UpdatePeriod(0),
-		Logger(log.TestingLogger()),
+		lite.UpdatePeriod(0),
+		lite.Logger(log.TestingLogger()),
 	)
 	if err != nil {
 		stdlog.Fatal(err)
@@ -9,10 +9,11 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
-// SignStatusClient combines a SignClient and StatusClient.
+// SignStatusClient combines a SignClient, StatusClient and EvidenceClient.
 type SignStatusClient interface {
 	rpcclient.SignClient
 	rpcclient.StatusClient
+	rpcclient.EvidenceClient
 }
 
 // http provider uses an RPC client (or SignStatusClient more generally) to
@@ -108,6 +109,12 @@ func (p *http) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	return types.NewValidatorSet(vals), nil
 }
 
+// ReportEvidence submits the evidence to the node via broadcast_evidence.
+func (p *http) ReportEvidence(ev types.Evidence) error {
+	_, err := p.client.BroadcastEvidence(ev)
+	return err
+}
+
 func validateHeight(height int64) (*int64, error) {
 	if height < 0 {
 		return nil, fmt.Errorf("expected height >= 0, got height %d", height)
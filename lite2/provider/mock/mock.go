@@ -1,33 +1,40 @@
 package mock
 
 import (
+	"sync"
+
 	"github.com/pkg/errors"
 
 	"github.com/tendermint/tendermint/lite2/provider"
 	"github.com/tendermint/tendermint/types"
 )
 
-type mock struct {
+// Mock is a provider which serves the given headers and validator sets, and
+// records reported evidence.
+type Mock struct {
 	chainID string
 	headers map[int64]*types.SignedHeader
 	vals    map[int64]*types.ValidatorSet
+
+	mtx      sync.Mutex
+	evidence []types.Evidence
 }
 
 // New creates a mock provider with the given set of headers and validator
 // sets.
-func New(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*types.ValidatorSet) provider.Provider {
-	return &mock{
+func New(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*types.ValidatorSet) *Mock {
+	return &Mock{
 		chainID: chainID,
 		headers: headers,
 		vals:    vals,
 	}
 }
 
-func (p *mock) ChainID() string {
+func (p *Mock) ChainID() string {
 	return p.chainID
 }
 
-func (p *mock) SignedHeader(height int64) (*types.SignedHeader, error) {
+func (p *Mock) SignedHeader(height int64) (*types.SignedHeader, error) {
 	if height == 0 && len(p.headers) > 0 {
 		return p.headers[int64(len(p.headers))], nil
 	}
@@ -37,7 +44,7 @@ func (p *mock) SignedHeader(height int64) (*types.SignedHeader, error) {
 	return nil, provider.ErrSignedHeaderNotFound
 }
 
-func (p *mock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
+func (p *Mock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	if height == 0 && len(p.vals) > 0 {
 		return p.vals[int64(len(p.vals))], nil
 	}
@@ -47,6 +54,21 @@ func (p *mock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	return nil, provider.ErrValidatorSetNotFound
 }
 
+// ReportEvidence records the evidence.
+func (p *Mock) ReportEvidence(ev types.Evidence) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.evidence = append(p.evidence, ev)
+	return nil
+}
+
+// Evidence returns the evidence reported so far.
+func (p *Mock) Evidence() []types.Evidence {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.evidence
+}
+
 type deadMock struct {
 	chainID string
 }
@@ -67,3 +89,7 @@ func (p *deadMock) SignedHeader(height int64) (*types.SignedHeader, error) {
 func (p *deadMock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	return nil, errors.New("no response from provider")
 }
+
+func (p *deadMock) ReportEvidence(ev types.Evidence) error {
+	return errors.New("no response from provider")
+}
@@ -30,4 +30,8 @@ type Provider interface {
 	// If there's no ValidatorSet for the given height, ErrValidatorSetNotFound
 	// error is returned.
 	ValidatorSet(height int64) (*types.ValidatorSet, error)
+
+	// ReportEvidence reports evidence of misbehaviour, such as conflicting
+	// headers, to the provider (i.e. the full node).
+	ReportEvidence(ev types.Evidence) error
 }
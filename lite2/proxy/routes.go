@@ -25,8 +25,9 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"block":                rpcserver.NewRPCFunc(makeBlockFunc(c), "height"),
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height"),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
+		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
-		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
+		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by,cursor,count_total"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page"),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
@@ -122,12 +123,22 @@ func makeTxFunc(c *lrpc.Client) rpcTxFunc {
 }
 
 type rpcTxSearchFunc func(ctx *rpctypes.Context, query string, prove bool,
-	page, perPage int, orderBy string) (*ctypes.ResultTxSearch, error)
+	page, perPage int, orderBy, cursor string, countTotal bool) (*ctypes.ResultTxSearch, error)
 
 func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
-	return func(ctx *rpctypes.Context, query string, prove bool, page, perPage int, orderBy string) (
-		*ctypes.ResultTxSearch, error) {
-		return c.TxSearch(query, prove, page, perPage, orderBy)
+	return func(ctx *rpctypes.Context, query string, prove bool, page, perPage int, orderBy, cursor string,
+		countTotal bool) (*ctypes.ResultTxSearch, error) {
+		return c.TxSearch(query, prove, page, perPage, orderBy, cursor, countTotal)
+	}
+}
+
+type rpcBlockSearchFunc func(ctx *rpctypes.Context, query string,
+	page, perPage int, orderBy string) (*ctypes.ResultBlockSearch, error)
+
+func makeBlockSearchFunc(c *lrpc.Client) rpcBlockSearchFunc {
+	return func(ctx *rpctypes.Context, query string, page, perPage int, orderBy string) (
+		*ctypes.ResultBlockSearch, error) {
+		return c.BlockSearch(query, page, perPage, orderBy)
 	}
 }
 
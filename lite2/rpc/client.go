@@ -11,6 +11,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/kv"
 	service "github.com/tendermint/tendermint/libs/service"
 	lite "github.com/tendermint/tendermint/lite2"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -31,14 +32,30 @@ type Client struct {
 
 var _ rpcclient.Client = (*Client)(nil)
 
+// Option sets a parameter for the client.
+type Option func(*Client)
+
+// ProofRuntime option sets the proof runtime used to verify the proofs of
+// ABCI queries, e.g. one with the decoders of the proof operators of the app
+// (such as IAVL existence and absence proofs) registered. Default:
+// merkle.DefaultProofRuntime().
+func ProofRuntime(prt *merkle.ProofRuntime) Option {
+	return func(c *Client) {
+		c.prt = prt
+	}
+}
+
 // NewClient returns a new client.
-func NewClient(next rpcclient.Client, lc *lite.Client) *Client {
+func NewClient(next rpcclient.Client, lc *lite.Client, opts ...Option) *Client {
 	c := &Client{
 		next: next,
 		lc:   lc,
-		prt:  defaultProofRuntime(),
+		prt:  merkle.DefaultProofRuntime(),
 	}
 	c.BaseService = *service.NewBaseService(nil, "Client", c)
+	for _, o := range opts {
+		o(c)
+	}
 	return c
 }
 
@@ -96,17 +113,17 @@ func (c *Client) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
 		return nil, err
 	}
 
+	storeName, err := parseQueryStorePath(path, "key")
+	if err != nil {
+		return nil, err
+	}
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
+
 	// Validate the value proof against the trusted header.
 	if resp.Value != nil {
 		// Value exists
-		// XXX How do we encode the key into a string...
-		storeName, err := parseQueryStorePath(path)
-		if err != nil {
-			return nil, err
-		}
-		kp := merkle.KeyPath{}
-		kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-		kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
 		err = c.prt.VerifyValue(resp.Proof, h.AppHash, kp.String(), resp.Value)
 		if err != nil {
 			return nil, errors.Wrap(err, "verify value proof")
@@ -114,15 +131,84 @@ func (c *Client) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
 		return &ctypes.ResultABCIQuery{Response: resp}, nil
 	}
 
-	// OR validate the ansence proof against the trusted header.
-	// XXX How do we encode the key into a string...
-	err = c.prt.VerifyAbsence(resp.Proof, h.AppHash, string(resp.Key))
+	// OR validate the absence proof against the trusted header.
+	err = c.prt.VerifyAbsence(resp.Proof, h.AppHash, kp.String())
 	if err != nil {
 		return nil, errors.Wrap(err, "verify absence proof")
 	}
 	return &ctypes.ResultABCIQuery{Response: resp}, nil
 }
 
+// ABCIQueryRangeWithOptions queries all the keys in [start, end) of a store
+// (end may be nil for no upper bound) and verifies the response against a
+// trusted header. The path must be like /store/<storeName>/range, and data
+// is the request of the app for that range.
+//
+// The response value must be the values of the keys, encoded with
+// merkle.EncodeRangeValues, and the first operator of its proof a
+// merkle.RangeProofOperator over the keys of the store. The verified keys and
+// values are returned along with the response.
+func (c *Client) ABCIQueryRangeWithOptions(path string, data tmbytes.HexBytes, start, end []byte,
+	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, kv.Pairs, error) {
+
+	storeName, err := parseQueryStorePath(path, "range")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := c.next.ABCIQueryWithOptions(path, data, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp := res.Response
+
+	// Validate the response.
+	if resp.IsErr() {
+		return nil, nil, errors.Errorf("err response code: %v", resp.Code)
+	}
+	if resp.Proof == nil || len(resp.Proof.Ops) == 0 {
+		return nil, nil, errors.New("no proof")
+	}
+	if resp.Height <= 0 {
+		return nil, nil, errors.New("negative or zero height")
+	}
+
+	// The keys are those of the range proof, checked when verifying it.
+	op, err := c.prt.Decode(resp.Proof.Ops[0])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decoding range proof operator")
+	}
+	rop, ok := op.(merkle.RangeProofOperator)
+	if !ok {
+		return nil, nil, errors.Errorf("expected a range proof operator, got %T", op)
+	}
+	keys := rop.GetKeys()
+	values, err := merkle.DecodeRangeValues(resp.Value)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decoding values")
+	}
+
+	// Update the light client if we're behind.
+	// NOTE: AppHash for height H is in header H+1.
+	h, err := c.updateLiteClientIfNeededTo(resp.Height + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	err = c.prt.VerifyRange(resp.Proof, h.AppHash, kp.String(), start, end, keys, values)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "verify range proof")
+	}
+
+	pairs := make(kv.Pairs, len(keys))
+	for i := range keys {
+		pairs[i] = kv.Pair{Key: keys[i], Value: values[i]}
+	}
+	return &ctypes.ResultABCIQuery{Response: resp}, pairs, nil
+}
+
 func (c *Client) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return c.next.BroadcastTxCommit(tx)
 }
@@ -295,9 +381,14 @@ func (c *Client) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return res, res.Proof.Validate(h.DataHash)
 }
 
-func (c *Client) TxSearch(query string, prove bool, page, perPage int, orderBy string) (
+func (c *Client) TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string, countTotal bool) (
 	*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(query, prove, page, perPage, orderBy)
+	return c.next.TxSearch(query, prove, page, perPage, orderBy, cursor, countTotal)
+}
+
+func (c *Client) BlockSearch(query string, page, perPage int, orderBy string) (
+	*ctypes.ResultBlockSearch, error) {
+	return c.next.BlockSearch(query, page, perPage, orderBy)
 }
 
 func (c *Client) Validators(height *int64, page, perPage int) (*ctypes.ResultValidators, error) {
@@ -392,7 +483,7 @@ func (c *Client) UnsubscribeAllWS(ctx *rpctypes.Context) (*ctypes.ResultUnsubscr
 	return &ctypes.ResultUnsubscribe{}, nil
 }
 
-func parseQueryStorePath(path string) (storeName string, err error) {
+func parseQueryStorePath(path string, queryType string) (storeName string, err error) {
 	if !strings.HasPrefix(path, "/") {
 		return "", fmt.Errorf("expected path to start with /")
 	}
@@ -400,11 +491,11 @@ func parseQueryStorePath(path string) (storeName string, err error) {
 	paths := strings.SplitN(path[1:], "/", 3)
 	switch {
 	case len(paths) != 3:
-		return "", errors.New("expected format like /store/<storeName>/key")
+		return "", errors.Errorf("expected format like /store/<storeName>/%s", queryType)
 	case paths[0] != "store":
-		return "", errors.New("expected format like /store/<storeName>/key")
-	case paths[2] != "key":
-		return "", errors.New("expected format like /store/<storeName>/key")
+		return "", errors.Errorf("expected format like /store/<storeName>/%s", queryType)
+	case paths[2] != queryType:
+		return "", errors.Errorf("expected format like /store/<storeName>/%s", queryType)
 	}
 
 	return paths[1], nil
@@ -0,0 +1,214 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
+	"github.com/tendermint/tendermint/lite2/provider"
+	mockp "github.com/tendermint/tendermint/lite2/provider/mock"
+	dbs "github.com/tendermint/tendermint/lite2/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	chainID = "lite-rpc-test"
+
+	// absenceOpType is the type of testAbsenceOp.
+	absenceOpType = "test:absence"
+)
+
+// testAbsenceOp proves that its key is absent from a store, whose hash is
+// given as is.
+type testAbsenceOp struct {
+	key       []byte
+	storeHash []byte
+}
+
+func testAbsenceOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	return testAbsenceOp{key: pop.Key, storeHash: pop.Data}, nil
+}
+
+func (op testAbsenceOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 0 {
+		return nil, errors.New("expected no args")
+	}
+	return [][]byte{op.storeHash}, nil
+}
+
+func (op testAbsenceOp) GetKey() []byte {
+	return op.key
+}
+
+func (op testAbsenceOp) ProofOp() merkle.ProofOp {
+	return merkle.ProofOp{Type: absenceOpType, Key: op.key, Data: op.storeHash}
+}
+
+// makeSignedHeader returns a header of the given height and app hash, signed
+// by all of vals.
+func makeSignedHeader(t *testing.T, height int64, bTime time.Time, appHash []byte,
+	vals *types.ValidatorSet, privVals []types.PrivValidator) *types.SignedHeader {
+
+	header := &types.Header{
+		ChainID:            chainID,
+		Height:             height,
+		Time:               bTime,
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+		AppHash:            appHash,
+	}
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := types.NewVoteSet(chainID, height, 0, types.PrecommitType, vals)
+	commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	return &types.SignedHeader{Header: header, Commit: commit}
+}
+
+// queryClient is a client returning resp to all ABCI queries.
+type queryClient struct {
+	mock.Client
+	resp abci.ResponseQuery
+}
+
+func (c queryClient) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	return &ctypes.ResultABCIQuery{Response: c.resp}, nil
+}
+
+// newTestClient returns a client verifying resp, the response to all ABCI
+// queries, against the app hash appHash of the light client's header #2 (so
+// queries of height 1).
+func newTestClient(t *testing.T, appHash []byte, resp abci.ResponseQuery, opts ...Option) *Client {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	bTime := time.Now().Add(-time.Minute)
+	h1 := makeSignedHeader(t, 1, bTime, []byte("app_hash"), vals, privVals)
+	h2 := makeSignedHeader(t, 2, bTime.Add(time.Second), appHash, vals, privVals)
+
+	headers := map[int64]*types.SignedHeader{1: h1, 2: h2}
+	valSets := map[int64]*types.ValidatorSet{1: vals, 2: vals, 3: vals}
+
+	lc, err := lite.NewClient(
+		chainID,
+		lite.TrustOptions{Period: time.Hour, Height: 1, Hash: h1.Hash()},
+		mockp.New(chainID, headers, valSets),
+		[]provider.Provider{mockp.New(chainID, headers, valSets)},
+		dbs.New(dbm.NewMemDB(), chainID),
+		lite.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	return NewClient(queryClient{resp: resp}, lc, opts...)
+}
+
+// storeProof returns the app hash of an app with the stores "store", whose
+// hash is storeHash, and "other", and the proof operator of "store".
+func storeProof(storeHash []byte) (appHash []byte, op merkle.ProofOp) {
+	appHash, proofs, _ := merkle.SimpleProofsFromMap(map[string][]byte{
+		"store": storeHash,
+		"other": []byte("other"),
+	})
+	return appHash, merkle.NewSimpleValueOp([]byte("store"), proofs["store"]).ProofOp()
+}
+
+func TestABCIQueryAbsence(t *testing.T) {
+	storeHash := []byte("store_hash")
+	appHash, storeOp := storeProof(storeHash)
+
+	for _, key := range [][]byte{[]byte("key"), []byte("key/with?special&chars")} {
+		resp := abci.ResponseQuery{
+			Key:    key,
+			Height: 1,
+			Proof: &merkle.Proof{Ops: []merkle.ProofOp{
+				testAbsenceOp{key: key, storeHash: storeHash}.ProofOp(),
+				storeOp,
+			}},
+		}
+		c := newTestClient(t, appHash, resp,
+			ProofRuntime(merkle.DefaultProofRuntime()))
+		c.RegisterOpDecoder(absenceOpType, testAbsenceOpDecoder)
+
+		_, err := c.ABCIQuery("/store/store/key", key)
+		assert.NoError(t, err, "key %q", key)
+
+		// the proof is of another store
+		_, err = c.ABCIQuery("/store/other/key", key)
+		assert.Error(t, err)
+	}
+
+	// unknown proof operators can't be verified
+	resp := abci.ResponseQuery{
+		Key:    []byte("key"),
+		Height: 1,
+		Proof: &merkle.Proof{Ops: []merkle.ProofOp{
+			testAbsenceOp{key: []byte("key"), storeHash: storeHash}.ProofOp(),
+			storeOp,
+		}},
+	}
+	c := newTestClient(t, appHash, resp)
+	_, err := c.ABCIQuery("/store/store/key", []byte("key"))
+	assert.Error(t, err)
+}
+
+func TestABCIQueryRange(t *testing.T) {
+	m := map[string][]byte{"a": []byte("1"), "b": []byte("2"), "c": []byte("3"), "d": []byte("4")}
+	start, end := []byte("b"), []byte("d")
+	storeHash, op, values := merkle.SimpleRangeProofFromMap(m, start, end)
+	appHash, storeOp := storeProof(storeHash)
+
+	resp := abci.ResponseQuery{
+		Value:  merkle.EncodeRangeValues(values),
+		Height: 1,
+		Proof:  &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp(), storeOp}},
+	}
+	c := newTestClient(t, appHash, resp)
+
+	_, pairs, err := c.ABCIQueryRangeWithOptions("/store/store/range", nil, start, end,
+		rpcclient.DefaultABCIQueryOptions)
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+	assert.Equal(t, []byte("b"), pairs[0].Key)
+	assert.Equal(t, []byte("2"), pairs[0].Value)
+	assert.Equal(t, []byte("c"), pairs[1].Key)
+	assert.Equal(t, []byte("3"), pairs[1].Value)
+
+	// another range, store or path
+	_, _, err = c.ABCIQueryRangeWithOptions("/store/store/range", nil, start, nil, rpcclient.DefaultABCIQueryOptions)
+	assert.Error(t, err)
+	_, _, err = c.ABCIQueryRangeWithOptions("/store/other/range", nil, start, end, rpcclient.DefaultABCIQueryOptions)
+	assert.Error(t, err)
+	_, _, err = c.ABCIQueryRangeWithOptions("/store/store/key", nil, start, end, rpcclient.DefaultABCIQueryOptions)
+	assert.Error(t, err)
+
+	// a wrong value
+	badValues := [][]byte{[]byte("2"), []byte("bad")}
+	resp.Value = merkle.EncodeRangeValues(badValues)
+	c = newTestClient(t, appHash, resp)
+	_, _, err = c.ABCIQueryRangeWithOptions("/store/store/range", nil, start, end, rpcclient.DefaultABCIQueryOptions)
+	assert.Error(t, err)
+
+	// a missing key
+	badOp := op
+	badOp.Keys = op.Keys[:1]
+	resp.Value = merkle.EncodeRangeValues(values[:1])
+	resp.Proof = &merkle.Proof{Ops: []merkle.ProofOp{badOp.ProofOp(), storeOp}}
+	c = newTestClient(t, appHash, resp)
+	_, _, err = c.ABCIQueryRangeWithOptions("/store/store/range", nil, start, end, rpcclient.DefaultABCIQueryOptions)
+	assert.Error(t, err)
+}
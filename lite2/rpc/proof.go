@@ -1,14 +0,0 @@
-package rpc
-
-import (
-	"github.com/tendermint/tendermint/crypto/merkle"
-)
-
-func defaultProofRuntime() *merkle.ProofRuntime {
-	prt := merkle.NewProofRuntime()
-	prt.RegisterOpDecoder(
-		merkle.ProofOpSimpleValue,
-		merkle.SimpleValueOpDecoder,
-	)
-	return prt
-}
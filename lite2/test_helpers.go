@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	"github.com/tendermint/tendermint/types"
@@ -28,6 +29,16 @@ func genPrivKeys(n int) privKeys {
 	return res
 }
 
+// genBlsPrivKeys produces an array of BLS12-381 private keys to generate
+// commits which can be aggregated.
+func genBlsPrivKeys(n int) privKeys {
+	res := make(privKeys, n)
+	for i := range res {
+		res[i] = bls12381.GenPrivKey()
+	}
+	return res
+}
+
 // // Change replaces the key at index i.
 // func (pkz privKeys) Change(i int) privKeys {
 // 	res := make(privKeys, len(pkz))
@@ -58,7 +58,9 @@ func Verify(
 		}
 	} else {
 		// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
-		err := h1NextVals.VerifyCommitTrusting(chainID, h2.Commit.BlockID, h2.Height, h2.Commit, trustLevel)
+		// The aggregated signature of h2, if any, is verified with h2Vals.
+		err := h1NextVals.VerifyAggregatedCommitTrusting(chainID, h2.Commit.BlockID, h2.Height, h2.Commit,
+			trustLevel, h2Vals)
 		if err != nil {
 			switch e := err.(type) {
 			case types.ErrNotEnoughVotingPowerSigned:
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/types"
@@ -268,6 +269,59 @@ func TestVerifyNonAdjacentHeaders(t *testing.T) {
 	}
 }
 
+func TestVerifyNonAdjacentAggregatedHeaders(t *testing.T) {
+	const (
+		chainID    = "TestVerifyNonAdjacentAggregatedHeaders"
+		lastHeight = 1
+	)
+
+	var (
+		keys = genBlsPrivKeys(4)
+		// 20, 30, 40, 50 - the first 3 don't have 2/3, the last 3 do!
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, lastHeight, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+
+		// 30, 40, 50 and a new validator
+		newKeys = append(keys[1:], genBlsPrivKeys(1)...)
+		newVals = newKeys.ToValidators(30, 10)
+
+		// 20 and a new validator
+		lessThanOneThirdKeys = append(keys[0:1:1], genBlsPrivKeys(1)...)
+		lessThanOneThirdVals = lessThanOneThirdKeys.ToValidators(20, 10)
+	)
+
+	aggregate := func(h *types.SignedHeader, vals *types.ValidatorSet) *types.SignedHeader {
+		commit, err := types.AggregateCommit(h.Commit, vals)
+		require.NoError(t, err)
+		require.NotEmpty(t, commit.AggregatedSignature)
+		return &types.SignedHeader{Header: h.Header, Commit: commit}
+	}
+
+	// 3/3 new vals signed, 3/4 old vals present -> no error
+	newHeader := aggregate(newKeys.GenSignedHeader(chainID, 5, bTime.Add(1*time.Hour), nil, newVals, newVals,
+		[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(newKeys)), newVals)
+	err := Verify(chainID, header, vals, newHeader, newVals, 3*time.Hour, bTime.Add(2*time.Hour), DefaultTrustLevel)
+	assert.NoError(t, err)
+
+	// wrong aggregated signature -> error
+	badHeader := *newHeader
+	badCommit := *newHeader.Commit
+	badCommit.AggregatedSignature = header.Commit.Signatures[0].Signature
+	badHeader.Commit = &badCommit
+	err = Verify(chainID, header, vals, &badHeader, newVals, 3*time.Hour, bTime.Add(2*time.Hour), DefaultTrustLevel)
+	assert.Error(t, err)
+
+	// 3/3 new vals signed, less than 1/3 old vals present -> error
+	newHeader = aggregate(lessThanOneThirdKeys.GenSignedHeader(chainID, 5, bTime.Add(1*time.Hour), nil,
+		lessThanOneThirdVals, lessThanOneThirdVals, []byte("app_hash"), []byte("cons_hash"), []byte("results_hash"),
+		0, len(lessThanOneThirdKeys)), lessThanOneThirdVals)
+	err = Verify(chainID, header, vals, newHeader, lessThanOneThirdVals, 3*time.Hour, bTime.Add(2*time.Hour),
+		DefaultTrustLevel)
+	assert.Equal(t, ErrNewValSetCantBeTrusted{types.ErrNotEnoughVotingPowerSigned{Got: 20, Needed: 46}}, err)
+}
+
 func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 	const (
 		chainID    = "TestVerifyReturnsErrorIfTrustLevelIsInvalid"
@@ -5,6 +5,7 @@ import (
 	"container/list"
 	"crypto/sha256"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -42,15 +43,21 @@ type CListMempool struct {
 
 	config *cfg.MempoolConfig
 
+	// Whether txs are reaped and evicted by priority (see PriorityMempool).
+	prioritized bool
+
 	proxyMtx     sync.Mutex
 	proxyAppConn proxy.AppConnMempool
 	txs          *clist.CList // concurrent linked-list of good txs
 	preCheck     PreCheckFunc
 	postCheck    PostCheckFunc
 
+	// Serializes the processing of abci responses, which may be received
+	// concurrently over a pool of app connections.
+	resCbMtx sync.Mutex
+
 	// Track whether we're rechecking txs.
-	// These are not protected by a mutex and are expected to be mutated
-	// in serial (ie. by abci responses which are called in serial).
+	// These are mutated in serial, by abci responses (under resCbMtx).
 	recheckCursor *clist.CElement // next expected response
 	recheckEnd    *clist.CElement // re-checking stops here
 
@@ -58,6 +65,15 @@ type CListMempool struct {
 	// txsMap: txKey -> CElement
 	txsMap sync.Map
 
+	// Guards adding and removing txs, so that checking for room (and for the
+	// tx sender), evicting txs and adding the new one happen atomically. Also
+	// guards the tx priorities, which change on recheck.
+	txsMtx sync.Mutex
+
+	// Map of txs with a sender assigned by the app (PriorityMempool only).
+	// sendersMap: sender -> CElement
+	sendersMap map[string]*clist.CElement
+
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
 	cache txCache
@@ -70,7 +86,7 @@ type CListMempool struct {
 	metrics *Metrics
 }
 
-var _ Mempool = &CListMempool{}
+var _ GossipMempool = &CListMempool{}
 
 // CListMempoolOption sets an optional parameter on the mempool.
 type CListMempoolOption func(*CListMempool)
@@ -90,6 +106,7 @@ func NewCListMempool(
 		rechecking:    0,
 		recheckCursor: nil,
 		recheckEnd:    nil,
+		sendersMap:    make(map[string]*clist.CElement),
 		logger:        log.NewNopLogger(),
 		metrics:       NopMetrics(),
 	}
@@ -183,12 +200,16 @@ func (mem *CListMempool) Flush() {
 
 	mem.cache.Reset()
 
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		mem.txs.Remove(e)
 		e.DetachPrev()
 	}
 
 	mem.txsMap = sync.Map{}
+	mem.sendersMap = make(map[string]*clist.CElement)
 	_ = atomic.SwapInt64(&mem.txsBytes, 0)
 }
 
@@ -210,6 +231,10 @@ func (mem *CListMempool) TxsWaitChan() <-chan struct{} {
 // cb: A callback from the CheckTx command.
 //     It gets called from another goroutine.
 // CONTRACT: Either cb will get called, or err returned.
+//
+// The priority mempool checks the tx with the app even if it is full, since
+// the tx priority is only known afterwards. If the tx could not be added to the
+// mempool, ResponseCheckTx.MempoolError is set.
 func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo TxInfo) (err error) {
 	mem.proxyMtx.Lock()
 	// use defer to unlock mutex because application (*local client*) might panic
@@ -220,8 +245,8 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 		txsBytes = mem.TxsBytes()
 		txSize   = len(tx)
 	)
-	if memSize >= mem.config.Size ||
-		int64(txSize)+txsBytes > mem.config.MaxTxsBytes {
+	if !mem.prioritized && (memSize >= mem.config.Size ||
+		int64(txSize)+txsBytes > mem.config.MaxTxsBytes) {
 		return ErrMempoolIsFull{
 			memSize, mem.config.Size,
 			txsBytes, mem.config.MaxTxsBytes}
@@ -292,6 +317,9 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 // so the request specific callback can do the work.
 // When rechecking, we don't need the peerID, so the recheck callback happens here.
 func (mem *CListMempool) globalCb(req *abci.Request, res *abci.Response) {
+	mem.resCbMtx.Lock()
+	defer mem.resCbMtx.Unlock()
+
 	if mem.recheckCursor == nil {
 		return
 	}
@@ -319,6 +347,7 @@ func (mem *CListMempool) reqResCb(
 	externalCb func(*abci.Response),
 ) func(res *abci.Response) {
 	return func(res *abci.Response) {
+		mem.resCbMtx.Lock()
 		if mem.recheckCursor != nil {
 			// this should never happen
 			panic("recheck cursor is not nil in reqResCb")
@@ -328,6 +357,7 @@ func (mem *CListMempool) reqResCb(
 
 		// update metrics
 		mem.metrics.Size.Set(float64(mem.Size()))
+		mem.resCbMtx.Unlock()
 
 		// passed in by the caller of CheckTx, eg. the RPC
 		if externalCb != nil {
@@ -338,20 +368,61 @@ func (mem *CListMempool) reqResCb(
 
 // Called from:
 //  - resCbFirstTime (lock not held) if tx is valid
-func (mem *CListMempool) addTx(memTx *mempoolTx) {
+//
+// For the priority mempool, returns an error if the tx sender already has a tx
+// in the mempool, or if the mempool is full and there are not enough lower
+// priority txs to evict.
+func (mem *CListMempool) addTx(memTx *mempoolTx) error {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	if mem.prioritized {
+		if _, ok := mem.sendersMap[memTx.sender]; ok && memTx.sender != "" {
+			return ErrSenderInMempool{memTx.sender}
+		}
+		if err := mem.evictTxsFor(memTx); err != nil {
+			return err
+		}
+	}
+
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(txKey(memTx.tx), e)
+	if mem.prioritized && memTx.sender != "" {
+		mem.sendersMap[memTx.sender] = e
+	}
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	return nil
 }
 
 // Called from:
 //  - Update (lock held) if tx was committed
 // 	- resCbRecheck (lock not held) if tx was invalidated
+//
+// Txs which were already removed (e.g. evicted concurrently) are skipped.
 func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	mem.removeTxLocked(tx, elem, removeFromCache)
+}
+
+// removeTxLocked removes the tx like removeTx, with txsMtx held.
+// Called from:
+//  - removeTx
+//  - evictTxsFor if tx was evicted
+func (mem *CListMempool) removeTxLocked(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
+	if e, ok := mem.txsMap.Load(txKey(tx)); !ok || e.(*clist.CElement) != elem {
+		return
+	}
+
+	memTx := elem.Value.(*mempoolTx)
 	mem.txs.Remove(elem)
 	elem.DetachPrev()
 	mem.txsMap.Delete(txKey(tx))
+	if mem.prioritized && memTx.sender != "" {
+		delete(mem.sendersMap, memTx.sender)
+	}
 	atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
 
 	if removeFromCache {
@@ -359,6 +430,60 @@ func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromC
 	}
 }
 
+// evictTxsFor evicts lower priority txs to make room for memTx, if the mempool
+// is full. Txs are evicted lowest priority first and, for equal priority, most
+// recently added first. If evicting all lower priority txs would not make
+// enough room, nothing is evicted and ErrMempoolIsFull is returned.
+//
+// Called from:
+//  - addTx (txsMtx held) for the priority mempool
+func (mem *CListMempool) evictTxsFor(memTx *mempoolTx) error {
+	var (
+		memSize  = mem.Size()
+		txsBytes = mem.TxsBytes()
+		txSize   = int64(len(memTx.tx))
+	)
+	if memSize < mem.config.Size && txSize+txsBytes <= mem.config.MaxTxsBytes {
+		return nil
+	}
+
+	victims := make([]*clist.CElement, 0)
+	for e := mem.txs.Back(); e != nil; e = e.Prev() {
+		if e.Value.(*mempoolTx).priority < memTx.priority {
+			victims = append(victims, e)
+		}
+	}
+	sort.SliceStable(victims, func(i, j int) bool {
+		return victims[i].Value.(*mempoolTx).priority < victims[j].Value.(*mempoolTx).priority
+	})
+
+	var (
+		numEvicted   = 0
+		bytesEvicted = int64(0)
+	)
+	for memSize-numEvicted >= mem.config.Size || txSize+txsBytes-bytesEvicted > mem.config.MaxTxsBytes {
+		if numEvicted == len(victims) {
+			return ErrMempoolIsFull{
+				memSize, mem.config.Size,
+				txsBytes, mem.config.MaxTxsBytes}
+		}
+		bytesEvicted += int64(len(victims[numEvicted].Value.(*mempoolTx).tx))
+		numEvicted++
+	}
+
+	for _, e := range victims[:numEvicted] {
+		evictedTx := e.Value.(*mempoolTx)
+		mem.removeTxLocked(evictedTx.tx, e, true)
+		mem.metrics.EvictedTxs.Add(1)
+		mem.logger.Info("Evicted transaction",
+			"tx", txID(evictedTx.tx),
+			"priority", evictedTx.priority,
+			"for", txID(memTx.tx),
+		)
+	}
+	return nil
+}
+
 // callback, which is called after the app checked the tx for the first time.
 //
 // The case where the app checks the tx for the second and subsequent times is
@@ -375,29 +500,41 @@ func (mem *CListMempool) resCbFirstTime(
 		if mem.postCheck != nil {
 			postCheckErr = mem.postCheck(tx, r.CheckTx)
 		}
-		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
-			memTx := &mempoolTx{
-				height:    mem.height,
-				gasWanted: r.CheckTx.GasWanted,
-				tx:        tx,
-			}
-			memTx.senders.Store(peerID, true)
-			mem.addTx(memTx)
-			mem.logger.Info("Added good transaction",
-				"tx", txID(tx),
-				"res", r,
-				"height", memTx.height,
-				"total", mem.Size(),
-			)
-			mem.notifyTxsAvailable()
-		} else {
+		if (r.CheckTx.Code != abci.CodeTypeOK) || postCheckErr != nil {
 			// ignore bad transaction
 			mem.logger.Info("Rejected bad transaction",
 				"tx", txID(tx), "peerID", peerP2PID, "res", r, "err", postCheckErr)
 			mem.metrics.FailedTxs.Add(1)
 			// remove from cache (it might be good later)
 			mem.cache.Remove(tx)
+			return
 		}
+
+		memTx := &mempoolTx{
+			height:    mem.height,
+			gasWanted: r.CheckTx.GasWanted,
+			tx:        tx,
+			priority:  r.CheckTx.Priority,
+			sender:    r.CheckTx.Sender,
+		}
+		memTx.senders.Store(peerID, true)
+		if err := mem.addTx(memTx); err != nil {
+			// the tx is valid, but there's no room for it (or its sender)
+			mem.logger.Info("Could not add good transaction",
+				"tx", txID(tx), "peerID", peerP2PID, "priority", memTx.priority, "err", err)
+			r.CheckTx.MempoolError = err.Error()
+			// remove from cache (it might fit later)
+			mem.cache.Remove(tx)
+			return
+		}
+		mem.logger.Info("Added good transaction",
+			"tx", txID(tx),
+			"res", r,
+			"height", memTx.height,
+			"priority", memTx.priority,
+			"total", mem.Size(),
+		)
+		mem.notifyTxsAvailable()
 	default:
 		// ignore other messages
 	}
@@ -406,7 +543,8 @@ func (mem *CListMempool) resCbFirstTime(
 // callback, which is called after the app rechecked the tx.
 //
 // The case where the app checks the tx for the first time is handled by the
-// resCbFirstTime callback.
+// resCbFirstTime callback. The tx priority is updated to the one returned by
+// the recheck.
 func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 	switch r := res.Value.(type) {
 	case *abci.Response_CheckTx:
@@ -423,7 +561,9 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 			postCheckErr = mem.postCheck(tx, r.CheckTx)
 		}
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
-			// Good, nothing to do.
+			mem.txsMtx.Lock()
+			memTx.priority = r.CheckTx.Priority
+			mem.txsMtx.Unlock()
 		} else {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Info("Tx is no longer valid", "tx", txID(tx), "res", r, "err", postCheckErr)
@@ -468,6 +608,9 @@ func (mem *CListMempool) notifyTxsAvailable() {
 	}
 }
 
+// ReapMaxBytesMaxGas reaps txs in the order they were added (or by priority,
+// highest first, for the priority mempool), until either maxBytes or maxGas
+// would be exceeded.
 func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
@@ -483,12 +626,11 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max/mem.avgTxSize))
 	txs := make([]types.Tx, 0, mem.txs.Len())
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	mem.forEachReapTx(func(memTx *mempoolTx) bool {
 		// Check total size requirement
 		aminoOverhead := types.ComputeAminoOverhead(memTx.tx, 1)
 		if maxBytes > -1 && totalBytes+int64(len(memTx.tx))+aminoOverhead > maxBytes {
-			return txs
+			return false
 		}
 		totalBytes += int64(len(memTx.tx)) + aminoOverhead
 		// Check total gas requirement.
@@ -497,35 +639,71 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		// must be non-negative, it follows that this won't overflow.
 		newTotalGas := totalGas + memTx.gasWanted
 		if maxGas > -1 && newTotalGas > maxGas {
-			return txs
+			return false
 		}
 		totalGas = newTotalGas
 		txs = append(txs, memTx.tx)
-	}
+		return true
+	})
 	return txs
 }
 
+// ReapMaxTxs reaps up to max txs in the order they were added (or by
+// priority, highest first, for the priority mempool).
 func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
 
-	if max < 0 {
-		max = mem.txs.Len()
-	}
-
 	for atomic.LoadInt32(&mem.rechecking) > 0 {
 		// TODO: Something better?
 		time.Sleep(time.Millisecond * 10)
 	}
 
+	if max < 0 {
+		max = mem.txs.Len()
+	}
 	txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max))
-	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	mem.forEachReapTx(func(memTx *mempoolTx) bool {
+		if len(txs) >= max {
+			return false
+		}
 		txs = append(txs, memTx.tx)
-	}
+		return true
+	})
 	return txs
 }
 
+// forEachReapTx calls f on the txs in the mempool in the order they are
+// reaped, until f returns false. Txs are reaped in the order they were added
+// or, for the priority mempool, by priority (highest first), and in the order
+// they were added for equal priorities.
+func (mem *CListMempool) forEachReapTx(f func(*mempoolTx) bool) {
+	if !mem.prioritized {
+		for e := mem.txs.Front(); e != nil; e = e.Next() {
+			if !f(e.Value.(*mempoolTx)) {
+				return
+			}
+		}
+		return
+	}
+
+	mem.txsMtx.Lock()
+	memTxs := make([]*mempoolTx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTxs = append(memTxs, e.Value.(*mempoolTx))
+	}
+	sort.SliceStable(memTxs, func(i, j int) bool {
+		return memTxs[i].priority > memTxs[j].priority
+	})
+	mem.txsMtx.Unlock()
+
+	for _, memTx := range memTxs {
+		if !f(memTx) {
+			return
+		}
+	}
+}
+
 func (mem *CListMempool) Update(
 	height int64,
 	txs types.Txs,
@@ -594,8 +772,10 @@ func (mem *CListMempool) recheckTxs() {
 	}
 
 	atomic.StoreInt32(&mem.rechecking, 1)
+	mem.resCbMtx.Lock()
 	mem.recheckCursor = mem.txs.Front()
 	mem.recheckEnd = mem.txs.Back()
+	mem.resCbMtx.Unlock()
 
 	// Push txs to proxyAppConn
 	// NOTE: globalCb may be called concurrently.
@@ -617,6 +797,8 @@ type mempoolTx struct {
 	height    int64    // height that this tx had been validated in
 	gasWanted int64    // amount of gas this tx states it will require
 	tx        types.Tx //
+	priority  int64    // priority assigned by the app in CheckTx
+	sender    string   // sender assigned by the app in CheckTx (PriorityMempool only)
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
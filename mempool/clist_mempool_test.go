@@ -574,6 +574,36 @@ func TestMempoolRemoteAppConcurrency(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// Checking txs over a pool of connections while rechecking others.
+func TestMempoolRemoteAppPool(t *testing.T) {
+	sockPath := fmt.Sprintf("unix:///tmp/echo_%v.sock", tmrand.Str(6))
+	cc, server := newRemoteApp(t, sockPath, kvstore.NewApplication())
+	defer server.Stop()
+	config := cfg.ResetTestRoot("mempool_test")
+	defer os.RemoveAll(config.RootDir)
+
+	proxyApp := proxy.NewAppConns(cc, proxy.MempoolConnections(3))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop()
+	mempool := NewCListMempool(config.Mempool, proxyApp.Mempool(), 0)
+	mempool.SetLogger(log.TestingLogger())
+
+	txs := checkTxs(t, mempool, 100, UnknownPeerID)
+	require.NoError(t, mempool.FlushAppConn())
+	require.Equal(t, 100, mempool.Size())
+
+	// commit some txs, so the others are rechecked
+	mempool.Lock()
+	err := mempool.Update(1, txs[:10], abciResponses(10, abci.CodeTypeOK), nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+
+	// and check more txs right away
+	checkTxs(t, mempool, 50, UnknownPeerID)
+	require.NoError(t, mempool.FlushAppConn())
+	assert.Equal(t, 140, mempool.Size())
+}
+
 // caller must close server
 func newRemoteApp(
 	t *testing.T,
@@ -37,6 +37,16 @@ func (e ErrMempoolIsFull) Error() string {
 		e.txsBytes, e.maxTxsBytes)
 }
 
+// ErrSenderInMempool means the application assigned the tx a sender, which
+// already has a tx in the mempool
+type ErrSenderInMempool struct {
+	sender string
+}
+
+func (e ErrSenderInMempool) Error() string {
+	return fmt.Sprintf("sender %s already has a tx in the mempool", e.sender)
+}
+
 // ErrPreCheck is returned when tx is too big
 type ErrPreCheck struct {
 	Reason error
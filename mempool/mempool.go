@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/types"
 )
@@ -76,6 +78,23 @@ type Mempool interface {
 	CloseWAL()
 }
 
+// GossipMempool is a Mempool which exposes its transactions, in the order they
+// were added, so that the Reactor can broadcast them to peers.
+type GossipMempool interface {
+	Mempool
+
+	// SetLogger sets the Logger.
+	SetLogger(l log.Logger)
+
+	// TxsFront returns the first transaction in the list for peer goroutines to
+	// call .NextWait() on.
+	TxsFront() *clist.CElement
+
+	// TxsWaitChan returns a channel which is closed once the mempool is not
+	// empty.
+	TxsWaitChan() <-chan struct{}
+}
+
 //--------------------------------------------------------------------------------
 
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
@@ -0,0 +1,40 @@
+package mempool
+
+import (
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+//--------------------------------------------------------------------------------
+
+// PriorityMempool is an in-memory pool for transactions, which are reaped in
+// order of the priority assigned to them by the application in CheckTx
+// (ResponseCheckTx.Priority). Transactions with equal priority are reaped in
+// the order they were received. When the mempool is full, lower priority
+// transactions are evicted to make room for higher priority ones.
+//
+// The application may also assign a sender to a transaction
+// (ResponseCheckTx.Sender), in which case only one transaction per sender is
+// kept in the mempool at a time.
+//
+// Otherwise, it works like CListMempool: transactions are also kept in a
+// concurrent list structure in the order they were added, which is used for
+// gossiping them to peers.
+type PriorityMempool struct {
+	*CListMempool
+}
+
+var _ GossipMempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	mempool := NewCListMempool(config, proxyAppConn, height, options...)
+	mempool.prioritized = true
+	return &PriorityMempool{mempool}
+}
@@ -0,0 +1,205 @@
+package mempool
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// priorityApp is an application which accepts txs of the form
+// "priority/id[/sender]", and assigns them the given priority and sender.
+type priorityApp struct {
+	abci.BaseApplication
+}
+
+func (priorityApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	parts := strings.Split(string(req.Tx), "/")
+	priority, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || len(parts) < 2 {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	var sender string
+	if len(parts) > 2 {
+		sender = parts[2]
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, GasWanted: 1, Priority: priority, Sender: sender}
+}
+
+func newPriorityMempool(t *testing.T, config *cfg.Config) (*PriorityMempool, cleanupFunc) {
+	appConnMem, _ := proxy.NewLocalClientCreator(priorityApp{}).NewABCIClient()
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	err := appConnMem.Start()
+	require.NoError(t, err)
+	mempool := NewPriorityMempool(config.Mempool, appConnMem, 0)
+	mempool.SetLogger(log.TestingLogger())
+	return mempool, func() { os.RemoveAll(config.RootDir) }
+}
+
+// checkPriorityTx runs CheckTx for the given tx, and returns the resulting
+// mempool error, if any.
+func checkPriorityTx(t *testing.T, mempool *PriorityMempool, tx string) string {
+	var res *abci.ResponseCheckTx
+	err := mempool.CheckTx(types.Tx(tx), func(r *abci.Response) {
+		res = r.GetCheckTx()
+	}, TxInfo{})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+	return res.MempoolError
+}
+
+func toTxs(txs ...string) types.Txs {
+	result := make(types.Txs, len(txs))
+	for i, tx := range txs {
+		result[i] = types.Tx(tx)
+	}
+	return result
+}
+
+func TestPriorityMempoolReap(t *testing.T) {
+	mempool, cleanup := newPriorityMempool(t, cfg.ResetTestRoot("mempool_test"))
+	defer cleanup()
+
+	for _, tx := range []string{"1/a", "5/b", "3/c", "5/d", "-1/e", "3/f"} {
+		require.Empty(t, checkPriorityTx(t, mempool, tx))
+	}
+	require.Equal(t, 6, mempool.Size())
+
+	// highest priority first, and in the order received for equal priorities
+	expected := toTxs("5/b", "5/d", "3/c", "3/f", "1/a", "-1/e")
+	assert.Equal(t, expected, mempool.ReapMaxTxs(-1))
+	assert.Equal(t, expected[:2], mempool.ReapMaxTxs(2))
+	assert.Equal(t, expected, mempool.ReapMaxTxs(10))
+	assert.Equal(t, expected, mempool.ReapMaxBytesMaxGas(-1, -1))
+	assert.Equal(t, expected[:3], mempool.ReapMaxBytesMaxGas(-1, 3))
+	assert.Equal(t, expected[:1], mempool.ReapMaxBytesMaxGas(5, -1))
+
+	// gossip order is unaffected by priority
+	assert.Equal(t, types.Tx("1/a"), mempool.TxsFront().Value.(*mempoolTx).tx)
+
+	// committed txs are removed
+	err := mempool.Update(1, toTxs("5/b", "3/f"), abciResponses(2, abci.CodeTypeOK), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, toTxs("5/d", "3/c", "1/a", "-1/e"), mempool.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.Size = 3
+	mempool, cleanup := newPriorityMempool(t, config)
+	defer cleanup()
+
+	for _, tx := range []string{"5/a", "1/b", "3/c"} {
+		require.Empty(t, checkPriorityTx(t, mempool, tx))
+	}
+
+	// a tx with higher priority than the lowest evicts it
+	require.Empty(t, checkPriorityTx(t, mempool, "2/d"))
+	assert.Equal(t, toTxs("5/a", "3/c", "2/d"), mempool.ReapMaxTxs(-1))
+
+	// a tx with lower or equal priority is not added
+	assert.NotEmpty(t, checkPriorityTx(t, mempool, "1/e"))
+	assert.NotEmpty(t, checkPriorityTx(t, mempool, "2/f"))
+	assert.Equal(t, toTxs("5/a", "3/c", "2/d"), mempool.ReapMaxTxs(-1))
+
+	// rejected and evicted txs are removed from the cache, so they can be resubmitted
+	err := mempool.Update(1, toTxs("5/a"), abciResponses(1, abci.CodeTypeOK), nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, checkPriorityTx(t, mempool, "2/f"))
+	require.Empty(t, checkPriorityTx(t, mempool, "4/b"))
+	assert.Equal(t, toTxs("4/b", "3/c", "2/d"), mempool.ReapMaxTxs(-1))
+	assert.EqualValues(t, len("4/b3/c2/d"), mempool.TxsBytes())
+
+	// when limited by bytes, enough lower priority txs are evicted to make room
+	mempool.config.Size = 10
+	mempool.config.MaxTxsBytes = mempool.TxsBytes()
+	require.Empty(t, checkPriorityTx(t, mempool, "7/long"))
+	assert.Equal(t, toTxs("7/long", "4/b"), mempool.ReapMaxTxs(-1))
+
+	// nothing is evicted if it wouldn't make enough room
+	assert.NotEmpty(t, checkPriorityTx(t, mempool, "5/very-long-tx"))
+	assert.Equal(t, toTxs("7/long", "4/b"), mempool.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolSender(t *testing.T) {
+	mempool, cleanup := newPriorityMempool(t, cfg.ResetTestRoot("mempool_test"))
+	defer cleanup()
+
+	require.Empty(t, checkPriorityTx(t, mempool, "1/a/alice"))
+	require.Empty(t, checkPriorityTx(t, mempool, "1/b/bob"))
+	require.Empty(t, checkPriorityTx(t, mempool, "1/c"))
+	require.Empty(t, checkPriorityTx(t, mempool, "1/d"))
+
+	// only one tx per sender
+	assert.Equal(t, ErrSenderInMempool{"alice"}.Error(), checkPriorityTx(t, mempool, "2/e/alice"))
+	assert.Equal(t, 4, mempool.Size())
+
+	// the sender can submit another tx once the previous one is committed
+	err := mempool.Update(1, toTxs("1/a/alice"), abciResponses(1, abci.CodeTypeOK), nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, checkPriorityTx(t, mempool, "2/e/alice"))
+	assert.Equal(t, toTxs("2/e/alice", "1/b/bob", "1/c", "1/d"), mempool.ReapMaxTxs(-1))
+
+	// flushing also clears senders
+	mempool.Flush()
+	require.Empty(t, checkPriorityTx(t, mempool, "3/f/alice"))
+}
+
+// Checking txs concurrently over a pool of connections, whose responses are
+// processed concurrently.
+func TestPriorityMempoolRemoteAppPool(t *testing.T) {
+	sockPath := fmt.Sprintf("unix:///tmp/echo_%v.sock", tmrand.Str(6))
+	cc, server := newRemoteApp(t, sockPath, priorityApp{})
+	defer server.Stop()
+	config := cfg.ResetTestRoot("mempool_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Mempool.Size = 10
+
+	proxyApp := proxy.NewAppConns(cc, proxy.MempoolConnections(3))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop()
+	mempool := NewPriorityMempool(config.Mempool, proxyApp.Mempool(), 0)
+	mempool.SetLogger(log.TestingLogger())
+	mempool.EnableTxsAvailable()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 25; j++ {
+				tx := fmt.Sprintf("%d/%d-%d/s%d", j%7, i, j, (i*25+j)%15)
+				err := mempool.CheckTx(types.Tx(tx), nil, TxInfo{})
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.NoError(t, mempool.FlushAppConn())
+
+	// the mempool is full, with at most one tx per sender
+	txs := mempool.ReapMaxTxs(-1)
+	assert.Len(t, txs, config.Mempool.Size)
+	senders := make(map[string]bool)
+	var txsBytes int64
+	for _, tx := range txs {
+		sender := strings.Split(string(tx), "/")[2]
+		assert.False(t, senders[sender], "several txs for sender %s", sender)
+		senders[sender] = true
+		txsBytes += int64(len(tx))
+	}
+	assert.Equal(t, txsBytes, mempool.TxsBytes())
+}
@@ -9,6 +9,8 @@ import (
 
 	amino "github.com/tendermint/go-amino"
 
+	"github.com/tendermint/tendermint/behaviour"
+
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/clist"
 	"github.com/tendermint/tendermint/libs/log"
@@ -35,9 +37,10 @@ const (
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
-	config  *cfg.MempoolConfig
-	mempool *CListMempool
-	ids     *mempoolIDs
+	config   *cfg.MempoolConfig
+	mempool  GossipMempool
+	ids      *mempoolIDs
+	reporter behaviour.Reporter
 }
 
 type mempoolIDs struct {
@@ -104,7 +107,7 @@ func newMempoolIDs() *mempoolIDs {
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, mempool GossipMempool) *Reactor {
 	memR := &Reactor{
 		config:  config,
 		mempool: mempool,
@@ -120,6 +123,13 @@ func (memR *Reactor) SetLogger(l log.Logger) {
 	memR.mempool.SetLogger(l)
 }
 
+// SetSwitch implements Reactor.
+// It reports the behaviour of peers to the given switch.
+func (memR *Reactor) SetSwitch(sw *p2p.Switch) {
+	memR.BaseReactor.SetSwitch(sw)
+	memR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // OnStart implements p2p.BaseReactor.
 func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
@@ -158,7 +168,7 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := memR.decodeMsg(msgBytes)
 	if err != nil {
 		memR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		memR.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(memR.reporter, memR.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 	memR.Logger.Debug("Receive", "src", src, "chId", chID, "msg", msg)
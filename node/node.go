@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
@@ -26,12 +27,16 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/evidence"
 	"github.com/tendermint/tendermint/libs/log"
+	tmnet "github.com/tendermint/tendermint/libs/net"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	"github.com/tendermint/tendermint/libs/service"
+	lite "github.com/tendermint/tendermint/lite2"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/trust"
 	"github.com/tendermint/tendermint/privval"
+	privvalgrpc "github.com/tendermint/tendermint/privval/grpc"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -41,6 +46,8 @@ import (
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
 	"github.com/tendermint/tendermint/state/txindex/null"
+	"github.com/tendermint/tendermint/state/txindex/psql"
+	"github.com/tendermint/tendermint/statesync"
 	"github.com/tendermint/tendermint/store"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -86,8 +93,14 @@ type Provider func(*cfg.Config, log.Logger) (*Node, error)
 // PrivValidator, ClientCreator, GenesisDoc, and DBProvider.
 // It implements NodeProvider.
 func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
+	// The key files are encrypted with the passphrase, if any.
+	passphrase, err := config.LoadKeyPassphrase()
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate node PrivKey
-	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	nodeKey, err := p2p.LoadOrGenNodeKeyWithPassphrase(config.NodeKeyFile(), passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +123,7 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	}
 
 	return NewNode(config,
-		privval.LoadOrGenFilePV(newPrivValKey, newPrivValState),
+		privval.LoadOrGenFilePVWithPassphrase(newPrivValKey, newPrivValState, passphrase),
 		nodeKey,
 		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
 		DefaultGenesisDocProviderFunc(config),
@@ -151,6 +164,7 @@ type Option func(*Node)
 //  - CONSENSUS
 //  - EVIDENCE
 //  - PEX
+//  - STATESYNC
 func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	return func(n *Node) {
 		for name, reactor := range reactors {
@@ -164,6 +178,15 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	}
 }
 
+// StateProvider overrides the state provider used by state sync to retrieve trusted app hashes and
+// build a State object for bootstrapping the node.
+// WARNING: this interface is considered unstable and subject to change.
+func StateProvider(stateProvider statesync.StateProvider) Option {
+	return func(n *Node) {
+		n.stateSyncProvider = stateProvider
+	}
+}
+
 //------------------------------------------------------------------------------
 
 // Node is the highest level interface to a full Tendermint node.
@@ -173,11 +196,12 @@ type Node struct {
 
 	// config
 	config        *cfg.Config
-	genesisDoc    *types.GenesisDoc   // initial validator set
-	privValidator types.PrivValidator // local node's validator key
+	genesisDoc    *types.GenesisDoc     // initial validator set
+	privValidator types.PrivValidator   // local node's validator key
+	remoteSigners []types.PrivValidator // clients of external signing processes
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -185,21 +209,26 @@ type Node struct {
 	isListening bool
 
 	// services
-	eventBus         *types.EventBus // pub/sub for services
-	stateDB          dbm.DB
-	blockStore       *store.BlockStore // store the blockchain to disk
-	bcReactor        p2p.Reactor       // for fast-syncing
-	mempoolReactor   *mempl.Reactor    // for gossipping transactions
-	mempool          mempl.Mempool
-	consensusState   *cs.State      // latest consensus state
-	consensusReactor *cs.Reactor    // for participating in the consensus
-	pexReactor       *pex.Reactor   // for exchanging peer addresses
-	evidencePool     *evidence.Pool // tracking evidence
-	proxyApp         proxy.AppConns // connection to the application
-	rpcListeners     []net.Listener // rpc servers
-	txIndexer        txindex.TxIndexer
-	indexerService   *txindex.IndexerService
-	prometheusSrv    *http.Server
+	eventBus          *types.EventBus // pub/sub for services
+	stateDB           dbm.DB
+	blockStore        *store.BlockStore       // store the blockchain to disk
+	bcReactor         p2p.Reactor             // for fast-syncing
+	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
+	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
+	stateSyncGenesis  sm.State                // provides the genesis state for state sync
+	stateSync         bool                    // whether the node should state sync on startup
+	mempoolReactor    *mempl.Reactor          // for gossipping transactions
+	mempool           mempl.Mempool
+	consensusState    *cs.State      // latest consensus state
+	consensusReactor  *cs.Reactor    // for participating in the consensus
+	pexReactor        *pex.Reactor   // for exchanging peer addresses
+	evidencePool      *evidence.Pool // tracking evidence
+	proxyApp          proxy.AppConns // connection to the application
+	rpcListeners      []net.Listener // rpc servers
+	txIndexer         txindex.TxIndexer
+	blockIndexer      txindex.BlockIndexer
+	indexerService    *txindex.IndexerService
+	prometheusSrv     *http.Server
 }
 
 func initDBs(config *cfg.Config, dbProvider DBProvider) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
@@ -218,8 +247,12 @@ func initDBs(config *cfg.Config, dbProvider DBProvider) (blockStore *store.Block
 	return
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger) (proxy.AppConns, error) {
-	proxyApp := proxy.NewAppConns(clientCreator)
+func createAndStartProxyAppConns(config *cfg.Config, clientCreator proxy.ClientCreator,
+	logger log.Logger) (proxy.AppConns, error) {
+	proxyApp := proxy.NewAppConns(clientCreator,
+		proxy.QueryConnections(config.ABCIQueryConnections),
+		proxy.MempoolConnections(config.ABCIMempoolConnections),
+	)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -237,33 +270,55 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 }
 
 func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
-	eventBus *types.EventBus, logger log.Logger) (*txindex.IndexerService, txindex.TxIndexer, error) {
+	eventBus *types.EventBus, chainID string, logger log.Logger) (*txindex.IndexerService,
+	txindex.TxIndexer, txindex.BlockIndexer, error) {
 
-	var txIndexer txindex.TxIndexer
+	var (
+		txIndexer    txindex.TxIndexer
+		blockIndexer txindex.BlockIndexer
+	)
 	switch config.TxIndex.Indexer {
+	case "psql":
+		if config.TxIndex.PsqlConn == "" {
+			return nil, nil, nil, errors.New(`no psql_conn is set for the "psql" indexer`)
+		}
+		sink, err := psql.NewEventSink(config.TxIndex.PsqlConn, chainID)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to create psql indexer")
+		}
+		txIndexer = sink
+		blockIndexer = sink.BlockIndexer()
 	case "kv":
 		store, err := dbProvider(&DBContext{"tx_index", config})
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
+		}
+		if err := kv.CheckKeysVersion(store); err != nil {
+			return nil, nil, nil, errors.Wrap(err, "run `tendermint migrate_tx_index`")
 		}
 		switch {
 		case config.TxIndex.IndexKeys != "":
-			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " ")))
+			indexKeys := splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " ")
+			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(indexKeys))
+			blockIndexer = kv.NewBlockIndex(store, kv.IndexBlockEvents(indexKeys))
 		case config.TxIndex.IndexAllKeys:
 			txIndexer = kv.NewTxIndex(store, kv.IndexAllEvents())
+			blockIndexer = kv.NewBlockIndex(store, kv.IndexAllBlockEvents())
 		default:
 			txIndexer = kv.NewTxIndex(store)
+			blockIndexer = kv.NewBlockIndex(store)
 		}
 	default:
 		txIndexer = &null.TxIndex{}
+		blockIndexer = &null.BlockIndex{}
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, eventBus)
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
 	indexerService.SetLogger(logger.With("module", "txindex"))
 	if err := indexerService.Start(); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return indexerService, txIndexer, nil
+	return indexerService, txIndexer, blockIndexer, nil
 }
 
 func doHandshake(
@@ -273,17 +328,35 @@ func doHandshake(
 	genDoc *types.GenesisDoc,
 	eventBus types.BlockEventPublisher,
 	proxyApp proxy.AppConns,
+	minABCIVersion proxy.ABCIVersion,
 	consensusLogger log.Logger) error {
 
 	handshaker := cs.NewHandshaker(stateDB, state, blockStore, genDoc)
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
+	handshaker.SetMinABCIVersion(minABCIVersion)
 	if err := handshaker.Handshake(proxyApp); err != nil {
 		return fmt.Errorf("error during handshake: %v", err)
 	}
 	return nil
 }
 
+// negotiateABCIVersion agrees on the ABCI version to speak with the app, when
+// there is no handshake because the app is bootstrapped by state sync.
+func negotiateABCIVersion(proxyApp proxy.AppConns, minABCIVersion proxy.ABCIVersion, logger log.Logger) error {
+	res, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
+	if err != nil {
+		return fmt.Errorf("error calling Info: %v", err)
+	}
+	abciVersion, err := proxy.NegotiateABCIVersion(res.AbciVersion, minABCIVersion)
+	if err != nil {
+		return fmt.Errorf("incompatible app: %v", err)
+	}
+	proxyApp.SetABCIVersion(abciVersion)
+	logger.Info("Negotiated ABCI version", "abci-version", abciVersion)
+	return nil
+}
+
 func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusLogger log.Logger) {
 	// Log the version info.
 	logger.Info("Version info",
@@ -309,6 +382,12 @@ func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusL
 	}
 }
 
+// fastSyncReactor is a blockchain reactor which can switch to fast sync after
+// the node has been bootstrapped by state sync.
+type fastSyncReactor interface {
+	SwitchToFastSync(sm.State) error
+}
+
 func onlyValidatorIsUs(state sm.State, privVal types.PrivValidator) bool {
 	if state.Validators.Size() > 1 {
 		return false
@@ -318,16 +397,31 @@ func onlyValidatorIsUs(state sm.State, privVal types.PrivValidator) bool {
 }
 
 func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
-	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, *mempl.CListMempool) {
-
-	mempool := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
-		mempl.WithMetrics(memplMetrics),
-		mempl.WithPreCheck(sm.TxPreCheck(state)),
-		mempl.WithPostCheck(sm.TxPostCheck(state)),
-	)
+	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, mempl.GossipMempool, error) {
+
+	var mempool mempl.GossipMempool
+	switch config.Mempool.Version {
+	case "v0":
+		mempool = mempl.NewCListMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+		)
+	case "v1":
+		mempool = mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+		)
+	default:
+		return nil, nil, fmt.Errorf("unknown mempool version %s", config.Mempool.Version)
+	}
 	mempoolLogger := logger.With("module", "mempool")
 	mempoolReactor := mempl.NewReactor(config.Mempool, mempool)
 	mempoolReactor.SetLogger(mempoolLogger)
@@ -335,7 +429,7 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 	if config.Consensus.WaitForTxs() {
 		mempool.EnableTxsAvailable()
 	}
-	return mempoolReactor, mempool
+	return mempoolReactor, mempool, nil
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
@@ -377,7 +471,7 @@ func createConsensusReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore sm.BlockStore,
-	mempool *mempl.CListMempool,
+	mempool mempl.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *cs.Metrics,
@@ -406,22 +500,36 @@ func createConsensusReactor(config *cfg.Config,
 	return consensusReactor, consensusState
 }
 
+// p2pTransport is a p2p.Transport the node listens on and closes.
+type p2pTransport interface {
+	p2p.Transport
+	Listen(p2p.NetAddress) error
+	Close() error
+}
+
 func createTransport(
 	config *cfg.Config,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	banList *p2p.BanList,
 ) (
-	*p2p.MultiplexTransport,
+	p2pTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
+		err         error
 	)
 
+	mConnConfig.RecvRateLimits, err = p2p.ParseRecvRateLimits(config.P2P.RecvMessageRateLimits)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid recv_message_rate_limits")
+	}
+
 	if !config.P2P.AllowDuplicateIP {
 		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
 	}
@@ -466,8 +574,20 @@ func createTransport(
 		)
 	}
 
+	if config.P2P.Transport == "quic" {
+		transport, err := p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		p2p.QUICTransportConnFilters(connFilters...)(transport)
+		p2p.QUICTransportBanList(banList)(transport)
+		return transport, peerFilters, nil
+	}
+
+	transport := p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
-	return transport, peerFilters
+	p2p.MultiplexTransportBanList(banList)(transport)
+	return transport, peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
@@ -476,10 +596,14 @@ func createSwitch(config *cfg.Config,
 	peerFilters []p2p.PeerFilterFunc,
 	mempoolReactor *mempl.Reactor,
 	bcReactor p2p.Reactor,
+	stateSyncReactor *statesync.Reactor,
 	consensusReactor *consensus.Reactor,
 	evidenceReactor *evidence.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
+	trustMetricStore *trust.MetricStore,
+	banList *p2p.BanList,
+	behaviourPolicies map[string]p2p.BehaviourPolicy,
 	p2pLogger log.Logger) *p2p.Switch {
 
 	sw := p2p.NewSwitch(
@@ -487,12 +611,16 @@ func createSwitch(config *cfg.Config,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.SwitchTrustMetricStore(trustMetricStore),
+		p2p.SwitchBanList(banList),
+		p2p.SwitchBehaviourPolicies(behaviourPolicies),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
 	sw.AddReactor("CONSENSUS", consensusReactor)
 	sw.AddReactor("EVIDENCE", evidenceReactor)
+	sw.AddReactor("STATESYNC", stateSyncReactor)
 
 	sw.SetNodeInfo(nodeInfo)
 	sw.SetNodeKey(nodeKey)
@@ -549,6 +677,55 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 	return pexReactor
 }
 
+// startStateSync starts an asynchronous state sync process, then switches to fast sync mode.
+func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reactor,
+	stateProvider statesync.StateProvider, config *cfg.StateSyncConfig, fastSync bool,
+	stateDB dbm.DB, blockStore *store.BlockStore, state sm.State) error {
+	ssR.Logger.Info("Starting state sync")
+
+	if stateProvider == nil {
+		var err error
+		stateProvider, err = statesync.NewLightClientStateProvider(
+			state.ChainID, state.Version, config.RPCServers, lite.TrustOptions{
+				Period: config.TrustPeriod,
+				Height: config.TrustHeight,
+				Hash:   config.TrustHashBytes(),
+			}, ssR.Logger.With("module", "lite"))
+		if err != nil {
+			return errors.Wrap(err, "failed to set up light client state provider")
+		}
+	}
+
+	go func() {
+		state, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
+		if err != nil {
+			ssR.Logger.Error("State sync failed", "err", err)
+			return
+		}
+		err = sm.BootstrapState(stateDB, state)
+		if err != nil {
+			ssR.Logger.Error("Failed to bootstrap node with new state", "err", err)
+			return
+		}
+		err = blockStore.SaveSeenCommit(state.LastBlockHeight, commit)
+		if err != nil {
+			ssR.Logger.Error("Failed to store last seen commit", "err", err)
+			return
+		}
+
+		if fastSync && bcR != nil {
+			err = bcR.SwitchToFastSync(state)
+			if err != nil {
+				ssR.Logger.Error("Failed to switch to fast sync", "err", err)
+				return
+			}
+		} else {
+			conR.SwitchToConsensus(state, 1)
+		}
+	}()
+	return nil
+}
+
 // NewNode returns a new, ready to go, Tendermint Node.
 func NewNode(config *cfg.Config,
 	privValidator types.PrivValidator,
@@ -570,8 +747,16 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	var minABCIVersion proxy.ABCIVersion
+	if config.ABCIMinVersion != "" {
+		minABCIVersion, err = proxy.ParseABCIVersion(config.ABCIMinVersion)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid abci_min_version")
+		}
+	}
+
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger)
+	proxyApp, err := createAndStartProxyAppConns(config, clientCreator, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -586,30 +771,45 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Transaction indexing
-	indexerService, txIndexer, err := createAndStartIndexerService(config, dbProvider, eventBus, logger)
+	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(config, dbProvider, eventBus,
+		genDoc.ChainID, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
-	// and replays any blocks as necessary to sync tendermint with the app.
-	consensusLogger := logger.With("module", "consensus")
-	if err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger); err != nil {
-		return nil, err
-	}
-
-	// Reload the state. It will have the Version.Consensus.App set by the
-	// Handshake, and may have other modifications as well (ie. depending on
-	// what happened during block replay).
-	state = sm.LoadState(stateDB)
-
 	// If an address is provided, listen on the socket for a connection from an
-	// external signing process.
-	if config.PrivValidatorListenAddr != "" {
-		// FIXME: we should start services inside OnStart
-		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, logger)
+	// external signing process, or dial it if it speaks gRPC. With a threshold,
+	// there is one such signer per key of the threshold multisig key.
+	var (
+		remoteSigners []types.PrivValidator
+		created       bool
+	)
+	defer func() {
+		// the node closes the clients when it stops, if it could be created
+		if !created {
+			closeRemoteSigners(remoteSigners, logger)
+		}
+	}()
+	if laddrs := config.PrivValidatorListenAddrs(); len(laddrs) > 0 {
+		remoteSigners = make([]types.PrivValidator, len(laddrs))
+		for i, laddr := range laddrs {
+			remoteSigners[i], err = createRemotePrivValidator(config, laddr, genDoc.ChainID, logger)
+			if err != nil {
+				return nil, err
+			}
+		}
+		privValidator = remoteSigners[0]
+		if config.PrivValidatorThreshold > 0 {
+			privValidator, err = privval.NewThresholdPV(config.PrivValidatorThreshold, remoteSigners)
+			if err != nil {
+				return nil, errors.Wrap(err, "error with threshold private validator")
+			}
+		}
+	}
+	if config.PrivValidatorListenAddr != "" && config.PrivValidatorGuardState != "" {
+		privValidator, err = privval.NewGuardedPV(privValidator, config.PrivValidatorGuardStateFile())
 		if err != nil {
-			return nil, errors.Wrap(err, "error with private validator socket client")
+			return nil, errors.Wrap(err, "error with private validator guard")
 		}
 	}
 
@@ -619,16 +819,42 @@ func NewNode(config *cfg.Config,
 		return nil, errors.New("could not retrieve public key from private validator")
 	}
 
-	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
-
-	// Decide whether to fast-sync or not
+	// Determine whether we should do state and/or fast sync.
 	// We don't fast-sync when the only validator is us.
 	fastSync := config.FastSyncMode && !onlyValidatorIsUs(state, privValidator)
+	stateSync := config.StateSync.Enable && !onlyValidatorIsUs(state, privValidator)
+	if stateSync && state.LastBlockHeight > 0 {
+		logger.Info("Found local state with non-zero height, skipping state sync")
+		stateSync = false
+	}
+
+	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
+	// and replays any blocks as necessary to sync tendermint with the app.
+	// When state syncing, the app is bootstrapped from a snapshot instead.
+	consensusLogger := logger.With("module", "consensus")
+	if !stateSync {
+		err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp, minABCIVersion, consensusLogger)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reload the state. It will have the Version.Consensus.App set by the
+		// Handshake, and may have other modifications as well (ie. depending on
+		// what happened during block replay).
+		state = sm.LoadState(stateDB)
+	} else if err := negotiateABCIVersion(proxyApp, minABCIVersion, consensusLogger); err != nil {
+		return nil, err
+	}
+
+	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
 	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool, err := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, logger)
@@ -646,31 +872,60 @@ func NewNode(config *cfg.Config,
 		sm.BlockExecutorWithMetrics(smMetrics),
 	)
 
-	// Make BlockchainReactor
-	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync, logger)
+	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
+	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync, logger)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create blockchain reactor")
 	}
+	if _, ok := bcReactor.(fastSyncReactor); stateSync && fastSync && !ok {
+		return nil, errors.Errorf("state sync is not supported with fastsync version %s",
+			config.FastSync.Version)
+	}
 
-	// Make ConsensusReactor
+	// Make ConsensusReactor. Don't enable fully if doing a state sync and/or fast sync first.
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, fastSync, eventBus, consensusLogger,
+		privValidator, csMetrics, stateSync || fastSync, eventBus, consensusLogger,
 	)
 
+	// Set up state sync reactor. The sync itself is started in OnStart, if requested.
+	stateSyncReactor := statesync.NewReactor(proxyApp.Snapshot(), proxyApp.Query(),
+		config.StateSync.TempDir)
+	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
+
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
 	if err != nil {
 		return nil, err
 	}
 
+	behaviourPolicies, err := p2p.ParseBehaviourPolicies(config.P2P.BehaviourPolicies)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid behaviour_policies")
+	}
+
+	banList, err := p2p.LoadBanList(config.P2P.BanListFile())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load ban list")
+	}
+
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	transport, peerFilters, err := createTransport(config, nodeInfo, nodeKey, proxyApp, banList)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create transport")
+	}
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(p2pLogger)
 	sw := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
+		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey,
+		trustMetricStore, banList, behaviourPolicies, p2pLogger,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -715,6 +970,7 @@ func NewNode(config *cfg.Config,
 		config:        config,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
+		remoteSigners: remoteSigners,
 
 		transport: transport,
 		sw:        sw,
@@ -729,10 +985,14 @@ func NewNode(config *cfg.Config,
 		mempool:          mempool,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
+		stateSyncReactor: stateSyncReactor,
+		stateSync:        stateSync,
+		stateSyncGenesis: state, // Shouldn't be necessary, but need a way to pass the genesis state
 		pexReactor:       pexReactor,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
+		blockIndexer:     blockIndexer,
 		indexerService:   indexerService,
 		eventBus:         eventBus,
 	}
@@ -742,6 +1002,7 @@ func NewNode(config *cfg.Config,
 		option(node)
 	}
 
+	created = true
 	return node, nil
 }
 
@@ -799,6 +1060,16 @@ func (n *Node) OnStart() error {
 		return errors.Wrap(err, "could not dial peers from persistent_peers field")
 	}
 
+	// Run state sync
+	if n.stateSync {
+		bcR, _ := n.bcReactor.(fastSyncReactor)
+		err := startStateSync(n.stateSyncReactor, bcR, n.consensusReactor, n.stateSyncProvider,
+			n.config.StateSync, n.config.FastSyncMode, n.stateDB, n.blockStore, n.stateSyncGenesis)
+		if err != nil {
+			return errors.Wrap(err, "failed to start state sync")
+		}
+	}
+
 	return nil
 }
 
@@ -811,6 +1082,11 @@ func (n *Node) OnStop() {
 	// first stop the non-reactor services
 	n.eventBus.Stop()
 	n.indexerService.Stop()
+	if sink, ok := n.txIndexer.(*psql.EventSink); ok {
+		if err := sink.Stop(); err != nil {
+			n.Logger.Error("Error closing psql indexer", "err", err)
+		}
+	}
 
 	// now stop the reactors
 	n.sw.Stop()
@@ -837,6 +1113,7 @@ func (n *Node) OnStop() {
 	if pvsc, ok := n.privValidator.(service.Service); ok {
 		pvsc.Stop()
 	}
+	closeRemoteSigners(n.remoteSigners, n.Logger)
 
 	if n.prometheusSrv != nil {
 		if err := n.prometheusSrv.Shutdown(context.Background()); err != nil {
@@ -861,6 +1138,7 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetGenesisDoc(n.genesisDoc)
 	rpccore.SetProxyAppQuery(n.proxyApp.Query())
 	rpccore.SetTxIndexer(n.txIndexer)
+	rpccore.SetBlockIndexer(n.blockIndexer)
 	rpccore.SetConsensusReactor(n.consensusReactor)
 	rpccore.SetEventBus(n.eventBus)
 	rpccore.SetLogger(n.Logger.With("module", "rpc"))
@@ -1208,6 +1486,61 @@ func createAndStartPrivValidatorSocketClient(
 	return pvsc, nil
 }
 
+// createRemotePrivValidator listens on laddr for a connection from an
+// external signing process, or dials it if it speaks gRPC.
+func createRemotePrivValidator(
+	config *cfg.Config,
+	laddr string,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	if strings.HasPrefix(laddr, "grpc://") {
+		pv, err := createPrivValidatorGRPCClient(config, laddr, chainID, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "error with private validator gRPC client")
+		}
+		return pv, nil
+	}
+
+	// FIXME: we should start services inside OnStart
+	pv, err := createAndStartPrivValidatorSocketClient(laddr, logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "error with private validator socket client")
+	}
+	return pv, nil
+}
+
+// closeRemoteSigners closes the clients of external signing processes which
+// were created, i.e. not nil.
+func closeRemoteSigners(signers []types.PrivValidator, logger log.Logger) {
+	for _, signer := range signers {
+		if closer, ok := signer.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				logger.Error("Error closing private validator client", "err", err)
+			}
+		}
+	}
+}
+
+func createPrivValidatorGRPCClient(
+	config *cfg.Config,
+	addr string,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	tlsConfig, err := privvalgrpc.ClientTLSConfig(
+		config.PrivValidatorClientCertificateFile(),
+		config.PrivValidatorClientKeyFile(),
+		config.PrivValidatorRootCAFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, addr = tmnet.ProtocolAndAddress(addr)
+	return privvalgrpc.DialRemoteSigner(addr, tlsConfig, chainID, logger.With("module", "privval"))
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
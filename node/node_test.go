@@ -72,6 +72,21 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeStartStopQUIC(t *testing.T) {
+	config := cfg.ResetTestRoot("node_node_test")
+	defer os.RemoveAll(config.RootDir)
+	config.P2P.Transport = "quic"
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &p2p.QUICTransport{}, n.transport)
+
+	err = n.Start()
+	require.NoError(t, err)
+	err = n.Stop()
+	require.NoError(t, err)
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -159,6 +174,85 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
 	assert.IsType(t, &privval.SignerClient{}, n.PrivValidator())
+
+	// the connection to the signer is closed when the node stops
+	require.NoError(t, n.Start())
+	require.NoError(t, n.Stop())
+	assert.False(t, n.PrivValidator().(*privval.SignerClient).IsConnected())
+}
+
+func TestNodeClosesRemoteSignersOnError(t *testing.T) {
+	addr := testFreeAddr(t)
+
+	config := cfg.ResetTestRoot("node_priv_val_tcp_test")
+	defer os.RemoveAll(config.RootDir)
+	// the second address has no protocol, so the node can't be created
+	config.BaseConfig.PrivValidatorListenAddr = "tcp://" + addr + "," + testFreeAddr(t)
+	config.BaseConfig.PrivValidatorThreshold = 1
+
+	dialer := privval.DialTCPFn("tcp://"+addr, 100*time.Millisecond, ed25519.GenPrivKey())
+	dialerEndpoint := privval.NewSignerDialerEndpoint(
+		log.TestingLogger(),
+		dialer,
+	)
+	privval.SignerDialerEndpointTimeoutReadWrite(100 * time.Millisecond)(dialerEndpoint)
+
+	signerServer := privval.NewSignerServer(
+		dialerEndpoint,
+		config.ChainID(),
+		types.NewMockPV(),
+	)
+
+	go func() {
+		err := signerServer.Start()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer signerServer.Stop()
+
+	_, err := DefaultNewNode(config, log.TestingLogger())
+	require.Error(t, err)
+
+	// the client of the first signer was closed, so its address is free again
+	ln, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	ln.Close()
+}
+
+func TestNodeSetPrivValGuard(t *testing.T) {
+	addr := "tcp://" + testFreeAddr(t)
+
+	config := cfg.ResetTestRoot("node_priv_val_guard_test")
+	defer os.RemoveAll(config.RootDir)
+	config.BaseConfig.PrivValidatorListenAddr = addr
+	config.BaseConfig.PrivValidatorGuardState = "data/priv_validator_guard_state.json"
+
+	dialer := privval.DialTCPFn(addr, 100*time.Millisecond, ed25519.GenPrivKey())
+	dialerEndpoint := privval.NewSignerDialerEndpoint(
+		log.TestingLogger(),
+		dialer,
+	)
+	privval.SignerDialerEndpointTimeoutReadWrite(100 * time.Millisecond)(dialerEndpoint)
+
+	signerServer := privval.NewSignerServer(
+		dialerEndpoint,
+		config.ChainID(),
+		types.NewMockPV(),
+	)
+
+	go func() {
+		err := signerServer.Start()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer signerServer.Stop()
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &privval.GuardedPV{}, n.PrivValidator())
+	assert.FileExists(t, config.PrivValidatorGuardStateFile())
 }
 
 // address without a protocol must result in error
@@ -280,11 +374,12 @@ func TestCreateProposalBlock(t *testing.T) {
 	)
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
-	block, _ := blockExec.CreateProposalBlock(
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
 		state, commit,
 		proposerAddr,
 	)
+	require.NoError(t, err)
 
 	err = blockExec.ValidateBlock(state, block)
 	assert.NoError(t, err)
@@ -0,0 +1,204 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/tempfile"
+)
+
+// Ban is a ban of a node ID, an IP or a range of IPs (CIDR).
+type Ban struct {
+	// Target is the banned node ID, IP or CIDR.
+	Target string `json:"target"`
+	// Until is when the ban expires. A zero time means the ban never expires.
+	Until time.Time `json:"until"`
+}
+
+// activeAt returns true if the ban has not expired at the given time.
+func (b Ban) activeAt(t time.Time) bool {
+	return b.Until.IsZero() || t.Before(b.Until)
+}
+
+// outlasts returns true if the ban expires no sooner than the other one.
+func (b Ban) outlasts(other Ban) bool {
+	return b.Until.IsZero() || (!other.Until.IsZero() && !other.Until.After(b.Until))
+}
+
+// banEntry is a Ban with its parsed target. For IP bans, either ip or ipNet
+// is set.
+type banEntry struct {
+	Ban
+	ip    net.IP
+	ipNet *net.IPNet
+}
+
+// parseBan parses the target of the ban, and normalizes it.
+func parseBan(target string, until time.Time) (banEntry, error) {
+	if _, ipNet, err := net.ParseCIDR(target); err == nil {
+		return banEntry{Ban: Ban{Target: ipNet.String(), Until: until}, ipNet: ipNet}, nil
+	}
+	if ip := net.ParseIP(target); ip != nil {
+		return banEntry{Ban: Ban{Target: ip.String(), Until: until}, ip: ip}, nil
+	}
+	if err := validateID(ID(target)); err != nil {
+		return banEntry{}, fmt.Errorf("invalid ban target %q: not an IP, a CIDR or a node ID (%v)", target, err)
+	}
+	return banEntry{Ban: Ban{Target: target, Until: until}}, nil
+}
+
+// BanList is a set of banned node IDs, IPs and CIDRs, each banned until a
+// given time. Banned peers are neither dialed nor accepted by the Switch and
+// MultiplexTransport. If the BanList has a file, it is saved to it on every
+// change, so bans survive restarts. It is safe for concurrent use.
+type BanList struct {
+	mtx      sync.RWMutex
+	filePath string
+	bans     map[string]banEntry // by normalized target
+}
+
+// NewBanList returns an empty BanList, which is not saved.
+func NewBanList() *BanList {
+	return &BanList{
+		bans: make(map[string]banEntry),
+	}
+}
+
+// LoadBanList returns the BanList saved in the given file, or an empty one if
+// the file does not exist. Changes to the BanList are saved to the file.
+func LoadBanList(filePath string) (*BanList, error) {
+	bl := NewBanList()
+	bl.filePath = filePath
+
+	bz, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return bl, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var bans []Ban
+	if err := json.Unmarshal(bz, &bans); err != nil {
+		return nil, fmt.Errorf("error reading ban list %s: %v", filePath, err)
+	}
+	for _, b := range bans {
+		entry, err := parseBan(b.Target, b.Until)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ban list %s: %v", filePath, err)
+		}
+		bl.bans[entry.Target] = entry
+	}
+	return bl, nil
+}
+
+// Ban bans the target, which is a node ID, an IP or a CIDR, until the given
+// time, or forever if it is zero. A ban never shortens an existing one.
+func (bl *BanList) Ban(target string, until time.Time) error {
+	entry, err := parseBan(target, until)
+	if err != nil {
+		return err
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	if existing, ok := bl.bans[entry.Target]; ok && existing.outlasts(entry.Ban) {
+		return nil
+	}
+	bl.bans[entry.Target] = entry
+	return bl.save()
+}
+
+// Unban lifts the ban of the target. It returns an error if the target was
+// not banned.
+func (bl *BanList) Unban(target string) error {
+	entry, err := parseBan(target, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	existing, ok := bl.bans[entry.Target]
+	if !ok || !existing.activeAt(time.Now()) {
+		return fmt.Errorf("%s is not banned", entry.Target)
+	}
+	delete(bl.bans, entry.Target)
+	return bl.save()
+}
+
+// IsBanned returns true if the node ID is currently banned.
+func (bl *BanList) IsBanned(id ID) bool {
+	bl.mtx.RLock()
+	defer bl.mtx.RUnlock()
+
+	entry, ok := bl.bans[string(id)]
+	return ok && entry.activeAt(time.Now())
+}
+
+// IsIPBanned returns true if the IP is currently banned, on its own or as part
+// of a CIDR.
+func (bl *BanList) IsIPBanned(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	bl.mtx.RLock()
+	defer bl.mtx.RUnlock()
+
+	now := time.Now()
+	for _, entry := range bl.bans {
+		if !entry.activeAt(now) {
+			continue
+		}
+		if (entry.ip != nil && entry.ip.Equal(ip)) || (entry.ipNet != nil && entry.ipNet.Contains(ip)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bans returns the current bans, sorted by target, and forgets expired ones.
+func (bl *BanList) Bans() []Ban {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	return bl.activeBans()
+}
+
+// activeBans returns the current bans, sorted by target, and forgets expired
+// ones. It must be called with the lock held.
+func (bl *BanList) activeBans() []Ban {
+	now := time.Now()
+	bans := make([]Ban, 0, len(bl.bans))
+	for target, entry := range bl.bans {
+		if entry.activeAt(now) {
+			bans = append(bans, entry.Ban)
+		} else {
+			delete(bl.bans, target)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Target < bans[j].Target })
+	return bans
+}
+
+// save writes the current bans to the file, if any. It must be called with
+// the lock held.
+func (bl *BanList) save() error {
+	if bl.filePath == "" {
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(bl.activeBans(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(bl.filePath, bz, 0644)
+}
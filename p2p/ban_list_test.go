@@ -0,0 +1,87 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestBanList(t *testing.T) {
+	bl := NewBanList()
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	expired := PubKeyToID(ed25519.GenPrivKey().PubKey())
+
+	assert.False(t, bl.IsBanned(id))
+	assert.Error(t, bl.Unban(string(id)))
+
+	until := time.Now().Add(time.Hour).Round(0)
+	require.NoError(t, bl.Ban(string(id), until))
+	require.NoError(t, bl.Ban(string(expired), time.Now().Add(-time.Second)))
+	assert.True(t, bl.IsBanned(id))
+	assert.False(t, bl.IsBanned(expired))
+
+	// bans aren't shortened
+	require.NoError(t, bl.Ban(string(id), time.Now().Add(time.Minute)))
+	assert.Equal(t, []Ban{{Target: string(id), Until: until}}, bl.Bans())
+
+	require.NoError(t, bl.Unban(string(id)))
+	assert.False(t, bl.IsBanned(id))
+	assert.Empty(t, bl.Bans())
+
+	assert.Error(t, bl.Ban("not a target", until))
+}
+
+func TestBanListIPs(t *testing.T) {
+	bl := NewBanList()
+
+	require.NoError(t, bl.Ban("10.0.0.1", time.Time{}))
+	require.NoError(t, bl.Ban("192.168.1.0/24", time.Time{}))
+	require.NoError(t, bl.Ban("172.16.0.0/16", time.Now().Add(-time.Second)))
+
+	assert.True(t, bl.IsIPBanned(net.ParseIP("10.0.0.1")))
+	assert.False(t, bl.IsIPBanned(net.ParseIP("10.0.0.2")))
+	assert.True(t, bl.IsIPBanned(net.ParseIP("192.168.1.42")))
+	assert.False(t, bl.IsIPBanned(net.ParseIP("192.168.2.42")))
+	assert.False(t, bl.IsIPBanned(net.ParseIP("172.16.0.1")))
+
+	// targets are normalized
+	require.NoError(t, bl.Unban("192.168.1.7/24"))
+	assert.False(t, bl.IsIPBanned(net.ParseIP("192.168.1.42")))
+}
+
+func TestBanListPersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ban_list")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	filePath := filepath.Join(dir, "banlist.json")
+
+	bl, err := LoadBanList(filePath)
+	require.NoError(t, err)
+	assert.Empty(t, bl.Bans())
+
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	until := time.Now().Add(time.Hour).Round(0)
+	require.NoError(t, bl.Ban(string(id), until))
+	require.NoError(t, bl.Ban("10.0.0.0/8", time.Time{}))
+	require.NoError(t, bl.Ban("10.0.0.1", time.Time{}))
+	require.NoError(t, bl.Unban("10.0.0.1"))
+
+	bl, err = LoadBanList(filePath)
+	require.NoError(t, err)
+	assert.True(t, bl.IsBanned(id))
+	assert.True(t, bl.IsIPBanned(net.ParseIP("10.1.2.3")))
+	assert.Len(t, bl.Bans(), 2)
+
+	err = ioutil.WriteFile(filePath, []byte(`[{"target":"nonsense"}]`), 0644)
+	require.NoError(t, err)
+	_, err = LoadBanList(filePath)
+	assert.Error(t, err)
+}
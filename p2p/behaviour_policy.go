@@ -0,0 +1,104 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kinds of peer behaviour reported to the Switch by the behaviour package.
+const (
+	BehaviourBadMessage        = "bad_message"
+	BehaviourMessageOutOfOrder = "message_out_of_order"
+	BehaviourConsensusVote     = "consensus_vote"
+	BehaviourBlockPart         = "block_part"
+)
+
+// BehaviourRateLimited is reported by peers when they drop messages received
+// over the rate limit of their channel.
+const BehaviourRateLimited = "rate_limited"
+
+// BehaviourAction is what the Switch does when a peer behaves in some way.
+type BehaviourAction string
+
+const (
+	// BehaviourActionMarkGood marks the peer as good.
+	BehaviourActionMarkGood BehaviourAction = "mark_good"
+	// BehaviourActionWarn logs the behaviour and lowers the peer's trust.
+	BehaviourActionWarn BehaviourAction = "warn"
+	// BehaviourActionDisconnect stops the peer (persistent peers are
+	// reconnected).
+	BehaviourActionDisconnect BehaviourAction = "disconnect"
+	// BehaviourActionBan stops the peer and bans it for some time.
+	BehaviourActionBan BehaviourAction = "ban"
+)
+
+// BehaviourPolicy tells the Switch what to do about a kind of peer behaviour.
+type BehaviourPolicy struct {
+	Action BehaviourAction
+	// BanDuration is how long the peer is banned for, if Action is
+	// BehaviourActionBan.
+	BanDuration time.Duration
+}
+
+// defaultBehaviourPolicy applies to unknown kinds of behaviour.
+var defaultBehaviourPolicy = BehaviourPolicy{Action: BehaviourActionDisconnect}
+
+// DefaultBehaviourPolicies returns the policies used by the Switch unless
+// others are set: good behaviour marks the peer as good, bad messages
+// disconnect it, and exceeding a rate limit lowers its trust.
+func DefaultBehaviourPolicies() map[string]BehaviourPolicy {
+	return map[string]BehaviourPolicy{
+		BehaviourConsensusVote:     {Action: BehaviourActionMarkGood},
+		BehaviourBlockPart:         {Action: BehaviourActionMarkGood},
+		BehaviourBadMessage:        {Action: BehaviourActionDisconnect},
+		BehaviourMessageOutOfOrder: {Action: BehaviourActionDisconnect},
+		BehaviourRateLimited:       {Action: BehaviourActionWarn},
+	}
+}
+
+// ParseBehaviourPolicies parses a comma-separated list of policies, each of
+// the form "<kind>:<action>" or "<kind>:ban:<duration>" (e.g.
+// "bad_message:ban:10m,message_out_of_order:warn"), and returns the default
+// policies overridden by them. Unknown kinds of behaviour are rejected.
+func ParseBehaviourPolicies(s string) (map[string]BehaviourPolicy, error) {
+	policies := DefaultBehaviourPolicies()
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.Split(item, ":")
+		if len(parts) < 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid behaviour policy %q, expected <kind>:<action>[:<duration>]", item)
+		}
+
+		kind, policy := parts[0], BehaviourPolicy{Action: BehaviourAction(parts[1])}
+		if _, ok := policies[kind]; !ok {
+			return nil, fmt.Errorf("invalid behaviour policy %q: unknown kind %q", item, kind)
+		}
+		switch policy.Action {
+		case BehaviourActionMarkGood, BehaviourActionWarn, BehaviourActionDisconnect:
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("invalid behaviour policy %q: only bans take a duration", item)
+			}
+		case BehaviourActionBan:
+			if len(parts) != 3 {
+				return nil, fmt.Errorf("invalid behaviour policy %q: bans require a duration", item)
+			}
+			d, err := time.ParseDuration(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid behaviour policy %q: %v", item, err)
+			}
+			if d <= 0 {
+				return nil, fmt.Errorf("invalid behaviour policy %q: ban duration must be positive", item)
+			}
+			policy.BanDuration = d
+		default:
+			return nil, fmt.Errorf("invalid behaviour policy %q: unknown action %q", item, parts[1])
+		}
+		policies[kind] = policy
+	}
+	return policies, nil
+}
@@ -0,0 +1,37 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBehaviourPolicies(t *testing.T) {
+	policies, err := ParseBehaviourPolicies("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBehaviourPolicies(), policies)
+
+	policies, err = ParseBehaviourPolicies(" bad_message:ban:10m, consensus_vote:warn")
+	require.NoError(t, err)
+	assert.Equal(t, BehaviourPolicy{Action: BehaviourActionBan, BanDuration: 10 * time.Minute},
+		policies[BehaviourBadMessage])
+	assert.Equal(t, BehaviourPolicy{Action: BehaviourActionWarn}, policies[BehaviourConsensusVote])
+	assert.Equal(t, BehaviourPolicy{Action: BehaviourActionMarkGood}, policies[BehaviourBlockPart])
+
+	invalid := []string{
+		"bad_message",
+		":warn",
+		"bad_message:explode",
+		"bad_message:ban",
+		"bad_message:ban:forever",
+		"bad_message:ban:-1m",
+		"bad_message:warn:1m",
+		"bad_mesage:warn",
+	}
+	for _, s := range invalid {
+		_, err := ParseBehaviourPolicies(s)
+		assert.Error(t, err, s)
+	}
+}
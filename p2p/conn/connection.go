@@ -46,6 +46,10 @@ const (
 	defaultPongTimeout         = 45 * time.Second
 )
 
+// ErrPongTimeout is the error an MConnection stops with if the peer does not
+// reply to a ping in time.
+var ErrPongTimeout = errors.New("pong timeout")
+
 type receiveCbFunc func(chID byte, msgBytes []byte)
 type errorCbFunc func(interface{})
 
@@ -132,6 +136,19 @@ type MConnConfig struct {
 
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
+
+	// Limits on the rate of messages received from a peer, by channel,
+	// overriding the RecvRateLimit of the channel descriptors. Enforced by
+	// the peer, not by the MConnection.
+	RecvRateLimits map[byte]RateLimit `mapstructure:"recv_rate_limits"`
+}
+
+// RateLimit is a token bucket limit on the rate of messages: Rate messages per
+// second on average, in bursts of up to Burst messages. A zero Rate means no
+// limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
 }
 
 // DefaultMConnConfig returns the default config.
@@ -450,7 +467,7 @@ FOR_LOOP:
 		case timeout := <-c.pongTimeoutCh:
 			if timeout {
 				c.Logger.Debug("Pong timeout")
-				err = errors.New("pong timeout")
+				err = ErrPongTimeout
 			} else {
 				c.stopPongTimer()
 			}
@@ -624,6 +641,7 @@ FOR_LOOP:
 				c.stopForError(err)
 				break FOR_LOOP
 			}
+			channel.recvMonitor.Update(int(_n))
 
 			msgBytes, err := channel.recvPacketMsg(pkt)
 			if err != nil {
@@ -684,6 +702,8 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+	SendRate          int64 // current rate, in bytes/second
+	RecvRate          int64 // current rate, in bytes/second
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -699,6 +719,8 @@ func (c *MConnection) Status() ConnectionStatus {
 			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			SendRate:          channel.sendMonitor.Status().CurRate,
+			RecvRate:          channel.recvMonitor.Status().CurRate,
 		}
 	}
 	return status
@@ -712,6 +734,9 @@ type ChannelDescriptor struct {
 	SendQueueCapacity   int
 	RecvBufferCapacity  int
 	RecvMessageCapacity int
+	// Default limit on the rate of messages received from each peer on the
+	// channel (see MConnConfig.RecvRateLimits)
+	RecvRateLimit RateLimit
 }
 
 func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
@@ -724,6 +749,9 @@ func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
 	if chDesc.RecvMessageCapacity == 0 {
 		chDesc.RecvMessageCapacity = defaultRecvMessageCapacity
 	}
+	if chDesc.RecvRateLimit.Rate > 0 && chDesc.RecvRateLimit.Burst <= 0 {
+		chDesc.RecvRateLimit.Burst = int(math.Ceil(chDesc.RecvRateLimit.Rate))
+	}
 	filled = chDesc
 	return
 }
@@ -738,6 +766,8 @@ type Channel struct {
 	recving       []byte
 	sending       []byte
 	recentlySent  int64 // exponential moving average
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
 
 	maxPacketMsgPayloadSize int
 
@@ -754,6 +784,8 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -836,6 +868,7 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int64, err error) {
 	var packet = ch.nextPacketMsg()
 	n, err = cdc.MarshalBinaryLengthPrefixedWriter(w, packet)
 	atomic.AddInt64(&ch.recentlySent, n)
+	ch.sendMonitor.Update(int(n))
 	return
 }
 
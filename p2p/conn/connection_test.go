@@ -143,6 +143,12 @@ func TestMConnectionReceive(t *testing.T) {
 	case <-time.After(500 * time.Millisecond):
 		t.Fatalf("Did not receive %s message in 500ms", msg)
 	}
+
+	// the traffic is accounted to the channel, once the monitors take a sample
+	assert.Eventually(t, func() bool {
+		return mconn2.channels[0].sendMonitor.Status().Bytes > int64(len(msg)) &&
+			mconn1.channels[0].recvMonitor.Status().Bytes > int64(len(msg))
+	}, time.Second, 10*time.Millisecond)
 }
 
 func TestMConnectionStatus(t *testing.T) {
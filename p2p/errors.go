@@ -20,6 +20,7 @@ type ErrRejected struct {
 	err               error
 	id                ID
 	isAuthFailure     bool
+	isBanned          bool
 	isDuplicate       bool
 	isFiltered        bool
 	isIncompatible    bool
@@ -37,6 +38,15 @@ func (e ErrRejected) Error() string {
 		return fmt.Sprintf("auth failure: %s", e.err)
 	}
 
+	if e.isBanned {
+		if e.id != "" {
+			return fmt.Sprintf("banned ID<%v>", e.id)
+		}
+		if e.conn != nil {
+			return fmt.Sprintf("banned CONN<%s>", e.conn.RemoteAddr().String())
+		}
+	}
+
 	if e.isDuplicate {
 		if e.conn != nil {
 			return fmt.Sprintf(
@@ -81,6 +91,9 @@ func (e ErrRejected) Error() string {
 // IsAuthFailure when Peer authentication was unsuccessful.
 func (e ErrRejected) IsAuthFailure() bool { return e.isAuthFailure }
 
+// IsBanned when Peer ID is banned.
+func (e ErrRejected) IsBanned() bool { return e.isBanned }
+
 // IsDuplicate when Peer ID or IP are present already.
 func (e ErrRejected) IsDuplicate() bool { return e.isDuplicate }
 
@@ -138,6 +151,26 @@ func (e ErrSwitchAuthenticationFailure) Error() string {
 	)
 }
 
+// ErrSwitchPeerBanned to be raised when trying to connect to a banned peer, and
+// the reason a peer is stopped when it is banned.
+type ErrSwitchPeerBanned struct {
+	ID ID
+}
+
+func (e ErrSwitchPeerBanned) Error() string {
+	return fmt.Sprintf("peer %v is banned", e.ID)
+}
+
+// ErrSwitchPeerEvicted is the reason an inbound peer is stopped to make room
+// for a more trusted one.
+type ErrSwitchPeerEvicted struct {
+	By ID
+}
+
+func (e ErrSwitchPeerEvicted) Error() string {
+	return fmt.Sprintf("evicted for more trusted peer %v", e.By)
+}
+
 // ErrTransportClosed is raised when the Transport has been closed.
 type ErrTransportClosed struct{}
 
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/armor"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmos "github.com/tendermint/tendermint/libs/os"
 )
@@ -18,9 +19,11 @@ type ID string
 // TODO: support other length addresses ?
 const IDByteLength = crypto.AddressSize
 
+// NodeKeyArmorType is the block type of an encrypted NodeKey file.
+const NodeKeyArmorType = "TENDERMINT NODE KEY"
+
 //------------------------------------------------------------------------------
 // Persistent peer ID
-// TODO: encrypt on disk
 
 // NodeKey is the persistent peer key.
 // It contains the nodes private key for authentication.
@@ -47,21 +50,47 @@ func PubKeyToID(pubKey crypto.PubKey) ID {
 // LoadOrGenNodeKey attempts to load the NodeKey from the given filePath.
 // If the file does not exist, it generates and saves a new NodeKey.
 func LoadOrGenNodeKey(filePath string) (*NodeKey, error) {
+	return LoadOrGenNodeKeyWithPassphrase(filePath, "")
+}
+
+// LoadOrGenNodeKeyWithPassphrase is like LoadOrGenNodeKey, but decrypts the
+// file with passphrase if it is encrypted, and encrypts a generated NodeKey
+// with it if not empty.
+func LoadOrGenNodeKeyWithPassphrase(filePath, passphrase string) (*NodeKey, error) {
 	if tmos.FileExists(filePath) {
-		nodeKey, err := LoadNodeKey(filePath)
+		nodeKey, err := LoadNodeKeyWithPassphrase(filePath, passphrase)
 		if err != nil {
 			return nil, err
 		}
 		return nodeKey, nil
 	}
-	return genNodeKey(filePath)
+	return genNodeKey(filePath, passphrase)
 }
 
 func LoadNodeKey(filePath string) (*NodeKey, error) {
+	return LoadNodeKeyWithPassphrase(filePath, "")
+}
+
+// LoadNodeKeyWithPassphrase loads the NodeKey from the given filePath,
+// decrypting it with passphrase if the file is encrypted.
+func LoadNodeKeyWithPassphrase(filePath, passphrase string) (*NodeKey, error) {
 	jsonBytes, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
+	if armor.IsArmored(jsonBytes) {
+		if passphrase == "" {
+			return nil, fmt.Errorf("NodeKey %v is encrypted, but no passphrase was given", filePath)
+		}
+		blockType, data, err := armor.UnarmorDecrypt(string(jsonBytes), passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting NodeKey from %v: %v", filePath, err)
+		}
+		if blockType != NodeKeyArmorType {
+			return nil, fmt.Errorf("unexpected block type %q in %v", blockType, filePath)
+		}
+		jsonBytes = data
+	}
 	nodeKey := new(NodeKey)
 	err = cdc.UnmarshalJSON(jsonBytes, nodeKey)
 	if err != nil {
@@ -70,18 +99,26 @@ func LoadNodeKey(filePath string) (*NodeKey, error) {
 	return nodeKey, nil
 }
 
-func genNodeKey(filePath string) (*NodeKey, error) {
+// SaveAs persists the NodeKey to filePath, encrypted with passphrase if not
+// empty.
+func (nodeKey *NodeKey) SaveAs(filePath, passphrase string) error {
+	jsonBytes, err := cdc.MarshalJSON(nodeKey)
+	if err != nil {
+		return err
+	}
+	if passphrase != "" {
+		jsonBytes = []byte(armor.EncryptArmor(NodeKeyArmorType, jsonBytes, passphrase))
+	}
+	return ioutil.WriteFile(filePath, jsonBytes, 0600)
+}
+
+func genNodeKey(filePath, passphrase string) (*NodeKey, error) {
 	privKey := ed25519.GenPrivKey()
 	nodeKey := &NodeKey{
 		PrivKey: privKey,
 	}
 
-	jsonBytes, err := cdc.MarshalJSON(nodeKey)
-	if err != nil {
-		return nil, err
-	}
-	err = ioutil.WriteFile(filePath, jsonBytes, 0600)
-	if err != nil {
+	if err := nodeKey.SaveAs(filePath, passphrase); err != nil {
 		return nil, err
 	}
 	return nodeKey, nil
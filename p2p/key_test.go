@@ -22,6 +22,29 @@ func TestLoadOrGenNodeKey(t *testing.T) {
 	assert.Equal(t, nodeKey, nodeKey2)
 }
 
+func TestLoadOrGenNodeKeyWithPassphrase(t *testing.T) {
+	filePath := filepath.Join(os.TempDir(), tmrand.Str(12)+"_peer_id.json")
+	defer os.Remove(filePath)
+
+	nodeKey, err := LoadOrGenNodeKeyWithPassphrase(filePath, "passphrase")
+	assert.Nil(t, err)
+
+	nodeKey2, err := LoadOrGenNodeKeyWithPassphrase(filePath, "passphrase")
+	assert.Nil(t, err)
+	assert.Equal(t, nodeKey, nodeKey2)
+
+	_, err = LoadNodeKey(filePath)
+	assert.Error(t, err)
+	_, err = LoadNodeKeyWithPassphrase(filePath, "wrong passphrase")
+	assert.Error(t, err)
+
+	// decrypt it
+	assert.Nil(t, nodeKey.SaveAs(filePath, ""))
+	nodeKey2, err = LoadNodeKey(filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, nodeKey, nodeKey2)
+}
+
 //----------------------------------------------------------
 
 func padBytes(bz []byte, targetBytes int) []byte {
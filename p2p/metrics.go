@@ -17,14 +17,24 @@ const (
 type Metrics struct {
 	// Number of peers.
 	Peers metrics.Gauge
-	// Number of bytes received from a given peer.
+	// Number of bytes received from a given peer, by channel and reactor.
 	PeerReceiveBytesTotal metrics.Counter
-	// Number of bytes sent to a given peer.
+	// Number of bytes sent to a given peer, by channel and reactor.
 	PeerSendBytesTotal metrics.Counter
+	// Number of messages received from a given peer, by channel and reactor.
+	PeerReceiveMessagesTotal metrics.Counter
+	// Number of messages sent to a given peer, by channel and reactor.
+	PeerSendMessagesTotal metrics.Counter
+	// Number of messages from a given peer dropped for exceeding the rate
+	// limit of their channel, by channel and reactor.
+	PeerDroppedMessagesTotal metrics.Counter
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge
 	// Number of transactions submitted by each peer.
 	NumTxs metrics.Gauge
+	// Number of peer behaviours reported by reactors, by kind and the action
+	// taken.
+	PeerBehaviours metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -47,13 +57,31 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Subsystem: MetricsSubsystem,
 			Name:      "peer_receive_bytes_total",
 			Help:      "Number of bytes received from a given peer.",
-		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		}, append(labels, "peer_id", "chID", "reactor")).With(labelsAndValues...),
 		PeerSendBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "peer_send_bytes_total",
 			Help:      "Number of bytes sent to a given peer.",
-		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		}, append(labels, "peer_id", "chID", "reactor")).With(labelsAndValues...),
+		PeerReceiveMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_receive_messages_total",
+			Help:      "Number of messages received from a given peer.",
+		}, append(labels, "peer_id", "chID", "reactor")).With(labelsAndValues...),
+		PeerSendMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_messages_total",
+			Help:      "Number of messages sent to a given peer.",
+		}, append(labels, "peer_id", "chID", "reactor")).With(labelsAndValues...),
+		PeerDroppedMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_dropped_messages_total",
+			Help:      "Number of messages from a given peer dropped for exceeding the rate limit of their channel.",
+		}, append(labels, "peer_id", "chID", "reactor")).With(labelsAndValues...),
 		PeerPendingSendBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -66,16 +94,26 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "num_txs",
 			Help:      "Number of transactions submitted by each peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerBehaviours: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_behaviours",
+			Help:      "Number of peer behaviours reported by reactors, by kind and the action taken.",
+		}, append(labels, "kind", "action")).With(labelsAndValues...),
 	}
 }
 
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Peers:                 discard.NewGauge(),
-		PeerReceiveBytesTotal: discard.NewCounter(),
-		PeerSendBytesTotal:    discard.NewCounter(),
-		PeerPendingSendBytes:  discard.NewGauge(),
-		NumTxs:                discard.NewGauge(),
+		Peers:                    discard.NewGauge(),
+		PeerReceiveBytesTotal:    discard.NewCounter(),
+		PeerSendBytesTotal:       discard.NewCounter(),
+		PeerReceiveMessagesTotal: discard.NewCounter(),
+		PeerSendMessagesTotal:    discard.NewCounter(),
+		PeerDroppedMessagesTotal: discard.NewCounter(),
+		PeerPendingSendBytes:     discard.NewGauge(),
+		NumTxs:                   discard.NewGauge(),
+		PeerBehaviours:           discard.NewCounter(),
 	}
 }
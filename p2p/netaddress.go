@@ -37,16 +37,24 @@ func IDAddressString(id ID, protocolHostPort string) string {
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
-// panic. Panics if ID is invalid.
+// NewNetAddress returns a new NetAddress using the provided TCP or UDP (for
+// QUIC) address. When testing, other net.Addr (except TCP and UDP) will
+// result in using 0.0.0.0:0. When normal run, other net.Addr (except TCP and
+// UDP) will panic. Panics if ID is invalid.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
-	tcpAddr, ok := addr.(*net.TCPAddr)
-	if !ok {
+	var (
+		ip   net.IP
+		port uint16
+	)
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = addr.IP, uint16(addr.Port)
+	case *net.UDPAddr:
+		ip, port = addr.IP, uint16(addr.Port)
+	default:
 		if flag.Lookup("test.v") == nil { // normal run
-			panic(fmt.Sprintf("Only TCPAddrs are supported. Got: %v", addr))
+			panic(fmt.Sprintf("Only TCPAddrs and UDPAddrs are supported. Got: %v", addr))
 		} else { // in testing
 			netAddr := NewNetAddressIPPort(net.IP("127.0.0.1"), 0)
 			netAddr.ID = id
@@ -58,8 +66,6 @@ func NewNetAddress(id ID, addr net.Addr) *NetAddress {
 		panic(fmt.Sprintf("Invalid ID %v: %v (addr: %v)", id, err, addr))
 	}
 
-	ip := tcpAddr.IP
-	port := uint16(tcpAddr.Port)
 	na := NewNetAddressIPPort(ip, port)
 	na.ID = id
 	return na
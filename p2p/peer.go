@@ -115,6 +115,13 @@ type peer struct {
 
 	metrics       *Metrics
 	metricsTicker *time.Ticker
+
+	// reactor names and metrics labels, by channel
+	reactorNames map[byte]string
+	chLabels     map[byte][]string
+
+	rateLimiter     *recvRateLimiter
+	onPeerBehaviour func(Peer, string, interface{})
 }
 
 type PeerOption func(*peer)
@@ -135,6 +142,7 @@ func newPeer(
 		Data:          cmap.NewCMap(),
 		metricsTicker: time.NewTicker(metricsTickerDuration),
 		metrics:       NopMetrics(),
+		rateLimiter:   newRecvRateLimiter(chDescs, mConfig.RecvRateLimits),
 	}
 
 	p.mconn = createMConnection(
@@ -149,6 +157,7 @@ func newPeer(
 	for _, option := range options {
 		option(p)
 	}
+	p.chLabels = channelMetricsLabels(p.ID(), chDescs, p.reactorNames)
 
 	return p
 }
@@ -249,11 +258,9 @@ func (p *peer) Send(chID byte, msgBytes []byte) bool {
 	}
 	res := p.mconn.Send(chID, msgBytes)
 	if res {
-		labels := []string{
-			"peer_id", string(p.ID()),
-			"chID", fmt.Sprintf("%#x", chID),
-		}
+		labels := p.channelLabels(chID)
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerSendMessagesTotal.With(labels...).Add(1)
 	}
 	return res
 }
@@ -268,11 +275,9 @@ func (p *peer) TrySend(chID byte, msgBytes []byte) bool {
 	}
 	res := p.mconn.TrySend(chID, msgBytes)
 	if res {
-		labels := []string{
-			"peer_id", string(p.ID()),
-			"chID", fmt.Sprintf("%#x", chID),
-		}
+		labels := p.channelLabels(chID)
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerSendMessagesTotal.With(labels...).Add(1)
 	}
 	return res
 }
@@ -342,6 +347,75 @@ func PeerMetrics(metrics *Metrics) PeerOption {
 	}
 }
 
+// allowRecv returns whether a message received from the peer on the channel is
+// within the channel's rate limit. Messages over the limit are counted, and
+// the first one of a flood is reported as BehaviourRateLimited.
+func allowRecv(
+	p Peer,
+	rateLimiter *recvRateLimiter,
+	chID byte,
+	metrics *Metrics,
+	labels []string,
+	onPeerBehaviour func(Peer, string, interface{}),
+) bool {
+	ok, report := rateLimiter.allow(chID)
+	if ok {
+		return true
+	}
+	metrics.PeerDroppedMessagesTotal.With(labels...).Add(1)
+	if report && onPeerBehaviour != nil {
+		// Don't block receiving on the Switch, which may stop the peer.
+		go onPeerBehaviour(p, BehaviourRateLimited,
+			fmt.Errorf("exceeded the rate limit of channel %#x", chID))
+	}
+	return false
+}
+
+// peerReactorNames sets the names of the reactors of the channels, used to
+// label the metrics.
+func peerReactorNames(reactorNames map[byte]string) PeerOption {
+	return func(p *peer) {
+		p.reactorNames = reactorNames
+	}
+}
+
+// peerOnBehaviour sets the function the peer reports its behaviour to.
+func peerOnBehaviour(onPeerBehaviour func(Peer, string, interface{})) PeerOption {
+	return func(p *peer) {
+		p.onPeerBehaviour = onPeerBehaviour
+	}
+}
+
+// channelLabels returns the labels of the metrics of the channel.
+func (p *peer) channelLabels(chID byte) []string {
+	if labels, ok := p.chLabels[chID]; ok {
+		return labels
+	}
+	return channelMetricsLabel(p.ID(), chID, p.reactorNames)
+}
+
+// channelMetricsLabels returns the labels of the metrics of each channel of a
+// peer: its ID, the channel ID and the name of the channel's reactor.
+func channelMetricsLabels(
+	id ID,
+	chDescs []*tmconn.ChannelDescriptor,
+	reactorNames map[byte]string,
+) map[byte][]string {
+	chLabels := make(map[byte][]string, len(chDescs))
+	for _, desc := range chDescs {
+		chLabels[desc.ID] = channelMetricsLabel(id, desc.ID, reactorNames)
+	}
+	return chLabels
+}
+
+func channelMetricsLabel(id ID, chID byte, reactorNames map[byte]string) []string {
+	return []string{
+		"peer_id", string(id),
+		"chID", fmt.Sprintf("%#x", chID),
+		"reactor", reactorNames[chID],
+	}
+}
+
 func (p *peer) metricsReporter() {
 	for {
 		select {
@@ -378,11 +452,12 @@ func createMConnection(
 			// which does onPeerError.
 			panic(fmt.Sprintf("Unknown channel %X", chID))
 		}
-		labels := []string{
-			"peer_id", string(p.ID()),
-			"chID", fmt.Sprintf("%#x", chID),
-		}
+		labels := p.channelLabels(chID)
 		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerReceiveMessagesTotal.With(labels...).Add(1)
+		if !allowRecv(p, p.rateLimiter, chID, p.metrics, labels, p.onPeerBehaviour) {
+			return
+		}
 		reactor.Receive(chID, p, msgBytes)
 	}
 
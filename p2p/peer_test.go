@@ -66,6 +66,26 @@ func TestPeerSend(t *testing.T) {
 	assert.True(p.Send(testCh, []byte("Asylum")))
 }
 
+func TestPeerChannelLabels(t *testing.T) {
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	p := &peer{
+		nodeInfo:     testNodeInfo(id, "peer"),
+		reactorNames: map[byte]string{0x01: "CONSENSUS", 0x02: "MEMPOOL"},
+	}
+	p.chLabels = channelMetricsLabels(id, []*tmconn.ChannelDescriptor{{ID: 0x01}}, p.reactorNames)
+
+	assert.Equal(t,
+		[]string{"peer_id", string(id), "chID", "0x1", "reactor", "CONSENSUS"},
+		p.channelLabels(0x01))
+	// channels missing from the descriptors are labelled too
+	assert.Equal(t,
+		[]string{"peer_id", string(id), "chID", "0x2", "reactor", "MEMPOOL"},
+		p.channelLabels(0x02))
+	assert.Equal(t,
+		[]string{"peer_id", string(id), "chID", "0x3", "reactor", ""},
+		p.channelLabels(0x03))
+}
+
 func createOutboundPeerAndPerformHandshake(
 	addr *NetAddress,
 	config *config.P2PConfig,
@@ -3,12 +3,14 @@ package pex
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/behaviour"
 	"github.com/tendermint/tendermint/libs/cmap"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/libs/rand"
@@ -94,6 +96,8 @@ type Reactor struct {
 
 	attemptsToDial sync.Map // address (string) -> {number of attempts (int), last time dialed (time.Time)}
 
+	reporter behaviour.Reporter
+
 	// seed/crawled mode fields
 	crawlPeerInfos map[p2p.ID]crawlPeerInfo
 }
@@ -141,6 +145,12 @@ func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
 	return r
 }
 
+// SetSwitch implements Reactor, reporting peer behaviour to the switch
+func (r *Reactor) SetSwitch(sw *p2p.Switch) {
+	r.BaseReactor.SetSwitch(sw)
+	r.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // OnStart implements BaseService
 func (r *Reactor) OnStart() error {
 	err := r.book.Start()
@@ -235,7 +245,7 @@ func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		r.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		r.Switch.StopPeerForError(src, err)
+		behaviour.ReportOrStop(r.reporter, r.Switch, src, behaviour.BadMessage(src.ID(), err.Error()), err)
 		return
 	}
 	r.Logger.Debug("Received message", "src", src, "chId", chID, "msg", msg)
@@ -271,7 +281,7 @@ func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
 		} else {
 			// Check we're not receiving requests too frequently.
 			if err := r.receiveRequest(src); err != nil {
-				r.Switch.StopPeerForError(src, err)
+				behaviour.ReportOrStop(r.reporter, r.Switch, src, behaviour.MessageOutOfOrder(src.ID(), err.Error()), err)
 				return
 			}
 			r.SendAddrs(src, r.book.GetSelection())
@@ -280,7 +290,7 @@ func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
 	case *pexAddrsMessage:
 		// If we asked for addresses, add them to the book
 		if err := r.ReceiveAddrs(msg.Addrs, src); err != nil {
-			r.Switch.StopPeerForError(src, err)
+			behaviour.ReportOrStop(r.reporter, r.Switch, src, behaviour.MessageOutOfOrder(src.ID(), err.Error()), err)
 			return
 		}
 	default:
@@ -456,16 +466,18 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := tmmath.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	// Try maxAttempts times to pick candidates, and dial the numToDial most
+	// trusted ones.
 	maxAttempts := numToDial * 3
+	picked := make(map[p2p.ID]struct{})
+	candidates := make([]*p2p.NetAddress, 0, maxAttempts)
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if _, selected := picked[try.ID]; selected {
 			continue
 		}
 		if r.Switch.IsDialingOrExistingAddress(try) {
@@ -474,8 +486,14 @@ func (r *Reactor) ensurePeers() {
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialling again, or have dialed too many times already
-		r.Logger.Info("Will dial address", "addr", try)
-		toDial[try.ID] = try
+		picked[try.ID] = struct{}{}
+		candidates = append(candidates, try)
+	}
+
+	toDial := make(map[p2p.ID]*p2p.NetAddress)
+	for _, addr := range r.mostTrusted(candidates, numToDial) {
+		r.Logger.Info("Will dial address", "addr", addr)
+		toDial[addr.ID] = addr
 	}
 
 	// Dial picked addresses
@@ -513,6 +531,24 @@ func (r *Reactor) ensurePeers() {
 	}
 }
 
+// mostTrusted returns the n addresses with the highest trust scores, keeping
+// the order of equally trusted addresses.
+func (r *Reactor) mostTrusted(addrs []*p2p.NetAddress, n int) []*p2p.NetAddress {
+	scores := make(map[p2p.ID]int, len(addrs))
+	for _, addr := range addrs {
+		scores[addr.ID] = r.Switch.PeerTrustScore(addr.ID)
+	}
+	sorted := make([]*p2p.NetAddress, len(addrs))
+	copy(sorted, addrs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return scores[sorted[i].ID] > scores[sorted[j].ID]
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
 func (r *Reactor) dialAttemptsInfo(addr *p2p.NetAddress) (attempts int, lastDialed time.Time) {
 	_attempts, ok := r.attemptsToDial.Load(addr.DialString())
 	if !ok {
@@ -15,6 +15,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/mock"
+	"github.com/tendermint/tendermint/p2p/trust"
+	dbm "github.com/tendermint/tm-db"
 )
 
 var (
@@ -518,6 +520,31 @@ func TestPEXReactorDialPeer(t *testing.T) {
 	}
 }
 
+func TestPEXReactorPrefersTrustedAddresses(t *testing.T) {
+	pexR, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+
+	store := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := p2p.MakeSwitch(cfg, 0, "127.0.0.1", "123.123.123",
+		func(i int, sw *p2p.Switch) *p2p.Switch { return sw }, p2p.SwitchTrustMetricStore(store))
+	sw.AddReactor(pexR.String(), pexR)
+	require.NoError(t, store.Start())
+	defer store.Stop()
+
+	addrs := make([]*p2p.NetAddress, 4)
+	for i := range addrs {
+		addrs[i] = mock.NewPeer(nil).SocketAddr()
+	}
+	store.GetPeerTrustMetric(string(addrs[0].ID)).BadEvents(10)
+	store.GetPeerTrustMetric(string(addrs[1].ID)).BadEvents(1)
+	store.GetPeerTrustMetric(string(addrs[1].ID)).GoodEvents(1)
+
+	// unknown addresses keep their order, and come before untrusted ones
+	assert.Equal(t, []*p2p.NetAddress{addrs[2], addrs[3], addrs[1]}, pexR.mostTrusted(addrs, 3))
+	assert.Equal(t, []*p2p.NetAddress{addrs[2], addrs[3], addrs[1], addrs[0]}, pexR.mostTrusted(addrs, 10))
+	assert.Empty(t, pexR.mostTrusted(nil, 3))
+}
+
 func assertPeersWithTimeout(
 	t *testing.T,
 	switches []*p2p.Switch,
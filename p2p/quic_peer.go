@@ -0,0 +1,535 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+
+	"github.com/tendermint/tendermint/libs/cmap"
+	flow "github.com/tendermint/tendermint/libs/flowrate"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
+)
+
+const (
+	// quicSendTimeout is how long Send waits for room in a channel's queue,
+	// like MConnection.Send.
+	quicSendTimeout = 10 * time.Second
+
+	// quicFlushTimeout is how long FlushStop waits for the queued messages
+	// to be sent.
+	quicFlushTimeout = 10 * time.Second
+)
+
+// quicChannel is a channel of a quicPeer. Its messages are sent, in order, on
+// their own unidirectional QUIC stream, as uvarint length-prefixed bytes. The
+// first byte of the stream is the channel ID.
+type quicChannel struct {
+	desc         tmconn.ChannelDescriptor
+	sendQueue    chan []byte
+	sendQueueLen int32 // atomic
+	recentlySent int64 // atomic
+	sendMonitor  *flow.Monitor
+	recvMonitor  *flow.Monitor
+}
+
+// quicPeer implements Peer for QUICTransport. Each channel is sent on its own
+// QUIC stream, so a slow channel doesn't hold back the others.
+type quicPeer struct {
+	service.BaseService
+
+	// raw peerConn, whose conn is the control stream, and the QUIC connection
+	peerConn
+	qc quic.Connection
+
+	// peer's node info and the channel it knows about
+	nodeInfo NodeInfo
+	channels []byte
+
+	chDescs         []*tmconn.ChannelDescriptor
+	channelsByID    map[byte]*quicChannel
+	reactorsByCh    map[byte]Reactor
+	onPeerError     func(Peer, interface{})
+	onPeerBehaviour func(Peer, string, interface{})
+	rateLimiter     *recvRateLimiter
+
+	created     time.Time
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+
+	quit     chan struct{} // closed to stop sending
+	flush    chan struct{} // closed to send the queued messages, then stop
+	sendWg   sync.WaitGroup
+	stopOnce sync.Once
+	errored  uint32 // atomic
+
+	// User data
+	Data *cmap.CMap
+
+	metrics       *Metrics
+	metricsTicker *time.Ticker
+	chLabels      map[byte][]string // metrics labels, by channel
+}
+
+var _ Peer = (*quicPeer)(nil)
+
+func newQUICPeer(
+	pc peerConn,
+	qc quic.Connection,
+	nodeInfo NodeInfo,
+	cfg peerConfig,
+	mConfig tmconn.MConnConfig,
+) (*quicPeer, error) {
+	ni, ok := nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return nil, errors.Errorf("unsupported node info %T", nodeInfo)
+	}
+	p := &quicPeer{
+		peerConn:        pc,
+		qc:              qc,
+		nodeInfo:        nodeInfo,
+		channels:        ni.Channels,
+		chDescs:         cfg.chDescs,
+		channelsByID:    make(map[byte]*quicChannel, len(cfg.chDescs)),
+		reactorsByCh:    cfg.reactorsByCh,
+		onPeerError:     cfg.onPeerError,
+		onPeerBehaviour: cfg.onPeerBehaviour,
+		rateLimiter:     newRecvRateLimiter(cfg.chDescs, mConfig.RecvRateLimits),
+		created:         time.Now(),
+		sendMonitor:     flow.New(0, 0),
+		recvMonitor:     flow.New(0, 0),
+		quit:            make(chan struct{}),
+		flush:           make(chan struct{}),
+		Data:            cmap.NewCMap(),
+		metricsTicker:   time.NewTicker(metricsTickerDuration),
+		metrics:         NopMetrics(),
+		chLabels:        channelMetricsLabels(nodeInfo.ID(), cfg.chDescs, cfg.reactorNames),
+	}
+	for _, desc := range cfg.chDescs {
+		filled := desc.FillDefaults()
+		p.channelsByID[desc.ID] = &quicChannel{
+			desc:        filled,
+			sendQueue:   make(chan []byte, filled.SendQueueCapacity),
+			sendMonitor: flow.New(0, 0),
+			recvMonitor: flow.New(0, 0),
+		}
+	}
+
+	if cfg.metrics != nil {
+		p.metrics = cfg.metrics
+	}
+
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+	return p, nil
+}
+
+// String representation.
+func (p *quicPeer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{QUIC %v %v out}", p.RemoteAddr(), p.ID())
+	}
+
+	return fmt.Sprintf("Peer{QUIC %v %v in}", p.RemoteAddr(), p.ID())
+}
+
+//---------------------------------------------------
+// Implements service.Service
+
+// OnStart implements BaseService.
+func (p *quicPeer) OnStart() error {
+	if err := p.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	// Streams are only opened for the channels the peer knows about, as it
+	// resets the others.
+	for _, chID := range p.channels {
+		if ch, ok := p.channelsByID[chID]; ok {
+			p.sendWg.Add(1)
+			go p.sendRoutine(ch)
+		}
+	}
+	go p.recvRoutine()
+	go p.metricsReporter()
+	return nil
+}
+
+// FlushStop mimics OnStop but additionally ensures that all successful
+// .Send() calls will get flushed before closing the connection.
+// NOTE: it is not safe to call this method more than once.
+func (p *quicPeer) FlushStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+
+	close(p.flush)
+	done := make(chan struct{})
+	go func() {
+		p.sendWg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(quicFlushTimeout):
+		p.Logger.Error("Timed out flushing the send queues", "peer", p)
+	}
+	p.stop()
+}
+
+// OnStop implements BaseService.
+func (p *quicPeer) OnStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	p.stop()
+}
+
+// stop stops sending and closes the connection. It is safe to call it more
+// than once.
+func (p *quicPeer) stop() {
+	p.stopOnce.Do(func() {
+		close(p.quit)
+		_ = p.qc.CloseWithError(quicCodeClosed, "")
+	})
+}
+
+//---------------------------------------------------
+// Implements Peer
+
+// ID returns the peer's ID - the hex encoded hash of its pubkey.
+func (p *quicPeer) ID() ID {
+	return p.nodeInfo.ID()
+}
+
+// IsOutbound returns true if the connection is outbound, false otherwise.
+func (p *quicPeer) IsOutbound() bool {
+	return p.peerConn.outbound
+}
+
+// IsPersistent returns true if the peer is persitent, false otherwise.
+func (p *quicPeer) IsPersistent() bool {
+	return p.peerConn.persistent
+}
+
+// NodeInfo returns a copy of the peer's NodeInfo.
+func (p *quicPeer) NodeInfo() NodeInfo {
+	return p.nodeInfo
+}
+
+// SocketAddr returns the address of the socket.
+// For outbound peers, it's the address dialed (after DNS resolution).
+// For inbound peers, it's the address returned by the underlying connection
+// (not what's reported in the peer's NodeInfo).
+func (p *quicPeer) SocketAddr() *NetAddress {
+	return p.peerConn.socketAddr
+}
+
+// Status returns the peer's ConnectionStatus.
+func (p *quicPeer) Status() tmconn.ConnectionStatus {
+	status := tmconn.ConnectionStatus{
+		Duration:    time.Since(p.created),
+		SendMonitor: p.sendMonitor.Status(),
+		RecvMonitor: p.recvMonitor.Status(),
+		Channels:    make([]tmconn.ChannelStatus, len(p.chDescs)),
+	}
+	for i, desc := range p.chDescs {
+		ch := p.channelsByID[desc.ID]
+		status.Channels[i] = tmconn.ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&ch.sendQueueLen)),
+			Priority:          ch.desc.Priority,
+			RecentlySent:      atomic.LoadInt64(&ch.recentlySent),
+			SendRate:          ch.sendMonitor.Status().CurRate,
+			RecvRate:          ch.recvMonitor.Status().CurRate,
+		}
+	}
+	return status
+}
+
+// Send msg bytes to the channel identified by chID byte. Returns false if the
+// send queue is full after timeout, like MConnection.
+func (p *quicPeer) Send(chID byte, msgBytes []byte) bool {
+	return p.send(chID, msgBytes, quicSendTimeout)
+}
+
+// TrySend msg bytes to the channel identified by chID byte. Immediately returns
+// false if the send queue is full.
+func (p *quicPeer) TrySend(chID byte, msgBytes []byte) bool {
+	return p.send(chID, msgBytes, 0)
+}
+
+func (p *quicPeer) send(chID byte, msgBytes []byte, timeout time.Duration) bool {
+	if !p.IsRunning() {
+		// see Switch#Broadcast, where we fetch the list of peers and loop over
+		// them - while we're looping, one peer may be removed and stopped.
+		return false
+	} else if !p.hasChannel(chID) {
+		return false
+	}
+	ch, ok := p.channelsByID[chID]
+	if !ok {
+		p.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	var timeoutc <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutc = timer.C
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		atomic.AddInt32(&ch.sendQueueLen, 1)
+	default:
+		if timeoutc == nil {
+			return false
+		}
+		select {
+		case ch.sendQueue <- msgBytes:
+			atomic.AddInt32(&ch.sendQueueLen, 1)
+		case <-timeoutc:
+			return false
+		case <-p.quit:
+			return false
+		}
+	}
+
+	labels := p.chLabels[chID]
+	p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	p.metrics.PeerSendMessagesTotal.With(labels...).Add(1)
+	return true
+}
+
+// Get the data for a given key.
+func (p *quicPeer) Get(key string) interface{} {
+	return p.Data.Get(key)
+}
+
+// Set sets the data for the given key.
+func (p *quicPeer) Set(key string, data interface{}) {
+	p.Data.Set(key, data)
+}
+
+// hasChannel returns true if the peer reported
+// knowing about the given chID.
+func (p *quicPeer) hasChannel(chID byte) bool {
+	for _, ch := range p.channels {
+		if ch == chID {
+			return true
+		}
+	}
+	p.Logger.Debug(
+		"Unknown channel for peer",
+		"channel",
+		chID,
+		"channels",
+		p.channels,
+	)
+	return false
+}
+
+// CloseConn closes the QUIC connection. Used for cleaning up in cases where
+// the peer had not been started at all.
+func (p *quicPeer) CloseConn() error {
+	return p.peerConn.conn.Close()
+}
+
+// RemoteAddr returns peer's remote network address.
+func (p *quicPeer) RemoteAddr() net.Addr {
+	return p.peerConn.conn.RemoteAddr()
+}
+
+//---------------------------------------------------
+
+// sendRoutine opens the stream of the channel and writes its queued messages
+// to it.
+func (p *quicPeer) sendRoutine(ch *quicChannel) {
+	defer p.sendWg.Done()
+
+	stream, err := p.qc.OpenUniStreamSync(p.qc.Context())
+	if err != nil {
+		p.stopForError(errors.Wrapf(err, "failed to open stream for channel %X", ch.desc.ID))
+		return
+	}
+	w := bufio.NewWriter(stream)
+	if err := w.WriteByte(ch.desc.ID); err != nil {
+		p.stopForError(err)
+		return
+	}
+
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	write := func(msgBytes []byte) error {
+		atomic.AddInt32(&ch.sendQueueLen, -1)
+		n := binary.PutUvarint(lenBuf, uint64(len(msgBytes)))
+		if _, err := w.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(msgBytes); err != nil {
+			return err
+		}
+		p.sendMonitor.Update(n + len(msgBytes))
+		ch.sendMonitor.Update(n + len(msgBytes))
+		atomic.AddInt64(&ch.recentlySent, int64(n+len(msgBytes)))
+		// Only flush once the queue is drained, to batch small messages.
+		if len(ch.sendQueue) == 0 {
+			return w.Flush()
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case msgBytes := <-ch.sendQueue:
+			if err := write(msgBytes); err != nil {
+				p.stopForError(err)
+				return
+			}
+		case <-p.flush:
+			for {
+				select {
+				case msgBytes := <-ch.sendQueue:
+					if err := write(msgBytes); err != nil {
+						return
+					}
+				default:
+					if err := w.Flush(); err == nil {
+						_ = stream.Close()
+					}
+					return
+				}
+			}
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// recvRoutine accepts the streams of the channels opened by the peer. Streams
+// of channels we don't know about are reset, so peers with other reactors can
+// still talk on the channels we share.
+func (p *quicPeer) recvRoutine() {
+	var opened [256]bool
+	for {
+		stream, err := p.qc.AcceptUniStream(p.qc.Context())
+		if err != nil {
+			p.stopForError(err)
+			return
+		}
+
+		r := bufio.NewReader(stream)
+		chID, err := r.ReadByte()
+		if err != nil {
+			p.stopForError(err)
+			return
+		}
+		ch, ok := p.channelsByID[chID]
+		if !ok || p.reactorsByCh[chID] == nil {
+			p.Logger.Debug("Resetting stream of unknown channel", "peer", p, "channel", chID)
+			stream.CancelRead(quicCodeUnknownChannel)
+			continue
+		}
+		if opened[chID] {
+			p.stopForError(fmt.Errorf("channel %X opened twice", chID))
+			return
+		}
+		opened[chID] = true
+
+		go p.recvChannel(ch, r)
+	}
+}
+
+// recvChannel reads the messages of a channel and passes them to its reactor.
+func (p *quicPeer) recvChannel(ch *quicChannel, r *bufio.Reader) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.stopForError(fmt.Errorf("recovered from panic: %v", r))
+		}
+	}()
+
+	chID := ch.desc.ID
+	reactor := p.reactorsByCh[chID]
+	labels := p.chLabels[chID]
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			p.stopForError(err)
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) {
+			p.stopForError(fmt.Errorf(
+				"received message exceeds available capacity: %v < %v",
+				ch.desc.RecvMessageCapacity,
+				size,
+			))
+			return
+		}
+		msgBytes := make([]byte, size)
+		if _, err := io.ReadFull(r, msgBytes); err != nil {
+			p.stopForError(err)
+			return
+		}
+		p.recvMonitor.Update(uvarintSize(size) + len(msgBytes))
+		ch.recvMonitor.Update(uvarintSize(size) + len(msgBytes))
+
+		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerReceiveMessagesTotal.With(labels...).Add(1)
+		if !allowRecv(p, p.rateLimiter, chID, p.metrics, labels, p.onPeerBehaviour) {
+			continue
+		}
+		reactor.Receive(chID, p, msgBytes)
+	}
+}
+
+// stopForError reports the first error of a running peer, so the Switch stops
+// it.
+func (p *quicPeer) stopForError(err error) {
+	select {
+	case <-p.quit:
+		// Errors are expected once we closed the connection.
+		return
+	default:
+	}
+	if atomic.CompareAndSwapUint32(&p.errored, 0, 1) {
+		p.Logger.Error("Connection failed", "peer", p, "err", err)
+		if p.onPeerError != nil {
+			p.onPeerError(p, err)
+		}
+	}
+}
+
+func (p *quicPeer) metricsReporter() {
+	for {
+		select {
+		case <-p.metricsTicker.C:
+			var sendQueueSize float64
+			for _, ch := range p.channelsByID {
+				sendQueueSize += float64(atomic.LoadInt32(&ch.sendQueueLen))
+			}
+
+			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// SetLogger implements BaseService.
+func (p *quicPeer) SetLogger(l log.Logger) {
+	p.Logger = l
+}
+
+// uvarintSize returns the number of bytes of the uvarint encoding of x.
+func uvarintSize(x uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], x)
+}
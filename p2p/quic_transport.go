@@ -0,0 +1,497 @@
+package p2p
+
+import (
+	"context"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+const (
+	// quicALPN is the TLS application protocol negotiated by QUICTransport.
+	quicALPN = "tendermint-p2p"
+
+	// Application error codes sent when closing a QUIC connection.
+	quicCodeClosed   quic.ApplicationErrorCode = 0
+	quicCodeRejected quic.ApplicationErrorCode = 1
+
+	// quicCodeUnknownChannel resets the stream of a channel we don't know.
+	quicCodeUnknownChannel quic.StreamErrorCode = 2
+)
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.connFilters = filters }
+}
+
+// QUICTransportFilterTimeout sets the timeout waited for filter calls to
+// return.
+func QUICTransportFilterTimeout(timeout time.Duration) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.filterTimeout = timeout }
+}
+
+// QUICTransportResolver sets the Resolver used for ip lookups, defaults to
+// net.DefaultResolver.
+func QUICTransportResolver(resolver IPResolver) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.resolver = resolver }
+}
+
+// QUICTransportBanList sets the list of banned peers, whose connections are
+// rejected.
+func QUICTransportBanList(banList *BanList) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.banList = banList }
+}
+
+// QUICTransport accepts and dials QUIC connections and upgrades them to peers.
+// Unlike MultiplexTransport, which multiplexes all channels over a single TCP
+// stream with MConnection, each channel is sent on its own QUIC stream, so a
+// slow channel doesn't hold back the others.
+//
+// Peers are authenticated with TLS 1.3, using a self-signed certificate for the
+// ed25519 node key: the remote node ID is derived from the key of the
+// certificate presented by the peer. NodeInfo is then exchanged on a
+// bidirectional control stream, with the same checks as MultiplexTransport.
+type QUICTransport struct {
+	netAddr  NetAddress
+	listener *quic.Listener
+
+	acceptc chan accept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       ConnSet
+	connFilters []ConnFilterFunc
+	banList     *BanList // may be nil
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeKey          NodeKey
+	resolver         IPResolver
+
+	tlsConfig  *tls.Config
+	quicConfig *quic.Config
+	mConfig    conn.MConnConfig
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC transport for the node. It returns an error
+// if the node key is not an ed25519 key. Only the ping interval and pong
+// timeout of mConfig, to keep connections alive and detect dead ones, and the
+// receive rate limits are used.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) (*QUICTransport, error) {
+	tlsConfig, err := quicTLSConfig(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QUICTransport{
+		acceptc:          make(chan accept),
+		closec:           make(chan struct{}),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		mConfig:          mConfig,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		conns:            NewConnSet(),
+		resolver:         net.DefaultResolver,
+		tlsConfig:        tlsConfig,
+		quicConfig: &quic.Config{
+			HandshakeIdleTimeout: defaultHandshakeTimeout,
+			MaxIdleTimeout:       mConfig.PingInterval + mConfig.PongTimeout,
+			KeepAlivePeriod:      mConfig.PingInterval,
+			// The control stream, and one stream per channel.
+			MaxIncomingStreams:    1,
+			MaxIncomingUniStreams: maxNumChannels,
+		},
+	}, nil
+}
+
+// NetAddress implements Transport.
+func (qt *QUICTransport) NetAddress() NetAddress {
+	return qt.netAddr
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-qt.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		cfg.outbound = false
+
+		return qt.wrapPeer(a.conn.(*quicConn), a.nodeInfo, cfg, a.netAddr)
+	case <-qt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (qt *QUICTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout+qt.handshakeTimeout)
+	defer cancel()
+
+	qc, err := quic.DialAddr(ctx, addr.DialString(), qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := qc.OpenStreamSync(ctx)
+	if err != nil {
+		_ = qc.CloseWithError(quicCodeClosed, "")
+		return nil, err
+	}
+	c := &quicConn{Stream: stream, qc: qc}
+
+	if err := qt.filterConn(c); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgrade(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr)
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	close(qt.closec)
+
+	if qt.listener != nil {
+		return qt.listener.Close()
+	}
+
+	return nil
+}
+
+// Listen implements transportLifecycle. The transport listens on UDP, on the
+// host and port of the address.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	ln, err := quic.ListenAddr(addr.DialString(), qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return err
+	}
+
+	qt.netAddr = addr
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	for {
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- accept{err: err}
+			return
+		}
+
+		// Connection upgrade and filtering is asynchronous, see
+		// MultiplexTransport.acceptPeers.
+		go func(qc quic.Connection) {
+			var (
+				c        *quicConn
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+			stream, err := qc.AcceptStream(ctx)
+			cancel()
+			if err != nil {
+				_ = qc.CloseWithError(quicCodeRejected, "")
+				err = ErrRejected{
+					err:           fmt.Errorf("control stream failed: %v", err),
+					isAuthFailure: true,
+				}
+			} else {
+				c = &quicConn{Stream: stream, qc: qc}
+				err = qt.filterConn(c)
+				if err == nil {
+					nodeInfo, err = qt.upgrade(c, nil)
+					if err == nil {
+						netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+					}
+				}
+			}
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = qc.CloseWithError(quicCodeClosed, "")
+				return
+			}
+		}(qc)
+	}
+}
+
+// Cleanup removes the given address from the connections set and
+// closes the connection.
+func (qt *QUICTransport) Cleanup(p Peer) {
+	qt.conns.RemoveAddr(p.RemoteAddr())
+	_ = p.CloseConn()
+}
+
+// IsConnectionError implements Transport. QUIC connections also fail with the
+// application errors they are closed with.
+func (qt *QUICTransport) IsConnectionError(err error) bool {
+	var appErr *quic.ApplicationError
+	return (&MultiplexTransport{}).IsConnectionError(err) || errors.As(err, &appErr)
+}
+
+func (qt *QUICTransport) cleanup(c *quicConn) error {
+	qt.conns.Remove(c)
+
+	return c.qc.CloseWithError(quicCodeRejected, "")
+}
+
+func (qt *QUICTransport) filterConn(c *quicConn) (err error) {
+	defer func() {
+		if err != nil {
+			_ = c.qc.CloseWithError(quicCodeRejected, "")
+		}
+	}()
+
+	// Reject if connection is already present.
+	if qt.conns.Has(c) {
+		return ErrRejected{conn: c, isDuplicate: true}
+	}
+
+	// Resolve ips for incoming conn.
+	ips, err := resolveIPs(qt.resolver, c)
+	if err != nil {
+		return err
+	}
+
+	if qt.banList != nil {
+		for _, ip := range ips {
+			if qt.banList.IsIPBanned(ip) {
+				return ErrRejected{conn: c, isBanned: true}
+			}
+		}
+	}
+
+	errc := make(chan error, len(qt.connFilters))
+
+	for _, f := range qt.connFilters {
+		go func(f ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
+			errc <- f(qt.conns, c, ips)
+		}(f, c, ips, errc)
+	}
+
+	for i := 0; i < cap(errc); i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return ErrRejected{conn: c, err: err, isFiltered: true}
+			}
+		case <-time.After(qt.filterTimeout):
+			return ErrFilterTimeout{}
+		}
+	}
+
+	qt.conns.Set(c, ips)
+
+	return nil
+}
+
+func (qt *QUICTransport) upgrade(
+	c *quicConn,
+	dialedAddr *NetAddress,
+) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	pubKey, err := quicRemotePubKey(c.qc)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("tls auth failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	return handshakePeer(
+		c,
+		PubKeyToID(pubKey),
+		dialedAddr,
+		qt.nodeInfo,
+		qt.banList,
+		qt.handshakeTimeout,
+	)
+}
+
+func (qt *QUICTransport) wrapPeer(
+	c *quicConn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) (Peer, error) {
+
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	peerConn := newPeerConn(
+		cfg.outbound,
+		persistent,
+		c,
+		socketAddr,
+	)
+
+	p, err := newQUICPeer(
+		peerConn,
+		c.qc,
+		ni,
+		cfg,
+		qt.mConfig,
+	)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// quicConn is the control stream of a QUIC connection, as a net.Conn. Closing
+// it closes the whole connection.
+type quicConn struct {
+	quic.Stream
+	qc quic.Connection
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+func (c *quicConn) LocalAddr() net.Addr  { return c.qc.LocalAddr() }
+func (c *quicConn) RemoteAddr() net.Addr { return c.qc.RemoteAddr() }
+
+func (c *quicConn) Close() error {
+	return c.qc.CloseWithError(quicCodeClosed, "")
+}
+
+// quicTLSConfig returns the TLS config of a node, both as a client and as a
+// server. The node presents a self-signed certificate for its node key, and
+// requires the same from its peers. The certificate is not verified against
+// any CA: the node ID, derived from the key, is checked instead.
+func quicTLSConfig(nodeKey NodeKey) (*tls.Config, error) {
+	privKey, ok := nodeKey.PrivKey.(ed25519.PrivKeyEd25519)
+	if !ok {
+		return nil, errors.Errorf("QUIC transport requires an ed25519 node key, got %T", nodeKey.PrivKey)
+	}
+	key := stded25519.PrivateKey(privKey[:])
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Unix(0, 0),
+		NotAfter:     time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create TLS certificate")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{certDER},
+			PrivateKey:  key,
+		}},
+		ClientAuth:            tls.RequireAnyClientCert,
+		InsecureSkipVerify:    true, // nolint: gosec // the peer is verified by its key
+		VerifyPeerCertificate: verifyQUICPeerCertificate,
+		NextProtos:            []string{quicALPN},
+		MinVersion:            tls.VersionTLS13,
+	}, nil
+}
+
+// verifyQUICPeerCertificate checks that the peer presented a single
+// certificate for an ed25519 key. TLS has already checked that the peer holds
+// the private key.
+func verifyQUICPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) != 1 {
+		return errors.Errorf("expected 1 certificate, got %d", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := cert.PublicKey.(stded25519.PublicKey); !ok {
+		return errors.Errorf("expected an ed25519 key, got %T", cert.PublicKey)
+	}
+	return nil
+}
+
+// quicRemotePubKey returns the node key of the remote end of an established
+// QUIC connection.
+func quicRemotePubKey(qc quic.Connection) (ed25519.PubKeyEd25519, error) {
+	var pubKey ed25519.PubKeyEd25519
+
+	certs := qc.ConnectionState().TLS.PeerCertificates
+	if len(certs) != 1 {
+		return pubKey, errors.Errorf("expected 1 peer certificate, got %d", len(certs))
+	}
+	key, ok := certs[0].PublicKey.(stded25519.PublicKey)
+	if !ok || len(key) != ed25519.PubKeyEd25519Size {
+		return pubKey, errors.Errorf("expected an ed25519 key, got %T", certs[0].PublicKey)
+	}
+	copy(pubKey[:], key)
+	return pubKey, nil
+}
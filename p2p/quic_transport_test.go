@@ -0,0 +1,251 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// blockingReactor passes received messages on, and blocks receiving on
+// blockedCh until unblocked.
+type blockingReactor struct {
+	BaseReactor
+
+	blockedCh byte
+	unblock   chan struct{}
+	received  chan PeerMessage
+}
+
+func (r *blockingReactor) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{{ID: 0x01}, {ID: 0x02}}
+}
+
+func (r *blockingReactor) Receive(chID byte, peer Peer, msgBytes []byte) {
+	if chID == r.blockedCh {
+		<-r.unblock
+	}
+	r.received <- PeerMessage{PeerID: peer.ID(), Bytes: msgBytes, Counter: int(chID)}
+}
+
+func testSetupQUICTransport(t *testing.T, channels []byte) (*QUICTransport, *NetAddress) {
+	pv := ed25519.GenPrivKey()
+	id := PubKeyToID(pv.PubKey())
+	nodeInfo := testNodeInfo(id, "transport").(DefaultNodeInfo)
+	nodeInfo.Channels = channels
+
+	qt, err := NewQUICTransport(nodeInfo, NodeKey{PrivKey: pv}, conn.DefaultMConnConfig())
+	require.NoError(t, err)
+
+	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+
+	return qt, NewNetAddress(id, qt.listener.Addr())
+}
+
+func TestQUICTransportRequiresEd25519(t *testing.T) {
+	pv := secp256k1.GenPrivKey()
+	_, err := NewQUICTransport(
+		testNodeInfo(PubKeyToID(pv.PubKey()), "transport"),
+		NodeKey{PrivKey: pv},
+		conn.DefaultMConnConfig(),
+	)
+	assert.Error(t, err)
+}
+
+func TestQUICTransportIsConnectionError(t *testing.T) {
+	qt := &QUICTransport{}
+	assert.True(t, qt.IsConnectionError(fmt.Errorf("read: %w", io.EOF)))
+	assert.True(t, qt.IsConnectionError(&quic.ApplicationError{ErrorCode: 0}))
+	assert.False(t, qt.IsConnectionError(errors.New("bad message")))
+
+	mt := &MultiplexTransport{}
+	assert.True(t, mt.IsConnectionError(conn.ErrPongTimeout))
+	assert.False(t, mt.IsConnectionError(&quic.ApplicationError{ErrorCode: 0}))
+}
+
+func TestQUICTransportChannelStreams(t *testing.T) {
+	channels := []byte{0x01, 0x02}
+	listener, listenerAddr := testSetupQUICTransport(t, channels)
+	defer listener.Close()
+	dialer, _ := testSetupQUICTransport(t, channels)
+	defer dialer.Close()
+
+	reactor := &blockingReactor{
+		blockedCh: 0x01,
+		unblock:   make(chan struct{}),
+		received:  make(chan PeerMessage, 10),
+	}
+	reactor.BaseReactor = *NewBaseReactor("blockingReactor", reactor)
+	cfg := peerConfig{
+		chDescs:      reactor.GetChannels(),
+		onPeerError:  func(p Peer, r interface{}) {},
+		reactorsByCh: map[byte]Reactor{0x01: reactor, 0x02: reactor},
+		metrics:      NopMetrics(),
+	}
+
+	acceptc := make(chan Peer)
+	go func() {
+		p, err := listener.Accept(cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		acceptc <- p
+	}()
+
+	out, err := dialer.Dial(*listenerAddr, cfg)
+	require.NoError(t, err)
+	in := <-acceptc
+	require.NotNil(t, in)
+
+	// Node IDs are authenticated by the TLS certificates.
+	assert.Equal(t, listenerAddr.ID, out.ID())
+	assert.Equal(t, dialer.nodeInfo.ID(), in.ID())
+	assert.True(t, out.IsOutbound())
+	assert.False(t, in.IsOutbound())
+
+	require.NoError(t, out.Start())
+	defer out.Stop() // nolint: errcheck
+	require.NoError(t, in.Start())
+	defer in.Stop() // nolint: errcheck
+
+	// A message stuck in the reactor of channel 0x01 doesn't hold back 0x02.
+	require.True(t, out.Send(0x01, []byte("blocked")))
+	require.True(t, out.Send(0x02, []byte("through")))
+	select {
+	case msg := <-reactor.received:
+		assert.Equal(t, 0x02, msg.Counter)
+		assert.Equal(t, []byte("through"), msg.Bytes)
+		assert.Equal(t, in.ID(), msg.PeerID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message on channel 0x02 was not received")
+	}
+
+	close(reactor.unblock)
+	select {
+	case msg := <-reactor.received:
+		assert.Equal(t, 0x01, msg.Counter)
+		assert.Equal(t, []byte("blocked"), msg.Bytes)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message on channel 0x01 was not received")
+	}
+
+	// Sending on a channel the peer doesn't know about fails.
+	assert.False(t, in.Send(0x03, []byte("unknown")))
+}
+
+func TestQUICTransportMismatchedChannels(t *testing.T) {
+	// The listener advertises channel 0x02 without having a reactor for it,
+	// and the dialer has a channel 0x03 the listener doesn't know about.
+	listener, listenerAddr := testSetupQUICTransport(t, []byte{0x01, 0x02})
+	defer listener.Close()
+	dialer, _ := testSetupQUICTransport(t, []byte{0x01, 0x02, 0x03})
+	defer dialer.Close()
+
+	reactor := &blockingReactor{received: make(chan PeerMessage, 10)}
+	reactor.BaseReactor = *NewBaseReactor("blockingReactor", reactor)
+	peerErrors := make(chan interface{}, 2)
+	onPeerError := func(p Peer, r interface{}) { peerErrors <- r }
+	listenerCfg := peerConfig{
+		chDescs:      []*conn.ChannelDescriptor{{ID: 0x01}},
+		onPeerError:  onPeerError,
+		reactorsByCh: map[byte]Reactor{0x01: reactor},
+		metrics:      NopMetrics(),
+	}
+	dialerCfg := peerConfig{
+		chDescs:      []*conn.ChannelDescriptor{{ID: 0x01}, {ID: 0x02}, {ID: 0x03}},
+		onPeerError:  onPeerError,
+		reactorsByCh: map[byte]Reactor{0x01: reactor, 0x02: reactor, 0x03: reactor},
+		metrics:      NopMetrics(),
+	}
+
+	acceptc := make(chan Peer)
+	go func() {
+		p, err := listener.Accept(listenerCfg)
+		if err != nil {
+			t.Error(err)
+		}
+		acceptc <- p
+	}()
+
+	out, err := dialer.Dial(*listenerAddr, dialerCfg)
+	require.NoError(t, err)
+	in := <-acceptc
+	require.NotNil(t, in)
+
+	require.NoError(t, out.Start())
+	defer out.Stop() // nolint: errcheck
+	require.NoError(t, in.Start())
+	defer in.Stop() // nolint: errcheck
+
+	// The stream of channel 0x02 is reset, and the peers keep talking on the
+	// channel they share.
+	require.True(t, out.Send(0x02, []byte("reset")))
+	require.False(t, out.Send(0x03, []byte("unknown")))
+	require.True(t, out.Send(0x01, []byte("shared")))
+	select {
+	case msg := <-reactor.received:
+		assert.Equal(t, 0x01, msg.Counter)
+		assert.Equal(t, []byte("shared"), msg.Bytes)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message on channel 0x01 was not received")
+	}
+
+	select {
+	case r := <-peerErrors:
+		t.Fatalf("peer stopped for error: %v", r)
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.True(t, in.IsRunning())
+	assert.True(t, out.IsRunning())
+}
+
+func TestQUICTransportDialRejectWrongID(t *testing.T) {
+	listener, _ := testSetupQUICTransport(t, []byte{testCh})
+	defer listener.Close()
+	dialer, _ := testSetupQUICTransport(t, []byte{testCh})
+	defer dialer.Close()
+
+	wrongAddr := NewNetAddress(PubKeyToID(ed25519.GenPrivKey().PubKey()), listener.listener.Addr())
+	_, err := dialer.Dial(*wrongAddr, peerConfig{})
+	require.Error(t, err)
+	rejected, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, rejected.IsAuthFailure())
+}
+
+func TestQUICTransportRejectBanned(t *testing.T) {
+	listener, listenerAddr := testSetupQUICTransport(t, []byte{testCh})
+	defer listener.Close()
+	dialer, _ := testSetupQUICTransport(t, []byte{testCh})
+	defer dialer.Close()
+
+	banList := NewBanList()
+	require.NoError(t, banList.Ban(string(dialer.nodeInfo.ID()), time.Time{}))
+	QUICTransportBanList(banList)(listener)
+
+	errc := make(chan error)
+	go func() {
+		_, err := listener.Accept(peerConfig{})
+		errc <- err
+	}()
+
+	// The dialer may or may not see the rejection, depending on timing.
+	_, _ = dialer.Dial(*listenerAddr, peerConfig{})
+
+	err := <-errc
+	require.Error(t, err)
+	rejected, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, rejected.IsBanned())
+}
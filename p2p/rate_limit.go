@@ -0,0 +1,135 @@
+package p2p
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// tokenBucket is a token bucket of a RateLimit: it holds up to burst tokens,
+// and gains rate tokens per second. Each message takes a token.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit conn.RateLimit, now time.Time) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = math.Ceil(limit.Rate)
+	}
+	return &tokenBucket{
+		rate:   limit.Rate,
+		burst:  burst,
+		tokens: burst,
+		last:   now,
+	}
+}
+
+// allow takes a token if there is one, and returns whether it did.
+func (tb *tokenBucket) allow(now time.Time) bool {
+	if elapsed := now.Sub(tb.last); elapsed > 0 {
+		tb.tokens = math.Min(tb.burst, tb.tokens+elapsed.Seconds()*tb.rate)
+		tb.last = now
+	}
+	if tb.tokens < 1 {
+		return false
+	}
+	tb.tokens--
+	return true
+}
+
+// recvRateLimiter limits the rate of the messages received from a peer, with
+// a token bucket for each rate limited channel. It is safe for concurrent use.
+type recvRateLimiter struct {
+	mtx     sync.Mutex
+	buckets map[byte]*tokenBucket
+	limited map[byte]bool // channels over their limit
+}
+
+// newRecvRateLimiter returns a limiter for the RecvRateLimit of the channels,
+// overridden by the given limits.
+func newRecvRateLimiter(
+	chDescs []*conn.ChannelDescriptor,
+	overrides map[byte]conn.RateLimit,
+) *recvRateLimiter {
+	now := time.Now()
+	rl := &recvRateLimiter{
+		buckets: make(map[byte]*tokenBucket),
+		limited: make(map[byte]bool),
+	}
+	for _, desc := range chDescs {
+		limit, ok := overrides[desc.ID]
+		if !ok {
+			limit = desc.RecvRateLimit
+		}
+		if limit.Rate > 0 {
+			rl.buckets[desc.ID] = newTokenBucket(limit, now)
+		}
+	}
+	return rl
+}
+
+// allow returns whether a message received on the channel is within its
+// limit. If it is not, report is true for the first message over the limit
+// since the channel was last within it, so a flood is reported once.
+func (rl *recvRateLimiter) allow(chID byte) (ok bool, report bool) {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	tb, limited := rl.buckets[chID]
+	if !limited {
+		return true, false
+	}
+	if tb.allow(time.Now()) {
+		rl.limited[chID] = false
+		return true, false
+	}
+	report = !rl.limited[chID]
+	rl.limited[chID] = true
+	return false, report
+}
+
+// ParseRecvRateLimits parses a comma-separated list of limits on the rate of
+// messages received from each peer on a channel, each of the form
+// "<channel>:<rate>" or "<channel>:<rate>:<burst>", where the channel ID is
+// decimal or hexadecimal, the rate is in messages per second and the burst in
+// messages (e.g. "0x30:100:200,0x22:500").
+func ParseRecvRateLimits(s string) (map[byte]conn.RateLimit, error) {
+	limits := make(map[byte]conn.RateLimit)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.Split(item, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid rate limit %q, expected <channel>:<rate>[:<burst>]", item)
+		}
+		chID, err := strconv.ParseUint(parts[0], 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit %q: bad channel ID: %v", item, err)
+		}
+		var limit conn.RateLimit
+		limit.Rate, err = strconv.ParseFloat(parts[1], 64)
+		if err != nil || limit.Rate < 0 || math.IsInf(limit.Rate, 0) || math.IsNaN(limit.Rate) {
+			return nil, fmt.Errorf("invalid rate limit %q: bad rate %q", item, parts[1])
+		}
+		if len(parts) == 3 {
+			limit.Burst, err = strconv.Atoi(parts[2])
+			if err != nil || limit.Burst <= 0 {
+				return nil, fmt.Errorf("invalid rate limit %q: bad burst %q", item, parts[2])
+			}
+		}
+		limits[byte(chID)] = limit
+	}
+	return limits, nil
+}
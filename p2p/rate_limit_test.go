@@ -0,0 +1,120 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	tb := newTokenBucket(conn.RateLimit{Rate: 2, Burst: 3}, now)
+
+	// The bucket starts full.
+	for i := 0; i < 3; i++ {
+		assert.True(t, tb.allow(now), "message %d", i)
+	}
+	assert.False(t, tb.allow(now))
+
+	// Half a second gives one token back.
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, tb.allow(now))
+	assert.False(t, tb.allow(now))
+
+	// It never holds more than the burst.
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, tb.allow(now), "message %d", i)
+	}
+	assert.False(t, tb.allow(now))
+
+	// Without a burst, it holds a second's worth of tokens.
+	tb = newTokenBucket(conn.RateLimit{Rate: 1.5}, now)
+	assert.True(t, tb.allow(now))
+	assert.True(t, tb.allow(now))
+	assert.False(t, tb.allow(now))
+}
+
+func TestRecvRateLimiter(t *testing.T) {
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: 0x01, RecvRateLimit: conn.RateLimit{Rate: 0.001, Burst: 2}},
+		{ID: 0x02, RecvRateLimit: conn.RateLimit{Rate: 1000}},
+		{ID: 0x03},
+	}
+	rl := newRecvRateLimiter(chDescs, map[byte]conn.RateLimit{
+		0x02: {},                      // disabled
+		0x03: {Rate: 0.001, Burst: 1}, // enabled
+		0x04: {Rate: 0.001, Burst: 1}, // unknown channel
+	})
+
+	for i := 0; i < 2; i++ {
+		ok, report := rl.allow(0x01)
+		assert.True(t, ok)
+		assert.False(t, report)
+	}
+	// The first message over the limit is reported, the rest are not.
+	ok, report := rl.allow(0x01)
+	assert.False(t, ok)
+	assert.True(t, report)
+	ok, report = rl.allow(0x01)
+	assert.False(t, ok)
+	assert.False(t, report)
+
+	for i := 0; i < 100; i++ {
+		ok, _ = rl.allow(0x02)
+		require.True(t, ok)
+		ok, _ = rl.allow(0x04)
+		require.True(t, ok)
+	}
+
+	ok, _ = rl.allow(0x03)
+	assert.True(t, ok)
+	ok, report = rl.allow(0x03)
+	assert.False(t, ok)
+	assert.True(t, report)
+
+	// A channel back within its limit is reported again.
+	rl.buckets[0x03].tokens = 1
+	ok, _ = rl.allow(0x03)
+	assert.True(t, ok)
+	ok, report = rl.allow(0x03)
+	assert.False(t, ok)
+	assert.True(t, report)
+}
+
+func TestParseRecvRateLimits(t *testing.T) {
+	testCases := []struct {
+		in      string
+		want    map[byte]conn.RateLimit
+		wantErr bool
+	}{
+		{"", map[byte]conn.RateLimit{}, false},
+		{"0x30:100:200, 34:0.5", map[byte]conn.RateLimit{
+			0x30: {Rate: 100, Burst: 200},
+			0x22: {Rate: 0.5},
+		}, false},
+		{"0x30:0", map[byte]conn.RateLimit{0x30: {}}, false},
+		{"0x30", nil, true},
+		{"0x30:1:2:3", nil, true},
+		{"0x130:1", nil, true},
+		{"foo:1", nil, true},
+		{"0x30:-1", nil, true},
+		{"0x30:NaN", nil, true},
+		{"0x30:1:0", nil, true},
+		{"0x30:1:x", nil, true},
+	}
+	for _, tc := range testCases {
+		limits, err := ParseRecvRateLimits(tc.in)
+		if tc.wantErr {
+			assert.Error(t, err, tc.in)
+			continue
+		}
+		if assert.NoError(t, err, tc.in) {
+			assert.Equal(t, tc.want, limits, tc.in)
+		}
+	}
+}
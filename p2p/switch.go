@@ -3,6 +3,7 @@ package p2p
 import (
 	"fmt"
 	"math"
+	"net"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/tendermint/tendermint/libs/rand"
 	"github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 const (
@@ -29,6 +31,10 @@ const (
 	// ie. 3**10 = 16hrs
 	reconnectBackOffAttempts    = 10
 	reconnectBackOffBaseSeconds = 3
+
+	// a new inbound peer must be this much more trusted than an existing one
+	// to replace it
+	evictionTrustMargin = 10
 )
 
 // MConnConfig returns an MConnConfig with fields updated
@@ -73,6 +79,7 @@ type Switch struct {
 	reactors     map[string]Reactor
 	chDescs      []*conn.ChannelDescriptor
 	reactorsByCh map[byte]Reactor
+	reactorNames map[byte]string // by channel, for metrics
 	peers        *PeerSet
 	dialing      *cmap.CMap
 	reconnecting *cmap.CMap
@@ -90,6 +97,11 @@ type Switch struct {
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
+	trustStore *trust.MetricStore // may be nil
+
+	banList           *BanList
+	behaviourPolicies map[string]BehaviourPolicy
+
 	metrics *Metrics
 }
 
@@ -113,6 +125,7 @@ func NewSwitch(
 		reactors:             make(map[string]Reactor),
 		chDescs:              make([]*conn.ChannelDescriptor, 0),
 		reactorsByCh:         make(map[byte]Reactor),
+		reactorNames:         make(map[byte]string),
 		peers:                NewPeerSet(),
 		dialing:              cmap.NewCMap(),
 		reconnecting:         cmap.NewCMap(),
@@ -121,6 +134,8 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
+		banList:              NewBanList(),
+		behaviourPolicies:    DefaultBehaviourPolicies(),
 	}
 
 	// Ensure we have a completely undeterministic PRNG.
@@ -145,6 +160,27 @@ func SwitchPeerFilters(filters ...PeerFilterFunc) SwitchOption {
 	return func(sw *Switch) { sw.peerFilters = filters }
 }
 
+// SwitchTrustMetricStore sets the store of peer trust metrics. The switch
+// records good and bad events for its peers in it, and prefers trusted peers
+// when it has to evict one. The store is started and stopped with the switch.
+func SwitchTrustMetricStore(store *trust.MetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = store }
+}
+
+// SwitchBanList sets the list of banned peers, which is shared with the
+// transport (see MultiplexTransportBanList). By default, the switch has its own
+// empty list.
+func SwitchBanList(banList *BanList) SwitchOption {
+	return func(sw *Switch) { sw.banList = banList }
+}
+
+// SwitchBehaviourPolicies sets the policies applied to peer behaviours reported
+// by reactors (see ReportPeerBehaviour). Kinds of behaviour without a policy
+// disconnect the peer.
+func SwitchBehaviourPolicies(policies map[string]BehaviourPolicy) SwitchOption {
+	return func(sw *Switch) { sw.behaviourPolicies = policies }
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
@@ -164,6 +200,7 @@ func (sw *Switch) AddReactor(name string, reactor Reactor) Reactor {
 		}
 		sw.chDescs = append(sw.chDescs, chDesc)
 		sw.reactorsByCh[chID] = reactor
+		sw.reactorNames[chID] = name
 	}
 	sw.reactors[name] = reactor
 	reactor.SetSwitch(sw)
@@ -182,6 +219,7 @@ func (sw *Switch) RemoveReactor(name string, reactor Reactor) {
 			}
 		}
 		delete(sw.reactorsByCh, chDesc.ID)
+		delete(sw.reactorNames, chDesc.ID)
 	}
 	delete(sw.reactors, name)
 	reactor.SetSwitch(nil)
@@ -222,6 +260,12 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Start(); err != nil {
+			return errors.Wrap(err, "failed to start trust metric store")
+		}
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -248,6 +292,11 @@ func (sw *Switch) OnStop() {
 	for _, reactor := range sw.reactors {
 		reactor.Stop()
 	}
+
+	// Save the trust history of all peers
+	if sw.trustStore != nil {
+		sw.trustStore.Stop()
+	}
 }
 
 //---------------------------------------------------------------------
@@ -319,9 +368,12 @@ func (sw *Switch) Peers() IPeerSet {
 
 // StopPeerForError disconnects from a peer due to external error.
 // If the peer is persistent, it will attempt to reconnect.
-// TODO: make record depending on reason.
+// The error lowers the peer's trust, unless its connection merely failed.
 func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	sw.Logger.Error("Stopping peer for error", "peer", peer, "err", reason)
+	if sw.trustStore != nil && !sw.isConnectionError(reason) {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+	}
 	sw.stopAndRemovePeer(peer, reason)
 
 	if peer.IsPersistent() {
@@ -363,6 +415,10 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 	if sw.peers.Remove(peer) {
 		sw.metrics.Peers.Add(float64(-1))
 	}
+
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
 }
 
 // reconnectToPeer tries to reconnect to the addr, first repeatedly
@@ -433,6 +489,91 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.ID())
 	}
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).GoodEvents(1)
+	}
+}
+
+// ReportPeerBehaviour applies the policy for the given kind of behaviour (see
+// the behaviour package) to the peer: it is marked as good, warned about,
+// disconnected or banned. The reason is logged, and passed to the reactors if
+// the peer is stopped.
+func (sw *Switch) ReportPeerBehaviour(peer Peer, kind string, reason interface{}) {
+	policy, ok := sw.behaviourPolicies[kind]
+	if !ok {
+		policy = defaultBehaviourPolicy
+	}
+	sw.metrics.PeerBehaviours.With("kind", kind, "action", string(policy.Action)).Add(1)
+
+	switch policy.Action {
+	case BehaviourActionMarkGood:
+		sw.MarkPeerAsGood(peer)
+	case BehaviourActionWarn:
+		sw.Logger.Info("Peer misbehaved", "peer", peer, "kind", kind, "reason", reason)
+		if sw.trustStore != nil {
+			sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+		}
+	case BehaviourActionBan:
+		sw.Logger.Error("Banning peer", "peer", peer, "kind", kind, "reason", reason,
+			"duration", policy.BanDuration)
+		if sw.trustStore != nil {
+			sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+		}
+		sw.BanPeer(peer.ID(), policy.BanDuration)
+	default:
+		sw.StopPeerForError(peer, reason)
+	}
+}
+
+// BanPeer bans the peer with the given ID for the given duration, and stops
+// it if it is connected. Banned peers are neither dialed nor accepted.
+func (sw *Switch) BanPeer(id ID, duration time.Duration) {
+	if err := sw.Ban(string(id), time.Now().Add(duration)); err != nil {
+		sw.Logger.Error("Failed to ban peer", "peer", id, "err", err)
+	}
+}
+
+// Ban bans the target, which is a node ID, an IP or a CIDR, until the given
+// time (forever if it is zero), and stops the connected peers it matches. The
+// ban applies even if the ban list could not be saved.
+func (sw *Switch) Ban(target string, until time.Time) error {
+	err := sw.banList.Ban(target, until)
+	for _, peer := range sw.peers.List() {
+		if sw.isPeerBanned(peer) {
+			sw.stopAndRemovePeer(peer, ErrSwitchPeerBanned{ID: peer.ID()})
+		}
+	}
+	return err
+}
+
+// isBanned returns true if the node ID or the IP is banned.
+func (sw *Switch) isBanned(id ID, ip net.IP) bool {
+	return sw.banList.IsBanned(id) || sw.banList.IsIPBanned(ip)
+}
+
+// isPeerBanned returns true if the ID of the peer, or the IP it is connected
+// from, is banned.
+func (sw *Switch) isPeerBanned(p Peer) bool {
+	var ip net.IP
+	if addr := p.SocketAddr(); addr != nil {
+		ip = addr.IP
+	}
+	return sw.isBanned(p.ID(), ip)
+}
+
+// BanList returns the list of banned peers.
+func (sw *Switch) BanList() *BanList {
+	return sw.banList
+}
+
+// PeerTrustScore returns the trust score of the peer with the given ID,
+// between 0 and 100. Peers we know nothing about have a neutral score, as do
+// all peers if the switch has no trust metric store.
+func (sw *Switch) PeerTrustScore(id ID) int {
+	if sw.trustStore == nil {
+		return trust.NeutralTrustScore
+	}
+	return sw.trustStore.PeerTrustScore(string(id))
 }
 
 //---------------------------------------------------------------------
@@ -512,7 +653,8 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 			err := sw.DialPeerWithAddress(addr)
 			if err != nil {
 				switch err.(type) {
-				case ErrSwitchConnectToSelf, ErrSwitchDuplicatePeerID, ErrCurrentlyDialingOrExistingAddress:
+				case ErrSwitchConnectToSelf, ErrSwitchDuplicatePeerID, ErrCurrentlyDialingOrExistingAddress,
+					ErrSwitchPeerBanned:
 					sw.Logger.Debug("Error dialing peer", "err", err)
 				default:
 					sw.Logger.Error("Error dialing peer", "err", err)
@@ -530,6 +672,9 @@ func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
+	if sw.isBanned(addr.ID, addr.IP) {
+		return ErrSwitchPeerBanned{ID: addr.ID}
+	}
 
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
@@ -600,11 +745,13 @@ func (sw *Switch) IsPeerPersistent(na *NetAddress) bool {
 func (sw *Switch) acceptRoutine() {
 	for {
 		p, err := sw.transport.Accept(peerConfig{
-			chDescs:      sw.chDescs,
-			onPeerError:  sw.StopPeerForError,
-			reactorsByCh: sw.reactorsByCh,
-			metrics:      sw.metrics,
-			isPersistent: sw.IsPeerPersistent,
+			chDescs:         sw.chDescs,
+			onPeerError:     sw.StopPeerForError,
+			reactorsByCh:    sw.reactorsByCh,
+			reactorNames:    sw.reactorNames,
+			metrics:         sw.metrics,
+			onPeerBehaviour: sw.ReportPeerBehaviour,
+			isPersistent:    sw.IsPeerPersistent,
 		})
 		if err != nil {
 			switch err := err.(type) {
@@ -654,9 +801,10 @@ func (sw *Switch) acceptRoutine() {
 		}
 
 		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) {
-			// Ignore connection if we already have enough peers.
+			// Ignore connection if we already have enough peers, unless it is
+			// more trusted than one of them.
 			_, in, _ := sw.NumPeers()
-			if in >= sw.config.MaxNumInboundPeers {
+			if in >= sw.config.MaxNumInboundPeers && !sw.evictInboundPeerFor(p) {
 				sw.Logger.Info(
 					"Ignoring inbound connection: already have enough inbound peers",
 					"address", p.SocketAddr(),
@@ -685,6 +833,48 @@ func (sw *Switch) acceptRoutine() {
 	}
 }
 
+// evictInboundPeerFor stops the least trusted inbound peer, if the new peer p
+// has a higher trust score by at least evictionTrustMargin. As new peers have
+// a neutral score, only peers which misbehaved can be evicted by them.
+// Unconditional and persistent peers are never evicted. It returns true if a
+// peer was evicted.
+func (sw *Switch) evictInboundPeerFor(p Peer) bool {
+	if sw.trustStore == nil {
+		return false
+	}
+
+	var (
+		victim      Peer
+		victimScore int
+	)
+	for _, peer := range sw.peers.List() {
+		if peer.IsOutbound() || peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+			continue
+		}
+		score := sw.PeerTrustScore(peer.ID())
+		if victim == nil || score < victimScore {
+			victim, victimScore = peer, score
+		}
+	}
+
+	score := sw.PeerTrustScore(p.ID())
+	if victim == nil || score < victimScore+evictionTrustMargin {
+		return false
+	}
+
+	sw.Logger.Info("Evicting inbound peer for a more trusted one",
+		"peer", victim, "score", victimScore, "newPeer", p.ID(), "newScore", score)
+	sw.stopAndRemovePeer(victim, ErrSwitchPeerEvicted{By: p.ID()})
+	return true
+}
+
+// isConnectionError returns true if reason is an error of the connection to a
+// peer (see Transport.IsConnectionError).
+func (sw *Switch) isConnectionError(reason interface{}) bool {
+	err, ok := reason.(error)
+	return ok && sw.transport.IsConnectionError(err)
+}
+
 // dial the peer; make secret connection; authenticate against the dialed ID;
 // add the peer.
 // if dialing fails, start the reconnect loop. If handshake fails, it's over.
@@ -703,11 +893,13 @@ func (sw *Switch) addOutboundPeerWithConfig(
 	}
 
 	p, err := sw.transport.Dial(*addr, peerConfig{
-		chDescs:      sw.chDescs,
-		onPeerError:  sw.StopPeerForError,
-		isPersistent: sw.IsPeerPersistent,
-		reactorsByCh: sw.reactorsByCh,
-		metrics:      sw.metrics,
+		chDescs:         sw.chDescs,
+		onPeerError:     sw.StopPeerForError,
+		isPersistent:    sw.IsPeerPersistent,
+		reactorsByCh:    sw.reactorsByCh,
+		reactorNames:    sw.reactorNames,
+		metrics:         sw.metrics,
+		onPeerBehaviour: sw.ReportPeerBehaviour,
 	})
 	if err != nil {
 		if e, ok := err.(ErrRejected); ok {
@@ -747,6 +939,10 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if sw.isPeerBanned(p) {
+		return ErrRejected{id: p.ID(), isBanned: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
@@ -809,6 +1005,11 @@ func (sw *Switch) addPeer(p Peer) error {
 	}
 	sw.metrics.Peers.Add(float64(1))
 
+	// Start tracking the peer's behaviour.
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(p.ID()))
+	}
+
 	// Start all the reactor protocols on the peer.
 	for _, reactor := range sw.reactors {
 		reactor.AddPeer(p)
@@ -24,6 +24,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/trust"
+	dbm "github.com/tendermint/tm-db"
 )
 
 var (
@@ -615,6 +617,203 @@ func TestSwitchAcceptRoutine(t *testing.T) {
 	}
 }
 
+func TestSwitchEvictsLeastTrustedInboundPeer(t *testing.T) {
+	p2pCfg := *cfg
+	p2pCfg.MaxNumInboundPeers = 2
+
+	store := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := MakeSwitch(&p2pCfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchTrustMetricStore(store))
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	dial := func() *remotePeer {
+		peer := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &p2pCfg}
+		peer.Start()
+		c, err := peer.Dial(sw.NetAddress())
+		require.NoError(t, err)
+		// spawn a reading routine to prevent connection from closing
+		go func(c net.Conn) {
+			for {
+				one := make([]byte, 1)
+				_, err := c.Read(one)
+				if err != nil {
+					return
+				}
+			}
+		}(c)
+		time.Sleep(50 * time.Millisecond)
+		return peer
+	}
+
+	badPeer, goodPeer := dial(), dial()
+	defer badPeer.Stop()
+	defer goodPeer.Stop()
+	require.Equal(t, 2, sw.Peers().Size())
+
+	store.GetPeerTrustMetric(string(badPeer.ID())).BadEvents(10)
+	sw.MarkPeerAsGood(sw.Peers().Get(goodPeer.ID()))
+	assert.True(t, sw.PeerTrustScore(badPeer.ID()) < trust.MaxTrustScore)
+	assert.Equal(t, trust.MaxTrustScore, sw.PeerTrustScore(goodPeer.ID()))
+
+	// a new peer replaces the least trusted one
+	newPeer := dial()
+	defer newPeer.Stop()
+	assert.Equal(t, 2, sw.Peers().Size())
+	assert.False(t, sw.Peers().Has(badPeer.ID()))
+	assert.True(t, sw.Peers().Has(goodPeer.ID()))
+	assert.True(t, sw.Peers().Has(newPeer.ID()))
+
+	// but not a peer as trusted as the remaining ones
+	otherPeer := dial()
+	defer otherPeer.Stop()
+	assert.Equal(t, 2, sw.Peers().Size())
+	assert.False(t, sw.Peers().Has(otherPeer.ID()))
+
+	// errors are bad events, but not failed connections
+	sw.StopPeerForError(sw.Peers().Get(newPeer.ID()), fmt.Errorf("read: %w", io.EOF))
+	assert.Equal(t, trust.MaxTrustScore, sw.PeerTrustScore(newPeer.ID()))
+	sw.StopPeerForError(sw.Peers().Get(goodPeer.ID()), "test")
+	assert.True(t, sw.PeerTrustScore(goodPeer.ID()) < trust.MaxTrustScore)
+}
+
+func TestSwitchDoesNotEvictForUnknownPeers(t *testing.T) {
+	p2pCfg := *cfg
+	p2pCfg.MaxNumInboundPeers = 1
+
+	store := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := MakeSwitch(&p2pCfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchTrustMetricStore(store))
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	peer := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &p2pCfg}
+	peer.Start()
+	defer peer.Stop()
+	_, err = peer.Dial(sw.NetAddress())
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, 1, sw.Peers().Size())
+
+	// a peer which misbehaved a little is not replaced by one we know
+	// nothing about
+	store.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+	store.GetPeerTrustMetric(string(peer.ID())).GoodEvents(3)
+	require.True(t, sw.PeerTrustScore(peer.ID()) < trust.MaxTrustScore)
+	unknownPeer := newMockPeer(net.IP{127, 0, 0, 2})
+	assert.Equal(t, trust.NeutralTrustScore, sw.PeerTrustScore(unknownPeer.ID()))
+	assert.False(t, sw.evictInboundPeerFor(unknownPeer))
+	assert.True(t, sw.Peers().Has(peer.ID()))
+}
+
+func TestSwitchPeerTrustScoreWithoutStore(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	peer := newMockPeer(net.IP{127, 0, 0, 2})
+	assert.Equal(t, trust.NeutralTrustScore, sw.PeerTrustScore(peer.ID()))
+}
+
+func TestSwitchReportPeerBehaviour(t *testing.T) {
+	policies, err := ParseBehaviourPolicies("message_out_of_order:warn,bad_message:ban:1h")
+	require.NoError(t, err)
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchBehaviourPolicies(policies))
+	err = sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	dial := func() {
+		c, err := rp.Dial(sw.NetAddress())
+		require.NoError(t, err)
+		// spawn a reading routine to prevent connection from closing
+		go func(c net.Conn) {
+			for {
+				one := make([]byte, 1)
+				_, err := c.Read(one)
+				if err != nil {
+					return
+				}
+			}
+		}(c)
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	dial()
+	require.Equal(t, 1, sw.Peers().Size())
+	peer := sw.Peers().Get(rp.ID())
+
+	// warnings and good behaviour keep the peer connected
+	sw.ReportPeerBehaviour(peer, BehaviourMessageOutOfOrder, "test")
+	sw.ReportPeerBehaviour(peer, BehaviourConsensusVote, "test")
+	assert.True(t, sw.Peers().Has(rp.ID()))
+
+	// bad messages get the peer banned
+	sw.ReportPeerBehaviour(peer, BehaviourBadMessage, "test")
+	assert.False(t, sw.Peers().Has(rp.ID()))
+	assert.True(t, sw.BanList().IsBanned(rp.ID()))
+
+	// and it can't connect again
+	err = sw.DialPeerWithAddress(rp.Addr())
+	assert.Equal(t, ErrSwitchPeerBanned{ID: rp.ID()}, err)
+	dial()
+	assert.Equal(t, 0, sw.Peers().Size())
+
+	// until it's unbanned
+	require.NoError(t, sw.BanList().Unban(string(rp.ID())))
+	dial()
+	assert.Equal(t, 1, sw.Peers().Size())
+
+	// banning its IP stops it too
+	require.NoError(t, sw.Ban(rp.Addr().IP.String(), time.Time{}))
+	assert.Equal(t, 0, sw.Peers().Size())
+	dial()
+	assert.Equal(t, 0, sw.Peers().Size())
+}
+
+func TestSwitchRecvRateLimit(t *testing.T) {
+	policies, err := ParseBehaviourPolicies("rate_limited:disconnect")
+	require.NoError(t, err)
+	initSwitch := func(i int, sw *Switch) *Switch {
+		sw.AddReactor("foo", NewTestReactor([]*conn.ChannelDescriptor{
+			{ID: byte(0x00), Priority: 10, RecvRateLimit: conn.RateLimit{Rate: 0.001, Burst: 5}},
+			{ID: byte(0x01), Priority: 10},
+		}, true))
+		return sw
+	}
+	switches := []*Switch{
+		MakeSwitch(cfg, 0, TestHost, "123.123.123", initSwitch, SwitchBehaviourPolicies(policies)),
+		MakeSwitch(cfg, 1, TestHost, "123.123.123", initSwitch),
+	}
+	require.NoError(t, StartSwitches(switches))
+	defer switches[0].Stop()
+	defer switches[1].Stop()
+	Connect2Switches(switches, 0, 1)
+
+	s1, s2 := switches[0], switches[1]
+	peer := s2.Peers().Get(s1.NodeInfo().ID())
+	require.NotNil(t, peer)
+	reactor := s1.Reactor("foo").(*TestReactor)
+
+	// channels without a limit are not limited
+	for i := 0; i < 10; i++ {
+		require.True(t, peer.Send(0x01, []byte("test")))
+	}
+	assertMsgReceivedWithTimeout(t, []byte("test"), 0x01, reactor, 10*time.Millisecond, 5*time.Second)
+	assert.Equal(t, 1, s1.Peers().Size())
+
+	// flooding a limited channel drops the messages over the limit and gets
+	// the peer disconnected
+	for i := 0; i < 10; i++ {
+		peer.Send(0x00, []byte("test"))
+	}
+	assert.Eventually(t, func() bool {
+		return s1.Peers().Size() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, reactor.getMsgs(0x00), 5)
+}
+
 type errorTransport struct {
 	acceptErr error
 }
@@ -632,6 +831,9 @@ func (errorTransport) Dial(NetAddress, peerConfig) (Peer, error) {
 func (errorTransport) Cleanup(Peer) {
 	panic("not implemented")
 }
+func (errorTransport) IsConnectionError(error) bool {
+	panic("not implemented")
+}
 
 func TestSwitchAcceptRoutineErrorCases(t *testing.T) {
 	sw := NewSwitch(cfg, errorTransport{ErrFilterTimeout{}})
@@ -153,6 +153,7 @@ func (sw *Switch) addPeerWithConnection(conn net.Conn) error {
 		sw.reactorsByCh,
 		sw.chDescs,
 		sw.StopPeerForError,
+		peerOnBehaviour(sw.ReportPeerBehaviour),
 	)
 
 	if err = sw.addPeer(p); err != nil {
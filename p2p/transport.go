@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"time"
 
@@ -47,7 +48,11 @@ type peerConfig struct {
 	// if the peer is persistent or not.
 	isPersistent func(*NetAddress) bool
 	reactorsByCh map[byte]Reactor
+	reactorNames map[byte]string // by channel, for metrics
 	metrics      *Metrics
+	// onPeerBehaviour reports the behaviour of the peer (see
+	// Switch.ReportPeerBehaviour).
+	onPeerBehaviour func(Peer, string, interface{})
 }
 
 // Transport emits and connects to Peers. The implementation of Peer is left to
@@ -65,6 +70,11 @@ type Transport interface {
 
 	// Cleanup any resources associated with Peer.
 	Cleanup(Peer)
+
+	// IsConnectionError returns true if err is an error of the connection to
+	// a peer, e.g. because it was closed or timed out, rather than an error in
+	// what the peer sent.
+	IsConnectionError(err error) bool
 }
 
 // transportLifecycle bundles the methods for callers to control start and stop
@@ -122,6 +132,12 @@ func MultiplexTransportResolver(resolver IPResolver) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.resolver = resolver }
 }
 
+// MultiplexTransportBanList sets the list of banned peers, whose connections
+// are rejected.
+func MultiplexTransportBanList(banList *BanList) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.banList = banList }
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
 // multiplexed peers.
 type MultiplexTransport struct {
@@ -134,6 +150,7 @@ type MultiplexTransport struct {
 	// Lookup table for duplicate ip and id checks.
 	conns       ConnSet
 	connFilters []ConnFilterFunc
+	banList     *BanList // may be nil
 
 	dialTimeout      time.Duration
 	filterTimeout    time.Duration
@@ -324,6 +341,15 @@ func (mt *MultiplexTransport) Cleanup(p Peer) {
 	_ = p.CloseConn()
 }
 
+// IsConnectionError implements Transport.
+func (mt *MultiplexTransport) IsConnectionError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, conn.ErrPongTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
 func (mt *MultiplexTransport) cleanup(c net.Conn) error {
 	mt.conns.Remove(c)
 
@@ -348,6 +374,14 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 		return err
 	}
 
+	if mt.banList != nil {
+		for _, ip := range ips {
+			if mt.banList.IsIPBanned(ip) {
+				return ErrRejected{conn: c, isBanned: true}
+			}
+		}
+	}
+
 	errc := make(chan error, len(mt.connFilters))
 
 	for _, f := range mt.connFilters {
@@ -392,11 +426,47 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	// For outgoing conns, ensure connection key matches dialed key.
 	connID := PubKeyToID(secretConn.RemotePubKey())
+	nodeInfo, err = handshakePeer(
+		secretConn,
+		connID,
+		dialedAddr,
+		mt.nodeInfo,
+		mt.banList,
+		mt.handshakeTimeout,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+// handshakePeer exchanges NodeInfo over the connection c, whose remote end has
+// been authenticated as connID, and checks that the peer is not banned, is the
+// one we dialed (if any), reports its own ID, is not us and is compatible with
+// us. The transport is responsible for the authentication of connID.
+func handshakePeer(
+	c net.Conn,
+	connID ID,
+	dialedAddr *NetAddress,
+	ourNodeInfo NodeInfo,
+	banList *BanList,
+	timeout time.Duration,
+) (NodeInfo, error) {
+	// Reject banned peers as soon as we know who they are.
+	if banList != nil && banList.IsBanned(connID) {
+		return nil, ErrRejected{
+			conn:     c,
+			id:       connID,
+			isBanned: true,
+		}
+	}
+
+	// For outgoing conns, ensure connection key matches dialed key.
 	if dialedAddr != nil {
 		if dialedID := dialedAddr.ID; connID != dialedID {
-			return nil, nil, ErrRejected{
+			return nil, ErrRejected{
 				conn: c,
 				id:   connID,
 				err: fmt.Errorf(
@@ -409,9 +479,9 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	nodeInfo, err := handshake(c, timeout, ourNodeInfo)
 	if err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:          c,
 			err:           fmt.Errorf("handshake failed: %v", err),
 			isAuthFailure: true,
@@ -419,7 +489,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -428,7 +498,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Ensure connection key matches self reported key.
 	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -441,8 +511,8 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+	if ourNodeInfo.ID() == nodeInfo.ID() {
+		return nil, ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -450,8 +520,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+	if err := ourNodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return nil, ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -459,7 +529,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return nodeInfo, nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -496,6 +566,8 @@ func (mt *MultiplexTransport) wrapPeer(
 		cfg.chDescs,
 		cfg.onPeerError,
 		PeerMetrics(cfg.metrics),
+		peerReactorNames(cfg.reactorNames),
+		peerOnBehaviour(cfg.onPeerBehaviour),
 	)
 
 	return p
@@ -440,6 +440,72 @@ func TestTransportMultiplexRejectIncompatible(t *testing.T) {
 	}
 }
 
+func TestTransportMultiplexRejectBanned(t *testing.T) {
+	mt := testSetupMultiplexTransport(t)
+
+	pv := ed25519.GenPrivKey()
+	banList := NewBanList()
+	err := banList.Ban(string(PubKeyToID(pv.PubKey())), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	MultiplexTransportBanList(banList)(mt)
+
+	go func() {
+		dialer := newMultiplexTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), "dialer"),
+			NodeKey{
+				PrivKey: pv,
+			},
+		)
+		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+
+		_, _ = dialer.Dial(*addr, peerConfig{})
+	}()
+
+	_, err = mt.Accept(peerConfig{})
+	if err, ok := err.(ErrRejected); ok {
+		if !err.IsBanned() {
+			t.Errorf("expected to reject banned peer, got: %v", err)
+		}
+	} else {
+		t.Errorf("expected ErrRejected")
+	}
+}
+
+func TestTransportMultiplexRejectBannedIP(t *testing.T) {
+	mt := testSetupMultiplexTransport(t)
+
+	banList := NewBanList()
+	err := banList.Ban("127.0.0.0/8", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	MultiplexTransportBanList(banList)(mt)
+
+	go func() {
+		pv := ed25519.GenPrivKey()
+		dialer := newMultiplexTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), "dialer"),
+			NodeKey{
+				PrivKey: pv,
+			},
+		)
+		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+
+		_, _ = dialer.Dial(*addr, peerConfig{})
+	}()
+
+	_, err = mt.Accept(peerConfig{})
+	if err, ok := err.(ErrRejected); ok {
+		if !err.IsBanned() {
+			t.Errorf("expected to reject banned IP, got: %v", err)
+		}
+	} else {
+		t.Errorf("expected ErrRejected")
+	}
+}
+
 func TestTransportMultiplexRejectSelf(t *testing.T) {
 	mt := testSetupMultiplexTransport(t)
 
@@ -22,6 +22,12 @@ const (
 
 	// The weight applied to history data values when calculating the history value
 	defaultHistoryDataWeight = 0.8
+
+	// MaxTrustScore is the score of a peer with a perfect record
+	MaxTrustScore = 100
+
+	// NeutralTrustScore is the score of a peer we know nothing about
+	NeutralTrustScore = MaxTrustScore / 2
 )
 
 // MetricHistoryJSON - history data necessary to save the trust metric
@@ -200,7 +206,7 @@ func (tm *Metric) TrustValue() float64 {
 
 // TrustScore gets a score based on the trust value always between 0 and 100
 func (tm *Metric) TrustScore() int {
-	score := tm.TrustValue() * 100
+	score := tm.TrustValue() * MaxTrustScore
 
 	return int(math.Floor(score))
 }
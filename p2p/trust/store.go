@@ -114,6 +114,21 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 	return tm
 }
 
+// PeerTrustScore returns the trust score of the peer identified by the key,
+// without creating a trust metric for it. Peers without a trust metric have a
+// neutral score: unlike a new metric, which starts with a perfect record, they
+// have not earned it yet.
+func (tms *MetricStore) PeerTrustScore(key string) int {
+	tms.mtx.Lock()
+	tm, ok := tms.peerMetrics[key]
+	tms.mtx.Unlock()
+
+	if !ok {
+		return NeutralTrustScore
+	}
+	return tm.TrustScore()
+}
+
 // PeerDisconnected pauses the trust metric associated with the peer identified by the key
 func (tms *MetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
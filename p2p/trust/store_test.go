@@ -148,5 +148,10 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	// We will remember our experiences with this peer
 	tm = store.GetPeerTrustMetric(key)
 	assert.NotEqual(t, 100, tm.TrustScore())
+	assert.Equal(t, tm.TrustScore(), store.PeerTrustScore(key))
+
+	// Unknown peers have a neutral score, and no metric is created for them
+	assert.Equal(t, NeutralTrustScore, store.PeerTrustScore("UnknownKey"))
+	assert.Equal(t, 1, store.Size())
 	store.Stop()
 }
@@ -19,5 +19,15 @@ SignerDialerEndpoint
 
 SignerDialerEndpoint is a simple wrapper around a net.Conn. It's used by both IPCVal and TCPVal.
 
+GuardedPV
+
+GuardedPV wraps any PrivValidator, including remote ones, and refuses to sign
+conflicting votes and proposals, keeping its own state file like FilePV.
+
+gRPC
+
+The privval/grpc package provides a PrivValidator which dials a remote signer
+over gRPC, authenticated with mutual TLS, instead of listening for it.
+
 */
 package privval
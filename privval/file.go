@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/armor"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -24,6 +25,9 @@ const (
 	stepPrecommit int8 = 3
 )
 
+// FilePVKeyArmorType is the block type of an encrypted FilePVKey file.
+const FilePVKeyArmorType = "TENDERMINT PRIVATE VALIDATOR KEY"
+
 // A vote is either stepPrevote or stepPrecommit.
 func voteToStep(vote *types.Vote) int8 {
 	switch vote.Type {
@@ -44,10 +48,56 @@ type FilePVKey struct {
 	PubKey  crypto.PubKey  `json:"pub_key"`
 	PrivKey crypto.PrivKey `json:"priv_key"`
 
-	filePath string
+	filePath   string
+	passphrase string
+}
+
+// LoadFilePVKey loads a FilePVKey from keyFilePath, decrypting it with
+// passphrase if the file is encrypted. The key is saved encrypted with the
+// same passphrase if it was encrypted, and as plaintext otherwise.
+func LoadFilePVKey(keyFilePath, passphrase string) (FilePVKey, error) {
+	keyBytes, err := ioutil.ReadFile(keyFilePath)
+	if err != nil {
+		return FilePVKey{}, err
+	}
+
+	pvKey := FilePVKey{}
+	if armor.IsArmored(keyBytes) {
+		if passphrase == "" {
+			return FilePVKey{}, fmt.Errorf("PrivValidator key %v is encrypted, but no passphrase was given", keyFilePath)
+		}
+		blockType, jsonBytes, err := armor.UnarmorDecrypt(string(keyBytes), passphrase)
+		if err != nil {
+			return FilePVKey{}, fmt.Errorf("error decrypting PrivValidator key from %v: %v", keyFilePath, err)
+		}
+		if blockType != FilePVKeyArmorType {
+			return FilePVKey{}, fmt.Errorf("unexpected block type %q in %v", blockType, keyFilePath)
+		}
+		keyBytes = jsonBytes
+		pvKey.passphrase = passphrase
+	}
+
+	err = cdc.UnmarshalJSON(keyBytes, &pvKey)
+	if err != nil {
+		return FilePVKey{}, fmt.Errorf("error reading PrivValidator key from %v: %v", keyFilePath, err)
+	}
+
+	// overwrite pubkey and address for convenience
+	pvKey.PubKey = pvKey.PrivKey.PubKey()
+	pvKey.Address = pvKey.PubKey.Address()
+	pvKey.filePath = keyFilePath
+
+	return pvKey, nil
+}
+
+// SetPassphrase sets the passphrase the FilePVKey is encrypted with when
+// saved. If empty, the key is saved as plaintext JSON.
+func (pvKey *FilePVKey) SetPassphrase(passphrase string) {
+	pvKey.passphrase = passphrase
 }
 
-// Save persists the FilePVKey to its filePath.
+// Save persists the FilePVKey to its filePath, encrypted if it has a
+// passphrase.
 func (pvKey FilePVKey) Save() {
 	outFile := pvKey.filePath
 	if outFile == "" {
@@ -58,6 +108,9 @@ func (pvKey FilePVKey) Save() {
 	if err != nil {
 		panic(err)
 	}
+	if pvKey.passphrase != "" {
+		jsonBytes = []byte(armor.EncryptArmor(FilePVKeyArmorType, jsonBytes, pvKey.passphrase))
+	}
 	err = tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
 	if err != nil {
 		panic(err)
@@ -170,31 +223,33 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 // signing prevention by persisting data to the stateFilePath.  If either file path
 // does not exist, the program will exit.
 func LoadFilePV(keyFilePath, stateFilePath string) *FilePV {
-	return loadFilePV(keyFilePath, stateFilePath, true)
+	return loadFilePV(keyFilePath, stateFilePath, "", true)
+}
+
+// LoadFilePVWithPassphrase is like LoadFilePV, but decrypts the key file with
+// passphrase if it is encrypted.
+func LoadFilePVWithPassphrase(keyFilePath, stateFilePath, passphrase string) *FilePV {
+	return loadFilePV(keyFilePath, stateFilePath, passphrase, true)
 }
 
 // LoadFilePVEmptyState loads a FilePV from the given keyFilePath, with an empty LastSignState.
 // If the keyFilePath does not exist, the program will exit.
 func LoadFilePVEmptyState(keyFilePath, stateFilePath string) *FilePV {
-	return loadFilePV(keyFilePath, stateFilePath, false)
+	return loadFilePV(keyFilePath, stateFilePath, "", false)
+}
+
+// LoadFilePVEmptyStateWithPassphrase is like LoadFilePVEmptyState, but
+// decrypts the key file with passphrase if it is encrypted.
+func LoadFilePVEmptyStateWithPassphrase(keyFilePath, stateFilePath, passphrase string) *FilePV {
+	return loadFilePV(keyFilePath, stateFilePath, passphrase, false)
 }
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
-func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
-	keyJSONBytes, err := ioutil.ReadFile(keyFilePath)
+func loadFilePV(keyFilePath, stateFilePath, passphrase string, loadState bool) *FilePV {
+	pvKey, err := LoadFilePVKey(keyFilePath, passphrase)
 	if err != nil {
 		tmos.Exit(err.Error())
 	}
-	pvKey := FilePVKey{}
-	err = cdc.UnmarshalJSON(keyJSONBytes, &pvKey)
-	if err != nil {
-		tmos.Exit(fmt.Sprintf("Error reading PrivValidator key from %v: %v\n", keyFilePath, err))
-	}
-
-	// overwrite pubkey and address for convenience
-	pvKey.PubKey = pvKey.PrivKey.PubKey()
-	pvKey.Address = pvKey.PubKey.Address()
-	pvKey.filePath = keyFilePath
 
 	pvState := FilePVLastSignState{}
 	if loadState {
@@ -219,11 +274,19 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
 // LoadOrGenFilePV loads a FilePV from the given filePaths
 // or else generates a new one and saves it to the filePaths.
 func LoadOrGenFilePV(keyFilePath, stateFilePath string) *FilePV {
+	return LoadOrGenFilePVWithPassphrase(keyFilePath, stateFilePath, "")
+}
+
+// LoadOrGenFilePVWithPassphrase is like LoadOrGenFilePV, but decrypts the key
+// file with passphrase if it is encrypted, and encrypts a generated key with
+// it if not empty.
+func LoadOrGenFilePVWithPassphrase(keyFilePath, stateFilePath, passphrase string) *FilePV {
 	var pv *FilePV
 	if tmos.FileExists(keyFilePath) {
-		pv = LoadFilePV(keyFilePath, stateFilePath)
+		pv = LoadFilePVWithPassphrase(keyFilePath, stateFilePath, passphrase)
 	} else {
 		pv = GenFilePV(keyFilePath, stateFilePath)
+		pv.Key.SetPassphrase(passphrase)
 		pv.Save()
 	}
 	return pv
@@ -308,13 +371,16 @@ func (pv *FilePV) signVote(chainID string, vote *types.Vote) error {
 	// We might crash before writing to the wal,
 	// causing us to try to re-sign for the same HRS.
 	// If signbytes are the same, use the last signature.
-	// If they only differ by timestamp, use last timestamp and signature
+	// If they only differ by timestamp or extension (the app may extend the
+	// vote differently when asked again), use the last ones and signature.
 	// Otherwise, return error
 	if sameHRS {
 		if bytes.Equal(signBytes, lss.SignBytes) {
 			vote.Signature = lss.Signature
-		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
+		} else if timestamp, ext, ok := checkVotesOnlyDifferByTimestampOrExtension(
+			lss.SignBytes, signBytes); ok {
 			vote.Timestamp = timestamp
+			vote.Extension = ext
 			vote.Signature = lss.Signature
 		} else {
 			err = fmt.Errorf("conflicting data")
@@ -388,9 +454,9 @@ func (pv *FilePV) saveSigned(height int64, round int, step int8,
 
 //-----------------------------------------------------------------------------------------
 
-// returns the timestamp from the lastSignBytes.
-// returns true if the only difference in the votes is their timestamp.
-func checkVotesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
+// returns the timestamp and extension of the lastSignBytes, and whether they
+// only differ from newSignBytes by them.
+func checkVotesOnlyDifferByTimestampOrExtension(lastSignBytes, newSignBytes []byte) (time.Time, []byte, bool) {
 	var lastVote, newVote types.CanonicalVote
 	if err := cdc.UnmarshalBinaryLengthPrefixed(lastSignBytes, &lastVote); err != nil {
 		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into vote: %v", err))
@@ -399,16 +465,16 @@ func checkVotesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.T
 		panic(fmt.Sprintf("signBytes cannot be unmarshalled into vote: %v", err))
 	}
 
-	lastTime := lastVote.Timestamp
+	lastTime, lastExt := lastVote.Timestamp, lastVote.Extension
 
-	// set the times to the same value and check equality
+	// set the times and extensions to the same value and check equality
 	now := tmtime.Now()
-	lastVote.Timestamp = now
-	newVote.Timestamp = now
+	lastVote.Timestamp, lastVote.Extension = now, nil
+	newVote.Timestamp, newVote.Extension = now, nil
 	lastVoteBytes, _ := cdc.MarshalJSON(lastVote)
 	newVoteBytes, _ := cdc.MarshalJSON(newVote)
 
-	return lastTime, bytes.Equal(newVoteBytes, lastVoteBytes)
+	return lastTime, lastExt, bytes.Equal(newVoteBytes, lastVoteBytes)
 }
 
 // returns the timestamp from the lastSignBytes.
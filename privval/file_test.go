@@ -35,6 +35,36 @@ func TestGenLoadValidator(t *testing.T) {
 	assert.Equal(height, privVal.LastSignState.Height, "expected privval.LastHeight to have been saved")
 }
 
+func TestEncryptedKeyFile(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	privVal.Key.SetPassphrase("passphrase")
+	privVal.Save()
+
+	_, err = LoadFilePVKey(tempKeyFile.Name(), "")
+	assert.Error(t, err)
+	_, err = LoadFilePVKey(tempKeyFile.Name(), "wrong passphrase")
+	assert.Error(t, err)
+
+	privVal2 := LoadFilePVWithPassphrase(tempKeyFile.Name(), tempStateFile.Name(), "passphrase")
+	assert.Equal(t, privVal.Key, privVal2.Key)
+
+	// the key stays encrypted when saved again
+	privVal2.Reset()
+	pvKey, err := LoadFilePVKey(tempKeyFile.Name(), "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, privVal.Key.PrivKey, pvKey.PrivKey)
+
+	// decrypt it
+	pvKey.SetPassphrase("")
+	pvKey.Save()
+	assert.Equal(t, privVal.Key.PrivKey, LoadFilePV(tempKeyFile.Name(), tempStateFile.Name()).Key.PrivKey)
+}
+
 func TestResetValidator(t *testing.T) {
 	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
 	require.Nil(t, err)
@@ -300,6 +330,43 @@ func TestDifferByTimestamp(t *testing.T) {
 	}
 }
 
+func TestDifferByExtension(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{Total: 5, Hash: []byte{1, 2, 3}}}
+	block2 := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{Total: 5, Hash: []byte{3, 2, 1}}}
+	height, round := int64(10), 1
+	chainID := "mychainid"
+	voteType := byte(types.PrecommitType)
+
+	vote := newVote(privVal.Key.Address, 0, height, round, voteType, block1)
+	vote.Extension = []byte("price=1")
+	require.NoError(t, privVal.SignVote(chainID, vote))
+	signBytes := vote.SignBytes(chainID)
+	sig := vote.Signature
+	timeStamp := vote.Timestamp
+
+	// re-signing with another extension and timestamp gets the first ones back
+	vote.Extension = []byte("price=2")
+	vote.Timestamp = vote.Timestamp.Add(time.Millisecond)
+	vote.Signature = nil
+	require.NoError(t, privVal.SignVote(chainID, vote))
+	assert.Equal(t, []byte("price=1"), vote.Extension)
+	assert.Equal(t, timeStamp, vote.Timestamp)
+	assert.Equal(t, signBytes, vote.SignBytes(chainID))
+	assert.Equal(t, sig, vote.Signature)
+
+	// but a different block is still a conflict
+	vote = newVote(privVal.Key.Address, 0, height, round, voteType, block2)
+	vote.Extension = []byte("price=1")
+	assert.Error(t, privVal.SignVote(chainID, vote))
+}
+
 func newVote(addr types.Address, idx int, height int64, round int, typ byte, blockID types.BlockID) *types.Vote {
 	return &types.Vote{
 		ValidatorAddress: addr,
@@ -0,0 +1,166 @@
+package privvalgrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+const defaultTimeout = 3 * time.Second
+
+// SignerClient implements PrivValidator by calling a remote signer over gRPC.
+type SignerClient struct {
+	logger  log.Logger
+	conn    *grpc.ClientConn
+	client  PrivValidatorAPIClient
+	chainID string
+	timeout time.Duration
+}
+
+var _ types.PrivValidator = (*SignerClient)(nil)
+
+// DialRemoteSigner connects to the remote signer at addr (host:port), using
+// tlsConfig to authenticate both sides (see ClientTLSConfig). chainID is sent
+// along with public key requests. It does not wait for the connection to be
+// established; calls wait for up to 3 seconds for the signer to become
+// reachable.
+func DialRemoteSigner(addr string, tlsConfig *tls.Config, chainID string, logger log.Logger) (*SignerClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial remote signer")
+	}
+
+	return &SignerClient{
+		logger:  logger,
+		conn:    conn,
+		client:  NewPrivValidatorAPIClient(conn),
+		chainID: chainID,
+		timeout: defaultTimeout,
+	}, nil
+}
+
+// Close closes the underlying connection
+func (sc *SignerClient) Close() error {
+	return sc.conn.Close()
+}
+
+// IsConnected indicates whether the client is connected to the remote signer
+func (sc *SignerClient) IsConnected() bool {
+	return sc.conn.GetState() == connectivity.Ready
+}
+
+// WaitForConnection waits maxWait for a connection or returns
+// privval.ErrConnectionTimeout.
+func (sc *SignerClient) WaitForConnection(maxWait time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), maxWait)
+	defer cancel()
+
+	for {
+		state := sc.conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Shutdown:
+			return privval.ErrNoConnection
+		}
+		if !sc.conn.WaitForStateChange(ctx, state) {
+			return privval.ErrConnectionTimeout
+		}
+	}
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey retrieves a public key from the remote signer
+func (sc *SignerClient) GetPubKey() crypto.PubKey {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.GetPubKey(ctx, &RequestPubKey{ChainId: sc.chainID}, grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::GetPubKey", "err", toRemoteSignerError(err))
+		return nil
+	}
+
+	var pubKey crypto.PubKey
+	if err := cdc.UnmarshalBinaryBare(resp.PubKey, &pubKey); err != nil {
+		sc.logger.Error("SignerClient::GetPubKey", "err", err)
+		return nil
+	}
+
+	return pubKey
+}
+
+// SignVote requests the remote signer to sign a vote
+func (sc *SignerClient) SignVote(chainID string, vote *types.Vote) error {
+	bz, err := cdc.MarshalBinaryBare(vote)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignVote(ctx, &RequestSignVote{ChainId: chainID, Vote: bz}, grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::SignVote", "err", err)
+		return toRemoteSignerError(err)
+	}
+
+	signed := new(types.Vote)
+	if err := cdc.UnmarshalBinaryBare(resp.Vote, signed); err != nil {
+		return errors.Wrap(err, "failed to decode signed vote")
+	}
+	*vote = *signed
+
+	return nil
+}
+
+// SignProposal requests the remote signer to sign a proposal
+func (sc *SignerClient) SignProposal(chainID string, proposal *types.Proposal) error {
+	bz, err := cdc.MarshalBinaryBare(proposal)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignProposal(ctx, &RequestSignProposal{ChainId: chainID, Proposal: bz},
+		grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::SignProposal", "err", err)
+		return toRemoteSignerError(err)
+	}
+
+	signed := new(types.Proposal)
+	if err := cdc.UnmarshalBinaryBare(resp.Proposal, signed); err != nil {
+		return errors.Wrap(err, "failed to decode signed proposal")
+	}
+	*proposal = *signed
+
+	return nil
+}
+
+// toRemoteSignerError converts a gRPC status error into the
+// privval.RemoteSignerError returned by socket signers, with the status code
+// as the error code.
+func toRemoteSignerError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return &privval.RemoteSignerError{Code: int(st.Code()), Description: st.Message()}
+}
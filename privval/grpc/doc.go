@@ -0,0 +1,12 @@
+/*
+Package privvalgrpc implements a remote signer protocol over gRPC.
+
+SignerClient is a types.PrivValidator which dials a remote signing service,
+such as an HSM, and asks it to sign votes and proposals. SignerServer is the
+other end, serving any types.PrivValidator. Both sides authenticate each
+other with TLS certificates (see ClientTLSConfig and ServerTLSConfig).
+
+Tendermint uses SignerClient when priv_validator_laddr has the grpc://
+scheme.
+*/
+package privvalgrpc
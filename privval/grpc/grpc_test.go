@@ -0,0 +1,234 @@
+package privvalgrpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "test-chain"
+
+func TestSignerClientServer(t *testing.T) {
+	dir, cleanup := testCerts(t)
+	defer cleanup()
+
+	mockPV := types.NewMockPV()
+	srv, addr := startTestServer(t, dir, mockPV)
+	defer srv.Stop()
+
+	sc := dialTestSigner(t, dir, addr)
+	defer sc.Close()
+
+	require.NoError(t, sc.WaitForConnection(5*time.Second))
+	assert.True(t, sc.IsConnected())
+	assert.Equal(t, mockPV.GetPubKey(), sc.GetPubKey())
+
+	vote := testVote()
+	require.NoError(t, sc.SignVote(testChainID, vote))
+	assert.True(t, mockPV.GetPubKey().VerifyBytes(vote.SignBytes(testChainID), vote.Signature))
+
+	proposal := testProposal()
+	require.NoError(t, sc.SignProposal(testChainID, proposal))
+	assert.True(t, mockPV.GetPubKey().VerifyBytes(proposal.SignBytes(testChainID), proposal.Signature))
+
+	// the server only signs for its own chain
+	err := sc.SignVote("other-chain", testVote())
+	require.Error(t, err)
+	rsErr, ok := err.(*privval.RemoteSignerError)
+	require.True(t, ok, "expected RemoteSignerError, got %T", err)
+	assert.Equal(t, int(codes.InvalidArgument), rsErr.Code)
+}
+
+func TestSignerServerSigningError(t *testing.T) {
+	dir, cleanup := testCerts(t)
+	defer cleanup()
+
+	srv, addr := startTestServer(t, dir, types.NewErroringMockPV())
+	defer srv.Stop()
+	sc := dialTestSigner(t, dir, addr)
+	defer sc.Close()
+
+	err := sc.SignVote(testChainID, testVote())
+	require.Error(t, err)
+	rsErr, ok := err.(*privval.RemoteSignerError)
+	require.True(t, ok, "expected RemoteSignerError, got %T", err)
+	assert.Equal(t, int(codes.FailedPrecondition), rsErr.Code)
+
+	assert.Error(t, sc.SignProposal(testChainID, testProposal()))
+}
+
+func TestSignerServerGuardStateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpc_signer_guard")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ss, err := NewSignerServer(testChainID, types.NewMockPV(), log.TestingLogger(),
+		SignerServerGuardStateFile(filepath.Join(dir, "state.json")))
+	require.NoError(t, err)
+
+	signVote := func(vote *types.Vote) error {
+		bz, err := cdc.MarshalBinaryBare(vote)
+		require.NoError(t, err)
+		_, err = ss.SignVote(context.Background(), &RequestSignVote{ChainId: testChainID, Vote: bz})
+		return err
+	}
+	vote := testVote()
+	require.NoError(t, signVote(vote))
+
+	// the server refuses to sign a conflicting vote, although the mock PV would
+	hash := tmhash.Sum([]byte("other hash"))
+	vote.BlockID = types.BlockID{Hash: hash, PartsHeader: types.PartSetHeader{Hash: hash, Total: 1}}
+	err = signVote(vote)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the state file must be valid
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bad.json"), []byte("{"), 0600))
+	_, err = NewSignerServer(testChainID, types.NewMockPV(), log.TestingLogger(),
+		SignerServerGuardStateFile(filepath.Join(dir, "bad.json")))
+	assert.Error(t, err)
+}
+
+func TestSignerServerRequiresClientCertificate(t *testing.T) {
+	dir, cleanup := testCerts(t)
+	defer cleanup()
+
+	srv, addr := startTestServer(t, dir, types.NewMockPV())
+	defer srv.Stop()
+
+	tlsConfig, err := ClientTLSConfig(
+		filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key"), filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+	tlsConfig.Certificates = nil
+
+	sc, err := DialRemoteSigner(addr, tlsConfig, testChainID, log.TestingLogger())
+	require.NoError(t, err)
+	defer sc.Close()
+	sc.timeout = 500 * time.Millisecond
+
+	assert.Nil(t, sc.GetPubKey())
+	assert.Error(t, sc.SignVote(testChainID, testVote()))
+}
+
+func startTestServer(t *testing.T, dir string, pv types.PrivValidator) (*grpc.Server, string) {
+	tlsConfig, err := ServerTLSConfig(
+		filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ss, err := NewSignerServer(testChainID, pv, log.TestingLogger())
+	require.NoError(t, err)
+	srv := NewServer(ss, tlsConfig)
+	go srv.Serve(ln) // nolint: errcheck
+
+	return srv, ln.Addr().String()
+}
+
+func dialTestSigner(t *testing.T, dir, addr string) *SignerClient {
+	tlsConfig, err := ClientTLSConfig(
+		filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key"), filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+
+	sc, err := DialRemoteSigner(addr, tlsConfig, testChainID, log.TestingLogger())
+	require.NoError(t, err)
+	return sc
+}
+
+func testVote() *types.Vote {
+	hash := tmhash.Sum([]byte("hash"))
+	return &types.Vote{
+		Type:             types.PrecommitType,
+		Height:           1,
+		BlockID:          types.BlockID{Hash: hash, PartsHeader: types.PartSetHeader{Hash: hash, Total: 1}},
+		ValidatorAddress: tmhash.SumTruncated([]byte("addr")),
+		Timestamp:        time.Unix(1, 0).UTC(),
+	}
+}
+
+func testProposal() *types.Proposal {
+	hash := tmhash.Sum([]byte("hash"))
+	return &types.Proposal{
+		Type:      types.ProposalType,
+		Height:    1,
+		POLRound:  -1,
+		BlockID:   types.BlockID{Hash: hash, PartsHeader: types.PartSetHeader{Hash: hash, Total: 1}},
+		Timestamp: time.Unix(1, 0).UTC(),
+	}
+}
+
+// testCerts writes a CA, and a server and a client certificate issued by it,
+// to a temporary directory.
+func testCerts(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "privval_grpc")
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "ca.crt"), "CERTIFICATE", caDER)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+		writePEM(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDER)
+	}
+	issue("server", 2, x509.ExtKeyUsageServerAuth)
+	issue("client", 3, x509.ExtKeyUsageClientAuth)
+
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	require.NoError(t, err)
+}
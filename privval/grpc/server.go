@@ -0,0 +1,149 @@
+package privvalgrpc
+
+import (
+	"context"
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+// SignerServer serves a PrivValidator to Tendermint nodes over gRPC. It only
+// signs for the chain it was created with.
+type SignerServer struct {
+	logger             log.Logger
+	chainID            string
+	privVal            types.PrivValidator
+	guardStateFilePath string
+}
+
+var _ PrivValidatorAPIServer = (*SignerServer)(nil)
+
+// SignerServerOption sets an optional parameter on the SignerServer.
+type SignerServerOption func(*SignerServer)
+
+// SignerServerGuardStateFile makes the SignerServer wrap its PrivValidator in
+// a privval.GuardedPV keeping its state in stateFilePath, so that it refuses
+// to sign conflicting votes and proposals even if the PrivValidator doesn't.
+func SignerServerGuardStateFile(stateFilePath string) SignerServerOption {
+	return func(ss *SignerServer) { ss.guardStateFilePath = stateFilePath }
+}
+
+// NewSignerServer returns a new SignerServer, which signs with privVal for
+// chainID. It fails if the guard state file, if any, can't be loaded.
+func NewSignerServer(
+	chainID string,
+	privVal types.PrivValidator,
+	logger log.Logger,
+	options ...SignerServerOption,
+) (*SignerServer, error) {
+	ss := &SignerServer{
+		logger:  logger,
+		chainID: chainID,
+		privVal: privVal,
+	}
+
+	for _, optionFunc := range options {
+		optionFunc(ss)
+	}
+
+	if ss.guardStateFilePath != "" {
+		gpv, err := privval.NewGuardedPV(ss.privVal, ss.guardStateFilePath)
+		if err != nil {
+			return nil, err
+		}
+		ss.privVal = gpv
+	}
+
+	return ss, nil
+}
+
+// NewServer returns a gRPC server serving ss, which requires clients to
+// authenticate using tlsConfig (see ServerTLSConfig). Call Serve on the
+// returned server to start accepting connections.
+func NewServer(ss *SignerServer, tlsConfig *tls.Config) *grpc.Server {
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	RegisterPrivValidatorAPIServer(srv, ss)
+	return srv
+}
+
+// GetPubKey implements PrivValidatorAPIServer.
+func (ss *SignerServer) GetPubKey(ctx context.Context, req *RequestPubKey) (*ResponsePubKey, error) {
+	if err := ss.checkChainID(req.ChainId); err != nil {
+		return nil, err
+	}
+
+	pubKey := ss.privVal.GetPubKey()
+	if pubKey == nil {
+		return nil, status.Error(codes.Unavailable, "public key is not available")
+	}
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode public key: %v", err)
+	}
+
+	return &ResponsePubKey{PubKey: bz}, nil
+}
+
+// SignVote implements PrivValidatorAPIServer.
+func (ss *SignerServer) SignVote(ctx context.Context, req *RequestSignVote) (*ResponseSignVote, error) {
+	if err := ss.checkChainID(req.ChainId); err != nil {
+		return nil, err
+	}
+
+	if len(req.Vote) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing vote")
+	}
+	vote := new(types.Vote)
+	if err := cdc.UnmarshalBinaryBare(req.Vote, vote); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid vote: %v", err)
+	}
+	if err := ss.privVal.SignVote(ss.chainID, vote); err != nil {
+		ss.logger.Error("Failed to sign vote", "height", vote.Height, "round", vote.Round, "err", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to sign vote: %v", err)
+	}
+	bz, err := cdc.MarshalBinaryBare(vote)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode vote: %v", err)
+	}
+
+	return &ResponseSignVote{Vote: bz}, nil
+}
+
+// SignProposal implements PrivValidatorAPIServer.
+func (ss *SignerServer) SignProposal(ctx context.Context, req *RequestSignProposal) (*ResponseSignProposal, error) {
+	if err := ss.checkChainID(req.ChainId); err != nil {
+		return nil, err
+	}
+
+	if len(req.Proposal) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing proposal")
+	}
+	proposal := new(types.Proposal)
+	if err := cdc.UnmarshalBinaryBare(req.Proposal, proposal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proposal: %v", err)
+	}
+	if err := ss.privVal.SignProposal(ss.chainID, proposal); err != nil {
+		ss.logger.Error("Failed to sign proposal", "height", proposal.Height, "round", proposal.Round, "err", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to sign proposal: %v", err)
+	}
+	bz, err := cdc.MarshalBinaryBare(proposal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode proposal: %v", err)
+	}
+
+	return &ResponseSignProposal{Proposal: bz}, nil
+}
+
+func (ss *SignerServer) checkChainID(chainID string) error {
+	if chainID != ss.chainID {
+		return status.Errorf(codes.InvalidArgument, "wrong chain ID: expected %s, got %s", ss.chainID, chainID)
+	}
+	return nil
+}
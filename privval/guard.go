@@ -0,0 +1,222 @@
+package privval
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/tempfile"
+	"github.com/tendermint/tendermint/types"
+)
+
+// GuardedPV wraps a PrivValidator, which may be remote, and refuses to sign
+// votes and proposals which conflict with ones signed before, like FilePV
+// does for its own key.
+//
+// The last signed height/round/step is kept in its own state file, which is
+// re-read under an exclusive lock (on a ".lock" file next to it) before each
+// signature, and written before the signature is returned. Several processes
+// sharing the same state file (e.g. two signers started by mistake) therefore
+// cannot sign conflicting messages either. File locking is only available on Unix-like
+// systems; elsewhere only a single process is protected.
+type GuardedPV struct {
+	privVal       types.PrivValidator
+	stateFilePath string
+
+	mtx sync.Mutex
+}
+
+var _ types.PrivValidator = (*GuardedPV)(nil)
+
+// NewGuardedPV returns a GuardedPV which signs with privVal, keeping its last
+// sign state in stateFilePath. The state file is created if it does not
+// exist.
+func NewGuardedPV(privVal types.PrivValidator, stateFilePath string) (*GuardedPV, error) {
+	gpv := &GuardedPV{
+		privVal:       privVal,
+		stateFilePath: stateFilePath,
+	}
+
+	unlock, err := lockFile(stateFilePath + ".lock")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if _, err := os.Stat(stateFilePath); os.IsNotExist(err) {
+		if err := gpv.saveState(&FilePVLastSignState{}); err != nil {
+			return nil, err
+		}
+	} else if _, err := gpv.loadState(); err != nil {
+		return nil, err
+	}
+
+	return gpv, nil
+}
+
+// GetPubKey implements PrivValidator.
+func (gpv *GuardedPV) GetPubKey() crypto.PubKey {
+	return gpv.privVal.GetPubKey()
+}
+
+// SignVote signs the vote with the wrapped PrivValidator, unless it conflicts
+// with a previously signed vote or proposal. Implements PrivValidator.
+func (gpv *GuardedPV) SignVote(chainID string, vote *types.Vote) error {
+	if err := gpv.signVote(chainID, vote); err != nil {
+		return fmt.Errorf("error signing vote: %v", err)
+	}
+	return nil
+}
+
+// SignProposal signs the proposal with the wrapped PrivValidator, unless it
+// conflicts with a previously signed vote or proposal. Implements
+// PrivValidator.
+func (gpv *GuardedPV) SignProposal(chainID string, proposal *types.Proposal) error {
+	if err := gpv.signProposal(chainID, proposal); err != nil {
+		return fmt.Errorf("error signing proposal: %v", err)
+	}
+	return nil
+}
+
+// String returns a string representation of the GuardedPV.
+func (gpv *GuardedPV) String() string {
+	return fmt.Sprintf("GuardedPV{%v %v}", gpv.privVal, gpv.stateFilePath)
+}
+
+func (gpv *GuardedPV) signVote(chainID string, vote *types.Vote) error {
+	gpv.mtx.Lock()
+	defer gpv.mtx.Unlock()
+
+	unlock, err := lockFile(gpv.stateFilePath + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	lss, err := gpv.loadState()
+	if err != nil {
+		return err
+	}
+
+	height, round, step := vote.Height, vote.Round, voteToStep(vote)
+	sameHRS, err := lss.CheckHRS(height, round, step)
+	if err != nil {
+		return err
+	}
+
+	// Same as FilePV: re-signing the same vote (e.g. after a crash) returns
+	// the last signature, possibly with the last timestamp and extension.
+	if sameHRS {
+		signBytes := vote.SignBytes(chainID)
+		if bytes.Equal(signBytes, lss.SignBytes) {
+			vote.Signature = lss.Signature
+		} else if timestamp, ext, ok := checkVotesOnlyDifferByTimestampOrExtension(
+			lss.SignBytes, signBytes); ok {
+			vote.Timestamp = timestamp
+			vote.Extension = ext
+			vote.Signature = lss.Signature
+		} else {
+			return errors.New("conflicting data")
+		}
+		return nil
+	}
+
+	if err := gpv.privVal.SignVote(chainID, vote); err != nil {
+		return err
+	}
+	if vote.Height != height || vote.Round != round || voteToStep(vote) != step {
+		vote.Signature = nil
+		return errors.New("signer changed the vote's height, round or type")
+	}
+
+	// Don't hand out a signature which wasn't recorded.
+	if err := gpv.saveSigned(height, round, step, vote.SignBytes(chainID), vote.Signature); err != nil {
+		vote.Signature = nil
+		return err
+	}
+	return nil
+}
+
+func (gpv *GuardedPV) signProposal(chainID string, proposal *types.Proposal) error {
+	gpv.mtx.Lock()
+	defer gpv.mtx.Unlock()
+
+	unlock, err := lockFile(gpv.stateFilePath + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	lss, err := gpv.loadState()
+	if err != nil {
+		return err
+	}
+
+	height, round, step := proposal.Height, proposal.Round, stepPropose
+	sameHRS, err := lss.CheckHRS(height, round, step)
+	if err != nil {
+		return err
+	}
+
+	if sameHRS {
+		signBytes := proposal.SignBytes(chainID)
+		if bytes.Equal(signBytes, lss.SignBytes) {
+			proposal.Signature = lss.Signature
+		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
+			proposal.Timestamp = timestamp
+			proposal.Signature = lss.Signature
+		} else {
+			return errors.New("conflicting data")
+		}
+		return nil
+	}
+
+	if err := gpv.privVal.SignProposal(chainID, proposal); err != nil {
+		return err
+	}
+	if proposal.Height != height || proposal.Round != round {
+		proposal.Signature = nil
+		return errors.New("signer changed the proposal's height or round")
+	}
+
+	if err := gpv.saveSigned(height, round, step, proposal.SignBytes(chainID), proposal.Signature); err != nil {
+		proposal.Signature = nil
+		return err
+	}
+	return nil
+}
+
+func (gpv *GuardedPV) saveSigned(height int64, round int, step int8, signBytes, sig []byte) error {
+	return gpv.saveState(&FilePVLastSignState{
+		Height:    height,
+		Round:     round,
+		Step:      step,
+		Signature: sig,
+		SignBytes: signBytes,
+	})
+}
+
+func (gpv *GuardedPV) loadState() (*FilePVLastSignState, error) {
+	bz, err := ioutil.ReadFile(gpv.stateFilePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read sign state")
+	}
+	lss := &FilePVLastSignState{}
+	if err := cdc.UnmarshalJSON(bz, lss); err != nil {
+		return nil, errors.Wrapf(err, "failed to read sign state from %v", gpv.stateFilePath)
+	}
+	return lss, nil
+}
+
+func (gpv *GuardedPV) saveState(lss *FilePVLastSignState) error {
+	bz, err := cdc.MarshalJSONIndent(lss, "", "  ")
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(tempfile.WriteFileAtomic(gpv.stateFilePath, bz, 0600), "failed to save sign state")
+}
@@ -0,0 +1,27 @@
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package privval
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// lockFile takes an exclusive lock on path, creating it if needed, and blocks
+// until the lock is acquired. The returned function releases the lock.
+func lockFile(path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open lock file")
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, errors.Wrap(err, "failed to lock file")
+	}
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN) // nolint: errcheck
+		f.Close()
+	}, nil
+}
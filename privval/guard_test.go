@@ -0,0 +1,131 @@
+package privval
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestGuardedPVSignVote(t *testing.T) {
+	dir, err := ioutil.TempDir("", "guarded_pv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stateFile := filepath.Join(dir, "state.json")
+
+	mockPV := types.NewMockPV()
+	addr := mockPV.GetPubKey().Address()
+	guardedPV, err := NewGuardedPV(mockPV, stateFile)
+	require.NoError(t, err)
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	block2 := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{}}
+	height, round := int64(10), 1
+	voteType := byte(types.PrevoteType)
+
+	vote := newVote(addr, 0, height, round, voteType, block1)
+	require.NoError(t, guardedPV.SignVote("mychainid", vote))
+	assert.True(t, mockPV.GetPubKey().VerifyBytes(vote.SignBytes("mychainid"), vote.Signature))
+
+	// signing the same vote again returns the same signature, even with a
+	// different timestamp
+	sig, timestamp := vote.Signature, vote.Timestamp
+	vote.Timestamp = vote.Timestamp.Add(time.Millisecond)
+	vote.Signature = nil
+	require.NoError(t, guardedPV.SignVote("mychainid", vote))
+	assert.Equal(t, sig, vote.Signature)
+	assert.Equal(t, timestamp, vote.Timestamp)
+
+	cases := []*types.Vote{
+		newVote(addr, 0, height, round-1, voteType, block1),   // round regression
+		newVote(addr, 0, height-1, round, voteType, block1),   // height regression
+		newVote(addr, 0, height-2, round+4, voteType, block1), // height regression and different round
+		newVote(addr, 0, height, round, voteType, block2),     // different block
+	}
+	for _, c := range cases {
+		assert.Error(t, guardedPV.SignVote("mychainid", c), "expected error on signing conflicting vote")
+		assert.Nil(t, c.Signature)
+	}
+
+	// a second process sharing the state file can't sign a conflicting vote
+	otherPV, err := NewGuardedPV(mockPV, stateFile)
+	require.NoError(t, err)
+	assert.Error(t, otherPV.SignVote("mychainid", newVote(addr, 0, height, round, voteType, block2)))
+
+	// but it can move on, after which the first one can't go back
+	require.NoError(t, otherPV.SignVote("mychainid", newVote(addr, 0, height, round+1, voteType, block2)))
+	assert.Error(t, guardedPV.SignVote("mychainid", newVote(addr, 0, height, round, voteType, block1)))
+}
+
+func TestGuardedPVSignProposal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "guarded_pv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stateFile := filepath.Join(dir, "state.json")
+
+	guardedPV, err := NewGuardedPV(types.NewMockPV(), stateFile)
+	require.NoError(t, err)
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{Total: 5, Hash: []byte{1, 2, 3}}}
+	block2 := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{Total: 10, Hash: []byte{3, 2, 1}}}
+	height, round := int64(10), 1
+
+	proposal := newProposal(height, round, block1)
+	require.NoError(t, guardedPV.SignProposal("mychainid", proposal))
+	sig := proposal.Signature
+	proposal.Timestamp = proposal.Timestamp.Add(time.Millisecond)
+	require.NoError(t, guardedPV.SignProposal("mychainid", proposal))
+	assert.Equal(t, sig, proposal.Signature)
+
+	cases := []*types.Proposal{
+		newProposal(height, round-1, block1), // round regression
+		newProposal(height-1, round, block1), // height regression
+		newProposal(height, round, block2),   // different block
+	}
+	for _, c := range cases {
+		assert.Error(t, guardedPV.SignProposal("mychainid", c), "expected error on signing conflicting proposal")
+	}
+
+	// the state survives a restart
+	guardedPV, err = NewGuardedPV(types.NewMockPV(), stateFile)
+	require.NoError(t, err)
+	assert.Error(t, guardedPV.SignProposal("mychainid", newProposal(height, round, block2)))
+}
+
+func TestGuardedPVSignerError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "guarded_pv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stateFile := filepath.Join(dir, "state.json")
+
+	guardedPV, err := NewGuardedPV(types.NewErroringMockPV(), stateFile)
+	require.NoError(t, err)
+
+	blockID := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	vote := newVote(nil, 0, 10, 1, byte(types.PrevoteType), blockID)
+	assert.Error(t, guardedPV.SignVote("mychainid", vote))
+
+	// nothing was recorded, so a working signer can sign the vote
+	guardedPV, err = NewGuardedPV(types.NewMockPV(), stateFile)
+	require.NoError(t, err)
+	assert.NoError(t, guardedPV.SignVote("mychainid", vote))
+}
+
+func TestNewGuardedPVInvalidState(t *testing.T) {
+	stateFile, err := ioutil.TempFile("", "guarded_pv_state")
+	require.NoError(t, err)
+	defer os.Remove(stateFile.Name())
+	defer os.Remove(stateFile.Name() + ".lock")
+
+	_, err = stateFile.WriteString("not json")
+	require.NoError(t, err)
+
+	_, err = NewGuardedPV(types.NewMockPV(), stateFile.Name())
+	assert.Error(t, err)
+}
@@ -29,8 +29,12 @@ func NewSignerClient(endpoint *SignerListenerEndpoint) (*SignerClient, error) {
 	return &SignerClient{endpoint: endpoint}, nil
 }
 
-// Close closes the underlying connection
+// Close closes the underlying connection, and stops the endpoint so that it
+// doesn't accept new ones.
 func (sc *SignerClient) Close() error {
+	if sc.endpoint.IsRunning() {
+		return sc.endpoint.Stop()
+	}
 	return sc.endpoint.Close()
 }
 
@@ -2,6 +2,9 @@ package privval
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -255,3 +258,31 @@ func TestSignerUnexpectedResponse(t *testing.T) {
 		assert.EqualError(t, e, "received unexpected response")
 	}
 }
+
+func TestSignerServerGuardStateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer_server_guard")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, dtc := range getDialerTestCases(t) {
+		chainID := tmrand.Str(12)
+		mockPV := types.NewMockPV()
+		sl, sd := getMockEndpoints(t, dtc.addr, dtc.dialer)
+		sc, err := NewSignerClient(sl)
+		require.NoError(t, err)
+		ss := NewSignerServer(sd, chainID, mockPV, SignerServerGuardStateFile(filepath.Join(dir, chainID+".json")))
+		require.NoError(t, ss.Start())
+		defer ss.Stop()
+		defer sc.Close()
+
+		addr := mockPV.GetPubKey().Address()
+		block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+		block2 := types.BlockID{Hash: []byte{3, 2, 1}, PartsHeader: types.PartSetHeader{}}
+		require.NoError(t, sc.SignVote(chainID, newVote(addr, 0, 10, 1, byte(types.PrevoteType), block1)))
+
+		// the server refuses to sign a conflicting vote, although mockPV would
+		vote := newVote(addr, 0, 10, 1, byte(types.PrevoteType), block2)
+		assert.Error(t, sc.SignVote(chainID, vote))
+		assert.Nil(t, vote.Signature)
+	}
+}
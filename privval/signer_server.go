@@ -14,18 +14,36 @@ type ValidationRequestHandlerFunc func(
 	requestMessage SignerMessage,
 	chainID string) (SignerMessage, error)
 
+// SignerServerOption sets an optional parameter on the SignerServer.
+type SignerServerOption func(*SignerServer)
+
+// SignerServerGuardStateFile makes the SignerServer wrap its PrivValidator in
+// a GuardedPV keeping its state in stateFilePath, so that it refuses to sign
+// conflicting votes and proposals even if the PrivValidator doesn't.
+func SignerServerGuardStateFile(stateFilePath string) SignerServerOption {
+	return func(ss *SignerServer) { ss.guardStateFilePath = stateFilePath }
+}
+
 type SignerServer struct {
 	service.BaseService
 
-	endpoint *SignerDialerEndpoint
-	chainID  string
-	privVal  types.PrivValidator
+	endpoint           *SignerDialerEndpoint
+	chainID            string
+	privVal            types.PrivValidator
+	guardStateFilePath string
 
 	handlerMtx               sync.Mutex
 	validationRequestHandler ValidationRequestHandlerFunc
 }
 
-func NewSignerServer(endpoint *SignerDialerEndpoint, chainID string, privVal types.PrivValidator) *SignerServer {
+// NewSignerServer returns a SignerServer which serves privVal through
+// endpoint.
+func NewSignerServer(
+	endpoint *SignerDialerEndpoint,
+	chainID string,
+	privVal types.PrivValidator,
+	options ...SignerServerOption,
+) *SignerServer {
 	ss := &SignerServer{
 		endpoint:                 endpoint,
 		chainID:                  chainID,
@@ -33,6 +51,10 @@ func NewSignerServer(endpoint *SignerDialerEndpoint, chainID string, privVal typ
 		validationRequestHandler: DefaultValidationRequestHandler,
 	}
 
+	for _, optionFunc := range options {
+		optionFunc(ss)
+	}
+
 	ss.BaseService = *service.NewBaseService(endpoint.Logger, "SignerServer", ss)
 
 	return ss
@@ -40,6 +62,16 @@ func NewSignerServer(endpoint *SignerDialerEndpoint, chainID string, privVal typ
 
 // OnStart implements service.Service.
 func (ss *SignerServer) OnStart() error {
+	if ss.guardStateFilePath != "" {
+		if _, ok := ss.privVal.(*GuardedPV); !ok {
+			gpv, err := NewGuardedPV(ss.privVal, ss.guardStateFilePath)
+			if err != nil {
+				return err
+			}
+			ss.privVal = gpv
+		}
+	}
+
 	go ss.serviceLoop()
 	return nil
 }
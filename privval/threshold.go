@@ -0,0 +1,170 @@
+package privval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/types"
+)
+
+// ThresholdPV is a PrivValidator for a k-of-n threshold multisig key. Each of
+// the n signers, usually remote signers in separate processes, holds one of
+// the keys and produces a partial signature; ThresholdPV combines k partial
+// signatures of the same sign bytes into a multisignature.
+//
+// Signers are asked concurrently, so a vote or proposal is signed as soon as
+// k of them agree on what to sign, without waiting for the others.
+type ThresholdPV struct {
+	pubKey  multisig.PubKeyMultisigThreshold
+	signers []types.PrivValidator
+}
+
+var _ types.PrivValidator = (*ThresholdPV)(nil)
+
+// NewThresholdPV returns a ThresholdPV for the k-of-n threshold key of the
+// given signers. The order of the signers is the order of the keys in the
+// threshold key.
+func NewThresholdPV(k int, signers []types.PrivValidator) (*ThresholdPV, error) {
+	if k <= 0 || k > len(signers) {
+		return nil, fmt.Errorf("invalid threshold %d of %d signers", k, len(signers))
+	}
+
+	pubKeys := make([]crypto.PubKey, len(signers))
+	for i, signer := range signers {
+		pubKeys[i] = signer.GetPubKey()
+		if pubKeys[i] == nil {
+			return nil, fmt.Errorf("signer #%d has no pubkey", i)
+		}
+	}
+	pubKey := multisig.NewPubKeyMultisigThreshold(k, pubKeys).(multisig.PubKeyMultisigThreshold)
+	if err := types.ValidateThresholdPubKey(pubKey); err != nil {
+		return nil, err
+	}
+
+	return &ThresholdPV{
+		pubKey:  pubKey,
+		signers: signers,
+	}, nil
+}
+
+// GetPubKey returns the threshold multisig key. Implements PrivValidator.
+func (tpv *ThresholdPV) GetPubKey() crypto.PubKey {
+	return tpv.pubKey
+}
+
+// SignVote signs the vote with k of the signers. Implements PrivValidator.
+func (tpv *ThresholdPV) SignVote(chainID string, vote *types.Vote) error {
+	// Each signer gets its own copy, made before signing as the slowest
+	// signers may still be signing after SignVote returns.
+	copies := make([]*types.Vote, len(tpv.signers))
+	for i := range copies {
+		copies[i] = vote.Copy()
+	}
+	i, sig, err := tpv.sign(chainID, func(i int, signer types.PrivValidator) (types.Signable, []byte, error) {
+		if err := signer.SignVote(chainID, copies[i]); err != nil {
+			return nil, nil, err
+		}
+		return copies[i], copies[i].Signature, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error signing vote: %v", err)
+	}
+
+	// The signers may have returned the timestamp and extension of a vote
+	// they signed before.
+	vote.Timestamp = copies[i].Timestamp
+	vote.Extension = copies[i].Extension
+	vote.Signature = sig
+	return nil
+}
+
+// SignProposal signs the proposal with k of the signers. Implements
+// PrivValidator.
+func (tpv *ThresholdPV) SignProposal(chainID string, proposal *types.Proposal) error {
+	copies := make([]*types.Proposal, len(tpv.signers))
+	for i := range copies {
+		p := *proposal
+		copies[i] = &p
+	}
+	i, sig, err := tpv.sign(chainID, func(i int, signer types.PrivValidator) (types.Signable, []byte, error) {
+		if err := signer.SignProposal(chainID, copies[i]); err != nil {
+			return nil, nil, err
+		}
+		return copies[i], copies[i].Signature, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error signing proposal: %v", err)
+	}
+
+	proposal.Timestamp = copies[i].Timestamp
+	proposal.Signature = sig
+	return nil
+}
+
+// String returns a string representation of the ThresholdPV.
+func (tpv *ThresholdPV) String() string {
+	return fmt.Sprintf("ThresholdPV{%d of %d %v}", tpv.pubKey.K, len(tpv.signers), tpv.pubKey.Address())
+}
+
+type partialSignature struct {
+	index     int
+	signBytes []byte
+	sig       []byte
+	err       error
+}
+
+// sign asks all signers to sign concurrently and returns, as soon as k valid
+// partial signatures of the same sign bytes were received, the index of one of
+// the signers whose partial signature is part of the multisignature, along
+// with the marshalled multisignature. Slower signers are not waited for.
+func (tpv *ThresholdPV) sign(
+	chainID string,
+	signFn func(i int, signer types.PrivValidator) (types.Signable, []byte, error),
+) (int, []byte, error) {
+	// buffered, so that the signers returning after sign don't block
+	partials := make(chan partialSignature, len(tpv.signers))
+	for i, signer := range tpv.signers {
+		go func(i int, signer types.PrivValidator) {
+			p := partialSignature{index: i}
+			signable, sig, err := signFn(i, signer)
+			if err != nil {
+				p.err = err
+			} else {
+				p.signBytes = signable.SignBytes(chainID)
+				p.sig = sig
+				if !tpv.pubKey.PubKeys[i].VerifyBytes(p.signBytes, sig) {
+					p.err = errors.New("invalid partial signature")
+				}
+			}
+			partials <- p
+		}(i, signer)
+	}
+
+	// Combine the first k valid partial signatures of the same sign bytes.
+	k := int(tpv.pubKey.K)
+	matching := make(map[string][]partialSignature)
+	errs := make([]string, 0, len(tpv.signers))
+	for range tpv.signers {
+		p := <-partials
+		if p.err != nil {
+			errs = append(errs, fmt.Sprintf("signer #%d: %v", p.index, p.err))
+			continue
+		}
+		group := append(matching[string(p.signBytes)], p)
+		matching[string(p.signBytes)] = group
+		if len(group) < k {
+			continue
+		}
+		mSig := multisig.NewMultisig(len(tpv.signers))
+		for _, q := range group {
+			mSig.AddSignature(q.sig, q.index)
+		}
+		return group[0].index, mSig.Marshal(), nil
+	}
+
+	return 0, nil, fmt.Errorf("could not get %d matching partial signatures (%s)", k, strings.Join(errs, "; "))
+}
@@ -0,0 +1,100 @@
+package privval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestNewThresholdPV(t *testing.T) {
+	signers := []types.PrivValidator{types.NewMockPV(), types.NewMockPV(), types.NewMockPV()}
+
+	tpv, err := NewThresholdPV(2, signers)
+	require.NoError(t, err)
+	pubKey, ok := tpv.GetPubKey().(multisig.PubKeyMultisigThreshold)
+	require.True(t, ok)
+	assert.EqualValues(t, 2, pubKey.K)
+	for i, signer := range signers {
+		assert.Equal(t, signer.GetPubKey(), pubKey.PubKeys[i])
+	}
+
+	_, err = NewThresholdPV(0, signers)
+	assert.Error(t, err)
+	_, err = NewThresholdPV(4, signers)
+	assert.Error(t, err)
+
+	tooMany := make([]types.PrivValidator, types.MaxThresholdPubKeys+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewMockPV()
+	}
+	_, err = NewThresholdPV(1, tooMany)
+	assert.Error(t, err)
+}
+
+func TestThresholdPVSignVote(t *testing.T) {
+	const chainID = "mychainid"
+	signers := []types.PrivValidator{types.NewMockPV(), types.NewErroringMockPV(), types.NewMockPV()}
+	tpv, err := NewThresholdPV(2, signers)
+	require.NoError(t, err)
+
+	block := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	vote := newVote(tpv.GetPubKey().Address(), 0, 10, 1, byte(types.PrecommitType), block)
+	require.NoError(t, tpv.SignVote(chainID, vote))
+	assert.True(t, tpv.GetPubKey().VerifyBytes(vote.SignBytes(chainID), vote.Signature))
+	assert.LessOrEqual(t, len(vote.Signature), types.MaxSignatureSize)
+
+	// a signer signing with the wrong key doesn't count
+	signers[2] = types.NewMockPVWithParams(ed25519.GenPrivKey(), false, false)
+	tpv.signers = signers
+	vote = newVote(tpv.GetPubKey().Address(), 0, 11, 1, byte(types.PrecommitType), block)
+	assert.Error(t, tpv.SignVote(chainID, vote))
+	assert.Nil(t, vote.Signature)
+}
+
+func TestThresholdPVSignProposal(t *testing.T) {
+	const chainID = "mychainid"
+	signers := []types.PrivValidator{types.NewMockPV(), types.NewMockPV(), types.NewErroringMockPV()}
+	tpv, err := NewThresholdPV(2, signers)
+	require.NoError(t, err)
+
+	block := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	proposal := newProposal(10, 1, block)
+	require.NoError(t, tpv.SignProposal(chainID, proposal))
+	assert.True(t, tpv.GetPubKey().VerifyBytes(proposal.SignBytes(chainID), proposal.Signature))
+
+	// only one signer is left
+	signers[1] = types.NewErroringMockPV()
+	proposal = newProposal(11, 1, block)
+	assert.Error(t, tpv.SignProposal(chainID, proposal))
+	assert.Nil(t, proposal.Signature)
+}
+
+// blockingPV is a signer which doesn't reply until it's unblocked.
+type blockingPV struct {
+	types.PrivValidator
+	unblock chan struct{}
+}
+
+func (pv blockingPV) SignVote(chainID string, vote *types.Vote) error {
+	<-pv.unblock
+	return pv.PrivValidator.SignVote(chainID, vote)
+}
+
+func TestThresholdPVDoesNotWaitForSlowSigners(t *testing.T) {
+	const chainID = "mychainid"
+	slow := blockingPV{types.NewMockPV(), make(chan struct{})}
+	defer close(slow.unblock)
+	signers := []types.PrivValidator{types.NewMockPV(), slow, types.NewMockPV()}
+	tpv, err := NewThresholdPV(2, signers)
+	require.NoError(t, err)
+
+	block := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	vote := newVote(tpv.GetPubKey().Address(), 0, 10, 1, byte(types.PrecommitType), block)
+	require.NoError(t, tpv.SignVote(chainID, vote))
+	assert.True(t, tpv.GetPubKey().VerifyBytes(vote.SignBytes(chainID), vote.Signature))
+}
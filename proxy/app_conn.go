@@ -1,6 +1,8 @@
 package proxy
 
 import (
+	"sync"
+
 	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/types"
 )
@@ -18,6 +20,12 @@ type AppConnConsensus interface {
 	DeliverTxAsync(types.RequestDeliverTx) *abcicli.ReqRes
 	EndBlockSync(types.RequestEndBlock) (*types.ResponseEndBlock, error)
 	CommitSync() (*types.ResponseCommit, error)
+
+	ExtendVoteSync(types.RequestExtendVote) (*types.ResponseExtendVote, error)
+	VerifyVoteExtensionSync(types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
+
+	PrepareProposalSync(types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error)
+	ProcessProposalSync(types.RequestProcessProposal) (*types.ResponseProcessProposal, error)
 }
 
 type AppConnMempool interface {
@@ -40,11 +48,21 @@ type AppConnQuery interface {
 	//	SetOptionSync(key string, value string) (res types.Result)
 }
 
+type AppConnSnapshot interface {
+	Error() error
+
+	ListSnapshotsSync(types.RequestListSnapshots) (*types.ResponseListSnapshots, error)
+	OfferSnapshotSync(types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error)
+	LoadSnapshotChunkSync(types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error)
+	ApplySnapshotChunkSync(types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error)
+}
+
 //-----------------------------------------------------------------------------------------
 // Implements AppConnConsensus (subset of abcicli.Client)
 
 type appConnConsensus struct {
 	appConn abcicli.Client
+	version *negotiatedVersion
 }
 
 func NewAppConnConsensus(appConn abcicli.Client) *appConnConsensus {
@@ -81,64 +99,257 @@ func (app *appConnConsensus) CommitSync() (*types.ResponseCommit, error) {
 	return app.appConn.CommitSync()
 }
 
+// ExtendVoteSync returns no extension if the app doesn't support vote
+// extensions.
+func (app *appConnConsensus) ExtendVoteSync(req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	if !app.version.supports(VoteExtensionsABCIVersion) {
+		return &types.ResponseExtendVote{}, nil
+	}
+	return app.appConn.ExtendVoteSync(req)
+}
+
+// VerifyVoteExtensionSync accepts all extensions if the app doesn't support
+// vote extensions.
+func (app *appConnConsensus) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	if !app.version.supports(VoteExtensionsABCIVersion) {
+		return &types.ResponseVerifyVoteExtension{Status: types.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+	return app.appConn.VerifyVoteExtensionSync(req)
+}
+
+// PrepareProposalSync returns the given txs if the app doesn't support
+// PrepareProposal.
+func (app *appConnConsensus) PrepareProposalSync(
+	req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	if !app.version.supports(ProposalsABCIVersion) {
+		return &types.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+	return app.appConn.PrepareProposalSync(req)
+}
+
+// ProcessProposalSync accepts all blocks if the app doesn't support
+// ProcessProposal.
+func (app *appConnConsensus) ProcessProposalSync(
+	req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	if !app.version.supports(ProposalsABCIVersion) {
+		return &types.ResponseProcessProposal{Status: types.ResponseProcessProposal_ACCEPT}, nil
+	}
+	return app.appConn.ProcessProposalSync(req)
+}
+
 //------------------------------------------------
 // Implements AppConnMempool (subset of abcicli.Client)
 
+// appConnMempool sends CheckTx requests over a pool of clients, in turn.
+// Rechecks are sent over the first client, and so are all requests while
+// rechecks are outstanding: the responses to rechecks are expected in order,
+// before the responses to the txs checked after them.
 type appConnMempool struct {
-	appConn abcicli.Client
+	appConns []abcicli.Client
+
+	mtx        sync.Mutex
+	next       int // next client to check a tx with
+	rechecking int // number of outstanding rechecks
 }
 
 func NewAppConnMempool(appConn abcicli.Client) *appConnMempool {
+	return NewAppConnMempoolPool([]abcicli.Client{appConn})
+}
+
+// NewAppConnMempoolPool returns a mempool connection over the given clients,
+// which must not be empty.
+func NewAppConnMempoolPool(appConns []abcicli.Client) *appConnMempool {
 	return &appConnMempool{
-		appConn: appConn,
+		appConns: appConns,
 	}
 }
 
+// SetResponseCallback sets the callback called with the responses received by
+// any client. Note the response callbacks, including those set on each ReqRes,
+// may be called concurrently by different clients.
 func (app *appConnMempool) SetResponseCallback(cb abcicli.Callback) {
-	app.appConn.SetResponseCallback(cb)
+	for _, appConn := range app.appConns {
+		appConn.SetResponseCallback(func(req *types.Request, res *types.Response) {
+			cb(req, res)
+			if req.GetCheckTx().GetType() == types.CheckTxType_Recheck {
+				app.mtx.Lock()
+				app.rechecking--
+				app.mtx.Unlock()
+			}
+		})
+	}
 }
 
+// Error returns the error of the first failed client.
 func (app *appConnMempool) Error() error {
-	return app.appConn.Error()
+	for _, appConn := range app.appConns {
+		if err := appConn.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// FlushAsync flushes all the clients, and returns the ReqRes of the first one.
 func (app *appConnMempool) FlushAsync() *abcicli.ReqRes {
-	return app.appConn.FlushAsync()
+	for _, appConn := range app.appConns[1:] {
+		appConn.FlushAsync()
+	}
+	return app.appConns[0].FlushAsync()
 }
 
+// FlushSync flushes all the clients.
 func (app *appConnMempool) FlushSync() error {
-	return app.appConn.FlushSync()
+	var firstErr error
+	for _, appConn := range app.appConns {
+		if err := appConn.FlushSync(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
 
 func (app *appConnMempool) CheckTxAsync(req types.RequestCheckTx) *abcicli.ReqRes {
-	return app.appConn.CheckTxAsync(req)
+	app.mtx.Lock()
+	appConn := app.appConns[0]
+	switch {
+	case req.Type == types.CheckTxType_Recheck:
+		app.rechecking++
+	case app.rechecking == 0:
+		appConn = app.appConns[app.next]
+		app.next = (app.next + 1) % len(app.appConns)
+	}
+	app.mtx.Unlock()
+
+	return appConn.CheckTxAsync(req)
 }
 
 //------------------------------------------------
 // Implements AppConnQuery (subset of abcicli.Client)
 
+// appConnQuery sends each request over the client of a pool with the fewest
+// requests in flight, so concurrent requests don't wait for each other.
 type appConnQuery struct {
-	appConn abcicli.Client
+	appConns []abcicli.Client
+
+	mtx      sync.Mutex
+	inFlight []int // number of requests in flight over each client
 }
 
 func NewAppConnQuery(appConn abcicli.Client) *appConnQuery {
+	return NewAppConnQueryPool([]abcicli.Client{appConn})
+}
+
+// NewAppConnQueryPool returns a query connection over the given clients,
+// which must not be empty.
+func NewAppConnQueryPool(appConns []abcicli.Client) *appConnQuery {
 	return &appConnQuery{
-		appConn: appConn,
+		appConns: appConns,
+		inFlight: make([]int, len(appConns)),
 	}
 }
 
+// acquire returns the index of the least busy client, which must be released
+// once the request is done.
+func (app *appConnQuery) acquire() int {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	idx := 0
+	for i, n := range app.inFlight {
+		if n < app.inFlight[idx] {
+			idx = i
+		}
+	}
+	app.inFlight[idx]++
+	return idx
+}
+
+func (app *appConnQuery) release(idx int) {
+	app.mtx.Lock()
+	app.inFlight[idx]--
+	app.mtx.Unlock()
+}
+
+// Error returns the error of the first failed client.
 func (app *appConnQuery) Error() error {
-	return app.appConn.Error()
+	for _, appConn := range app.appConns {
+		if err := appConn.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (app *appConnQuery) EchoSync(msg string) (*types.ResponseEcho, error) {
-	return app.appConn.EchoSync(msg)
+	idx := app.acquire()
+	defer app.release(idx)
+	return app.appConns[idx].EchoSync(msg)
 }
 
 func (app *appConnQuery) InfoSync(req types.RequestInfo) (*types.ResponseInfo, error) {
-	return app.appConn.InfoSync(req)
+	idx := app.acquire()
+	defer app.release(idx)
+	return app.appConns[idx].InfoSync(req)
 }
 
 func (app *appConnQuery) QuerySync(reqQuery types.RequestQuery) (*types.ResponseQuery, error) {
-	return app.appConn.QuerySync(reqQuery)
+	idx := app.acquire()
+	defer app.release(idx)
+	return app.appConns[idx].QuerySync(reqQuery)
+}
+
+//------------------------------------------------
+// Implements AppConnSnapshot (subset of abcicli.Client)
+
+type appConnSnapshot struct {
+	appConn abcicli.Client
+	version *negotiatedVersion
+}
+
+func NewAppConnSnapshot(appConn abcicli.Client) *appConnSnapshot {
+	return &appConnSnapshot{
+		appConn: appConn,
+	}
+}
+
+func (app *appConnSnapshot) Error() error {
+	return app.appConn.Error()
+}
+
+// ListSnapshotsSync returns no snapshots if the app doesn't support
+// snapshots.
+func (app *appConnSnapshot) ListSnapshotsSync(req types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
+	if !app.version.supports(SnapshotsABCIVersion) {
+		return &types.ResponseListSnapshots{}, nil
+	}
+	return app.appConn.ListSnapshotsSync(req)
+}
+
+// OfferSnapshotSync aborts state sync if the app doesn't support snapshots.
+func (app *appConnSnapshot) OfferSnapshotSync(req types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
+	if !app.version.supports(SnapshotsABCIVersion) {
+		return &types.ResponseOfferSnapshot{Result: types.ResponseOfferSnapshot_ABORT}, nil
+	}
+	return app.appConn.OfferSnapshotSync(req)
+}
+
+// LoadSnapshotChunkSync returns no chunk if the app doesn't support
+// snapshots.
+func (app *appConnSnapshot) LoadSnapshotChunkSync(
+	req types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error) {
+	if !app.version.supports(SnapshotsABCIVersion) {
+		return &types.ResponseLoadSnapshotChunk{}, nil
+	}
+	return app.appConn.LoadSnapshotChunkSync(req)
+}
+
+// ApplySnapshotChunkSync aborts state sync if the app doesn't support
+// snapshots.
+func (app *appConnSnapshot) ApplySnapshotChunkSync(
+	req types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
+	if !app.version.supports(SnapshotsABCIVersion) {
+		return &types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ABORT}, nil
+	}
+	return app.appConn.ApplySnapshotChunkSync(req)
 }
@@ -5,6 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	"github.com/tendermint/tendermint/abci/server"
@@ -151,3 +154,104 @@ func TestInfo(t *testing.T) {
 		t.Error("Expected ResponseInfo with one element '{\"size\":0}' but got something else")
 	}
 }
+
+// fakeClient records the txs it is asked to check, and blocks queries until
+// unblocked.
+type fakeClient struct {
+	abcicli.Client
+
+	id      int
+	cb      abcicli.Callback
+	checked []types.RequestCheckTx
+	queried chan int
+	unblock chan struct{}
+}
+
+func (cli *fakeClient) SetResponseCallback(cb abcicli.Callback) {
+	cli.cb = cb
+}
+
+func (cli *fakeClient) CheckTxAsync(req types.RequestCheckTx) *abcicli.ReqRes {
+	cli.checked = append(cli.checked, req)
+	return abcicli.NewReqRes(types.ToRequestCheckTx(req))
+}
+
+// respond calls the response callback for the first unanswered tx.
+func (cli *fakeClient) respond() {
+	req := cli.checked[0]
+	cli.checked = cli.checked[1:]
+	cli.cb(types.ToRequestCheckTx(req), types.ToResponseCheckTx(types.ResponseCheckTx{}))
+}
+
+func (cli *fakeClient) QuerySync(types.RequestQuery) (*types.ResponseQuery, error) {
+	cli.queried <- cli.id
+	<-cli.unblock
+	return &types.ResponseQuery{}, nil
+}
+
+func TestAppConnMempoolPool(t *testing.T) {
+	clis := []*fakeClient{{id: 0}, {id: 1}, {id: 2}}
+	conn := NewAppConnMempoolPool([]abcicli.Client{clis[0], clis[1], clis[2]})
+	var responses int
+	conn.SetResponseCallback(func(*types.Request, *types.Response) { responses++ })
+
+	newTx := types.RequestCheckTx{Tx: []byte("new")}
+	recheckTx := types.RequestCheckTx{Tx: []byte("recheck"), Type: types.CheckTxType_Recheck}
+
+	// New txs are checked over the clients in turn.
+	for i := 0; i < 6; i++ {
+		conn.CheckTxAsync(newTx)
+	}
+	for _, cli := range clis {
+		require.Len(t, cli.checked, 2)
+		cli.respond()
+		cli.respond()
+	}
+
+	// Rechecks, and txs checked while rechecking, go to the first client.
+	conn.CheckTxAsync(recheckTx)
+	conn.CheckTxAsync(recheckTx)
+	conn.CheckTxAsync(newTx)
+	require.Len(t, clis[0].checked, 3)
+	assert.Empty(t, clis[1].checked)
+	assert.Empty(t, clis[2].checked)
+
+	clis[0].respond()
+	conn.CheckTxAsync(newTx)
+	require.Len(t, clis[0].checked, 3)
+	clis[0].respond()
+
+	// Once rechecking is done, they're spread again.
+	conn.CheckTxAsync(newTx)
+	conn.CheckTxAsync(newTx)
+	assert.Len(t, clis[0].checked, 3) // with the two txs checked while rechecking
+	assert.Len(t, clis[1].checked, 1)
+	assert.Len(t, clis[2].checked, 0)
+	assert.Equal(t, 8, responses)
+}
+
+func TestAppConnQueryPool(t *testing.T) {
+	unblock := make(chan struct{})
+	queried := make(chan int, 3)
+	clis := []*fakeClient{
+		{id: 0, queried: queried, unblock: unblock},
+		{id: 1, queried: queried, unblock: unblock},
+	}
+	conn := NewAppConnQueryPool([]abcicli.Client{clis[0], clis[1]})
+
+	// Concurrent queries are sent over different clients.
+	done := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := conn.QuerySync(types.RequestQuery{})
+			assert.NoError(t, err)
+			done <- struct{}{}
+		}()
+	}
+	ids := []int{<-queried, <-queried}
+	assert.ElementsMatch(t, []int{0, 1}, ids)
+
+	close(unblock)
+	<-done
+	<-done
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"github.com/pkg/errors"
 
+	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/libs/service"
 )
 
@@ -15,16 +16,24 @@ type AppConns interface {
 	Mempool() AppConnMempool
 	Consensus() AppConnConsensus
 	Query() AppConnQuery
+	Snapshot() AppConnSnapshot
+
+	// ABCIVersion returns the ABCI version used with the app, NodeABCIVersion
+	// until SetABCIVersion is called.
+	ABCIVersion() ABCIVersion
+	// SetABCIVersion sets the ABCI version negotiated with the app. Methods
+	// added in later versions aren't called, and return a default response.
+	SetABCIVersion(ABCIVersion)
 }
 
-func NewAppConns(clientCreator ClientCreator) AppConns {
-	return NewMultiAppConn(clientCreator)
+func NewAppConns(clientCreator ClientCreator, options ...MultiAppConnOption) AppConns {
+	return NewMultiAppConn(clientCreator, options...)
 }
 
 //-----------------------------
 // multiAppConn implements AppConns
 
-// a multiAppConn is made of a few appConns (mempool, consensus, query)
+// a multiAppConn is made of a few appConns (mempool, consensus, query, snapshot)
 // and manages their underlying abci clients
 // TODO: on app restart, clients must reboot together
 type multiAppConn struct {
@@ -33,14 +42,39 @@ type multiAppConn struct {
 	mempoolConn   *appConnMempool
 	consensusConn *appConnConsensus
 	queryConn     *appConnQuery
+	snapshotConn  *appConnSnapshot
+	version       negotiatedVersion
 
-	clientCreator ClientCreator
+	clientCreator  ClientCreator
+	queryClients   int
+	mempoolClients int
+}
+
+// MultiAppConnOption sets an optional parameter on the multiAppConn.
+type MultiAppConnOption func(*multiAppConn)
+
+// QueryConnections sets the number of ABCI clients the query connection
+// sends requests over concurrently. The default is 1.
+func QueryConnections(n int) MultiAppConnOption {
+	return func(app *multiAppConn) { app.queryClients = n }
+}
+
+// MempoolConnections sets the number of ABCI clients the mempool connection
+// checks txs over. The default is 1.
+func MempoolConnections(n int) MultiAppConnOption {
+	return func(app *multiAppConn) { app.mempoolClients = n }
 }
 
 // Make all necessary abci connections to the application
-func NewMultiAppConn(clientCreator ClientCreator) *multiAppConn {
+func NewMultiAppConn(clientCreator ClientCreator, options ...MultiAppConnOption) *multiAppConn {
 	multiAppConn := &multiAppConn{
-		clientCreator: clientCreator,
+		version:        negotiatedVersion{version: NodeABCIVersion},
+		clientCreator:  clientCreator,
+		queryClients:   1,
+		mempoolClients: 1,
+	}
+	for _, option := range options {
+		option(multiAppConn)
 	}
 	multiAppConn.BaseService = *service.NewBaseService(nil, "multiAppConn", multiAppConn)
 	return multiAppConn
@@ -61,28 +95,45 @@ func (app *multiAppConn) Query() AppConnQuery {
 	return app.queryConn
 }
 
+// Returns the snapshot Connection
+func (app *multiAppConn) Snapshot() AppConnSnapshot {
+	return app.snapshotConn
+}
+
+func (app *multiAppConn) ABCIVersion() ABCIVersion {
+	return app.version.get()
+}
+
+func (app *multiAppConn) SetABCIVersion(v ABCIVersion) {
+	app.version.set(v)
+}
+
 func (app *multiAppConn) OnStart() error {
 	// query connection
-	querycli, err := app.clientCreator.NewABCIClient()
+	queryclis, err := app.startClients("query", app.queryClients)
+	if err != nil {
+		return err
+	}
+	app.queryConn = NewAppConnQueryPool(queryclis)
+
+	// snapshot connection
+	snapshotcli, err := app.clientCreator.NewABCIClient()
 	if err != nil {
-		return errors.Wrap(err, "Error creating ABCI client (query connection)")
+		return errors.Wrap(err, "Error creating ABCI client (snapshot connection)")
 	}
-	querycli.SetLogger(app.Logger.With("module", "abci-client", "connection", "query"))
-	if err := querycli.Start(); err != nil {
-		return errors.Wrap(err, "Error starting ABCI client (query connection)")
+	snapshotcli.SetLogger(app.Logger.With("module", "abci-client", "connection", "snapshot"))
+	if err := snapshotcli.Start(); err != nil {
+		return errors.Wrap(err, "Error starting ABCI client (snapshot connection)")
 	}
-	app.queryConn = NewAppConnQuery(querycli)
+	app.snapshotConn = NewAppConnSnapshot(snapshotcli)
+	app.snapshotConn.version = &app.version
 
 	// mempool connection
-	memcli, err := app.clientCreator.NewABCIClient()
+	memclis, err := app.startClients("mempool", app.mempoolClients)
 	if err != nil {
-		return errors.Wrap(err, "Error creating ABCI client (mempool connection)")
+		return err
 	}
-	memcli.SetLogger(app.Logger.With("module", "abci-client", "connection", "mempool"))
-	if err := memcli.Start(); err != nil {
-		return errors.Wrap(err, "Error starting ABCI client (mempool connection)")
-	}
-	app.mempoolConn = NewAppConnMempool(memcli)
+	app.mempoolConn = NewAppConnMempoolPool(memclis)
 
 	// consensus connection
 	concli, err := app.clientCreator.NewABCIClient()
@@ -94,6 +145,27 @@ func (app *multiAppConn) OnStart() error {
 		return errors.Wrap(err, "Error starting ABCI client (consensus connection)")
 	}
 	app.consensusConn = NewAppConnConsensus(concli)
+	app.consensusConn.version = &app.version
 
 	return nil
 }
+
+// startClients creates and starts n clients for a pooled connection.
+func (app *multiAppConn) startClients(conn string, n int) ([]abcicli.Client, error) {
+	if n < 1 {
+		n = 1
+	}
+	clis := make([]abcicli.Client, n)
+	for i := range clis {
+		cli, err := app.clientCreator.NewABCIClient()
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error creating ABCI client (%s connection)", conn)
+		}
+		cli.SetLogger(app.Logger.With("module", "abci-client", "connection", conn, "client", i))
+		if err := cli.Start(); err != nil {
+			return nil, errors.Wrapf(err, "Error starting ABCI client (%s connection)", conn)
+		}
+		clis[i] = cli
+	}
+	return clis, nil
+}
@@ -1,6 +1,11 @@
 package proxy
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/version"
 )
@@ -12,4 +17,137 @@ var RequestInfo = abci.RequestInfo{
 	Version:      version.Version,
 	BlockVersion: version.BlockProtocol.Uint64(),
 	P2PVersion:   version.P2PProtocol.Uint64(),
+	AbciVersion:  version.ABCIVersion,
+}
+
+// ABCIVersion is the semantic version of the ABCI protocol spoken by the node
+// or the app. Versions with the same major version are compatible: a minor
+// version only adds optional methods and fields.
+type ABCIVersion struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+}
+
+var (
+	// NodeABCIVersion is the ABCI version spoken by the node.
+	NodeABCIVersion = mustParseABCIVersion(version.ABCIVersion)
+
+	// LegacyABCIVersion is the oldest ABCI version the node works with. It's
+	// assumed for apps which don't report their ABCI version in Info.
+	LegacyABCIVersion = ABCIVersion{0, 16, 0}
+
+	// SnapshotsABCIVersion is the ABCI version which added the snapshot
+	// methods.
+	SnapshotsABCIVersion = ABCIVersion{0, 17, 0}
+
+	// VoteExtensionsABCIVersion is the ABCI version which added ExtendVote and
+	// VerifyVoteExtension.
+	VoteExtensionsABCIVersion = ABCIVersion{0, 17, 0}
+
+	// ProposalsABCIVersion is the ABCI version which added PrepareProposal and
+	// ProcessProposal.
+	ProposalsABCIVersion = ABCIVersion{0, 17, 0}
+)
+
+// ParseABCIVersion parses an ABCI version of the form "<major>.<minor>.<patch>".
+func ParseABCIVersion(s string) (ABCIVersion, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return ABCIVersion{}, fmt.Errorf("invalid ABCI version %q, expected <major>.<minor>.<patch>", s)
+	}
+	var nums [3]uint64
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return ABCIVersion{}, fmt.Errorf("invalid ABCI version %q: %v", s, err)
+		}
+		nums[i] = n
+	}
+	return ABCIVersion{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+func mustParseABCIVersion(s string) ABCIVersion {
+	v, err := ParseABCIVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the version as "<major>.<minor>.<patch>".
+func (v ABCIVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Less returns whether v is older than other.
+func (v ABCIVersion) Less(other ABCIVersion) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// NegotiateABCIVersion returns the ABCI version to use with an app which
+// reported the given version in Info (empty for apps which predate
+// negotiation): the older of the app's and the node's versions. It returns an
+// error if the app speaks an incompatible major version, or a version older
+// than minVersion or LegacyABCIVersion.
+func NegotiateABCIVersion(appVersion string, minVersion ABCIVersion) (ABCIVersion, error) {
+	appV := LegacyABCIVersion
+	if appVersion != "" {
+		var err error
+		appV, err = ParseABCIVersion(appVersion)
+		if err != nil {
+			return ABCIVersion{}, fmt.Errorf("app reported an %v", err)
+		}
+	}
+
+	if appV.Major != NodeABCIVersion.Major {
+		return ABCIVersion{}, fmt.Errorf("app speaks ABCI %v, which is incompatible with ABCI %v spoken by the node",
+			appV, NodeABCIVersion)
+	}
+	if minVersion.Less(LegacyABCIVersion) {
+		minVersion = LegacyABCIVersion
+	}
+	if appV.Less(minVersion) {
+		return ABCIVersion{}, fmt.Errorf("app speaks ABCI %v, older than the minimum ABCI %v", appV, minVersion)
+	}
+
+	if NodeABCIVersion.Less(appV) {
+		return NodeABCIVersion, nil
+	}
+	return appV, nil
+}
+
+// negotiatedVersion holds the ABCI version negotiated with the app, shared by
+// the connections to it. It is safe for concurrent use, and a nil
+// negotiatedVersion supports all methods.
+type negotiatedVersion struct {
+	mtx     sync.RWMutex
+	version ABCIVersion
+}
+
+func (nv *negotiatedVersion) get() ABCIVersion {
+	nv.mtx.RLock()
+	defer nv.mtx.RUnlock()
+	return nv.version
+}
+
+func (nv *negotiatedVersion) set(v ABCIVersion) {
+	nv.mtx.Lock()
+	defer nv.mtx.Unlock()
+	nv.version = v
+}
+
+// supports returns whether methods added in the given ABCI version can be
+// called.
+func (nv *negotiatedVersion) supports(added ABCIVersion) bool {
+	if nv == nil {
+		return true
+	}
+	return !nv.get().Less(added)
 }
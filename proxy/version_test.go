@@ -0,0 +1,124 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func TestParseABCIVersion(t *testing.T) {
+	v, err := ParseABCIVersion("0.17.2")
+	require.NoError(t, err)
+	assert.Equal(t, ABCIVersion{0, 17, 2}, v)
+	assert.Equal(t, "0.17.2", v.String())
+
+	for _, s := range []string{"", "0.17", "0.17.2.1", "0.x.2", "-1.17.2", "v0.17.2"} {
+		_, err := ParseABCIVersion(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestABCIVersionLess(t *testing.T) {
+	assert.True(t, ABCIVersion{0, 16, 9}.Less(ABCIVersion{0, 17, 0}))
+	assert.True(t, ABCIVersion{0, 17, 0}.Less(ABCIVersion{0, 17, 1}))
+	assert.True(t, ABCIVersion{0, 99, 0}.Less(ABCIVersion{1, 0, 0}))
+	assert.False(t, ABCIVersion{0, 17, 0}.Less(ABCIVersion{0, 17, 0}))
+	assert.False(t, ABCIVersion{0, 17, 1}.Less(ABCIVersion{0, 17, 0}))
+}
+
+func TestNegotiateABCIVersion(t *testing.T) {
+	newer := NodeABCIVersion
+	newer.Minor++
+
+	testCases := []struct {
+		appVersion string
+		minVersion ABCIVersion
+		expVersion ABCIVersion
+		expErr     bool
+	}{
+		{"", ABCIVersion{}, LegacyABCIVersion, false},
+		{"0.16.0", ABCIVersion{}, LegacyABCIVersion, false},
+		{NodeABCIVersion.String(), ABCIVersion{}, NodeABCIVersion, false},
+		{newer.String(), ABCIVersion{}, NodeABCIVersion, false},
+		{NodeABCIVersion.String(), NodeABCIVersion, NodeABCIVersion, false},
+		{"", NodeABCIVersion, ABCIVersion{}, true},
+		{"0.16.0", ABCIVersion{0, 16, 1}, ABCIVersion{}, true},
+		{"0.15.9", ABCIVersion{}, ABCIVersion{}, true},
+		{"1.0.0", ABCIVersion{}, ABCIVersion{}, true},
+		{"0.17", ABCIVersion{}, ABCIVersion{}, true},
+	}
+	for _, tc := range testCases {
+		v, err := NegotiateABCIVersion(tc.appVersion, tc.minVersion)
+		if tc.expErr {
+			assert.Error(t, err, "%q (min %v)", tc.appVersion, tc.minVersion)
+			continue
+		}
+		if assert.NoError(t, err, "%q (min %v)", tc.appVersion, tc.minVersion) {
+			assert.Equal(t, tc.expVersion, v, "%q (min %v)", tc.appVersion, tc.minVersion)
+		}
+	}
+}
+
+// panics on the methods added after the legacy ABCI version
+type legacyApp struct {
+	types.BaseApplication
+}
+
+func (legacyApp) ExtendVote(types.RequestExtendVote) types.ResponseExtendVote {
+	panic("unexpected ExtendVote")
+}
+
+func (legacyApp) PrepareProposal(types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	panic("unexpected PrepareProposal")
+}
+
+func (legacyApp) ListSnapshots(types.RequestListSnapshots) types.ResponseListSnapshots {
+	panic("unexpected ListSnapshots")
+}
+
+func (legacyApp) OfferSnapshot(types.RequestOfferSnapshot) types.ResponseOfferSnapshot {
+	panic("unexpected OfferSnapshot")
+}
+
+func TestAppConnsLegacyABCIVersion(t *testing.T) {
+	proxyApp := NewAppConns(NewLocalClientCreator(legacyApp{}))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop()
+
+	assert.Equal(t, NodeABCIVersion, proxyApp.ABCIVersion())
+	proxyApp.SetABCIVersion(LegacyABCIVersion)
+	assert.Equal(t, LegacyABCIVersion, proxyApp.ABCIVersion())
+
+	// the methods the app doesn't implement aren't called
+	resExtend, err := proxyApp.Consensus().ExtendVoteSync(types.RequestExtendVote{})
+	require.NoError(t, err)
+	assert.Empty(t, resExtend.VoteExtension)
+
+	resVerify, err := proxyApp.Consensus().VerifyVoteExtensionSync(types.RequestVerifyVoteExtension{})
+	require.NoError(t, err)
+	assert.Equal(t, types.ResponseVerifyVoteExtension_ACCEPT, resVerify.Status)
+
+	txs := [][]byte{[]byte("a"), []byte("b")}
+	resPrepare, err := proxyApp.Consensus().PrepareProposalSync(types.RequestPrepareProposal{Txs: txs})
+	require.NoError(t, err)
+	assert.Equal(t, txs, resPrepare.Txs)
+
+	resProcess, err := proxyApp.Consensus().ProcessProposalSync(types.RequestProcessProposal{})
+	require.NoError(t, err)
+	assert.Equal(t, types.ResponseProcessProposal_ACCEPT, resProcess.Status)
+
+	resList, err := proxyApp.Snapshot().ListSnapshotsSync(types.RequestListSnapshots{})
+	require.NoError(t, err)
+	assert.Empty(t, resList.Snapshots)
+
+	resOffer, err := proxyApp.Snapshot().OfferSnapshotSync(types.RequestOfferSnapshot{})
+	require.NoError(t, err)
+	assert.Equal(t, types.ResponseOfferSnapshot_ABORT, resOffer.Result)
+
+	// the methods the app does implement still are
+	_, err = proxyApp.Consensus().CommitSync()
+	assert.NoError(t, err)
+}
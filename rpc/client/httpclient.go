@@ -348,19 +348,38 @@ func (c *baseRPCClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return result, nil
 }
 
-func (c *baseRPCClient) TxSearch(query string, prove bool, page, perPage int, orderBy string) (
+func (c *baseRPCClient) TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string,
+	countTotal bool) (
 	*ctypes.ResultTxSearch, error) {
 	result := new(ctypes.ResultTxSearch)
+	params := map[string]interface{}{
+		"query":       query,
+		"prove":       prove,
+		"page":        page,
+		"per_page":    perPage,
+		"order_by":    orderBy,
+		"cursor":      cursor,
+		"count_total": countTotal,
+	}
+	_, err := c.caller.Call("tx_search", params, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "TxSearch")
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) BlockSearch(query string, page, perPage int, orderBy string) (
+	*ctypes.ResultBlockSearch, error) {
+	result := new(ctypes.ResultBlockSearch)
 	params := map[string]interface{}{
 		"query":    query,
-		"prove":    prove,
 		"page":     page,
 		"per_page": perPage,
 		"order_by": orderBy,
 	}
-	_, err := c.caller.Call("tx_search", params, result)
+	_, err := c.caller.Call("block_search", params, result)
 	if err != nil {
-		return nil, errors.Wrap(err, "TxSearch")
+		return nil, errors.Wrap(err, "BlockSearch")
 	}
 	return result, nil
 }
@@ -132,6 +132,18 @@ func (c *Local) DialPeers(peers []string, persistent bool) (*ctypes.ResultDialPe
 	return core.UnsafeDialPeers(c.ctx, peers, persistent)
 }
 
+func (c *Local) BanPeer(peer string, duration string) (*ctypes.ResultBanPeer, error) {
+	return core.UnsafeBanPeer(c.ctx, peer, duration)
+}
+
+func (c *Local) UnbanPeer(peer string) (*ctypes.ResultUnbanPeer, error) {
+	return core.UnsafeUnbanPeer(c.ctx, peer)
+}
+
+func (c *Local) ListBans() (*ctypes.ResultListBans, error) {
+	return core.UnsafeListBans(c.ctx)
+}
+
 func (c *Local) BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return core.BlockchainInfo(c.ctx, minHeight, maxHeight)
 }
@@ -160,9 +172,14 @@ func (c *Local) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return core.Tx(c.ctx, hash, prove)
 }
 
-func (c *Local) TxSearch(query string, prove bool, page, perPage int, orderBy string) (
+func (c *Local) TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string, countTotal bool) (
 	*ctypes.ResultTxSearch, error) {
-	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
+	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy, cursor, countTotal)
+}
+
+func (c *Local) BlockSearch(query string, page, perPage int, orderBy string) (
+	*ctypes.ResultBlockSearch, error) {
+	return core.BlockSearch(c.ctx, query, page, perPage, orderBy)
 }
 
 func (c *Local) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
@@ -138,6 +138,18 @@ func (c Client) DialPeers(peers []string, persistent bool) (*ctypes.ResultDialPe
 	return core.UnsafeDialPeers(&rpctypes.Context{}, peers, persistent)
 }
 
+func (c Client) BanPeer(peer string, duration string) (*ctypes.ResultBanPeer, error) {
+	return core.UnsafeBanPeer(&rpctypes.Context{}, peer, duration)
+}
+
+func (c Client) UnbanPeer(peer string) (*ctypes.ResultUnbanPeer, error) {
+	return core.UnsafeUnbanPeer(&rpctypes.Context{}, peer)
+}
+
+func (c Client) ListBans() (*ctypes.ResultListBans, error) {
+	return core.UnsafeListBans(&rpctypes.Context{})
+}
+
 func (c Client) BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return core.BlockchainInfo(&rpctypes.Context{}, minHeight, maxHeight)
 }
@@ -413,6 +413,36 @@ func TestTx(t *testing.T) {
 	}
 }
 
+func TestBlockSearch(t *testing.T) {
+	c := getHTTPClient()
+	_, _, tx := MakeTxKV()
+	bres, err := c.BroadcastTxCommit(tx)
+	require.Nil(t, err)
+
+	for i, c := range GetClients() {
+		t.Logf("client %d", i)
+
+		// every block is indexed by height
+		result, err := c.BlockSearch(fmt.Sprintf("block.height=%d", bres.Height), 1, 30, "asc")
+		require.Nil(t, err)
+		assert.Equal(t, []int64{bres.Height}, result.Heights)
+		assert.Equal(t, 1, result.TotalCount)
+
+		// check sorting
+		result, err = c.BlockSearch("block.height >= 1", 1, 100, "desc")
+		require.Nil(t, err)
+		require.NotEmpty(t, result.Heights)
+		for k := 0; k < len(result.Heights)-1; k++ {
+			require.Greater(t, result.Heights[k], result.Heights[k+1])
+		}
+
+		// query a non existing block
+		result, err = c.BlockSearch("block.height < 0", 1, 30, "asc")
+		require.Nil(t, err)
+		require.Len(t, result.Heights, 0)
+	}
+}
+
 func TestTxSearch(t *testing.T) {
 	// first we broadcast a tx
 	c := getHTTPClient()
@@ -430,7 +460,7 @@ func TestTxSearch(t *testing.T) {
 
 		// now we query for the tx.
 		// since there's only one tx, we know index=0.
-		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", txHash), true, 1, 30, "asc")
+		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", txHash), true, 1, 30, "asc", "", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 1)
 
@@ -448,31 +478,31 @@ func TestTxSearch(t *testing.T) {
 		}
 
 		// query by height
-		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", txHeight), true, 1, 30, "asc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", txHeight), true, 1, 30, "asc", "", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 1)
 
 		// query for non existing tx
-		result, err = c.TxSearch(fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, 1, 30, "asc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, 1, 30, "asc", "", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 0)
 
 		// query using a compositeKey (see kvstore application)
-		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "asc")
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "asc", "", false)
 		require.Nil(t, err)
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
 
 		// query using a compositeKey (see kvstore application) and height
-		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, 1, 30, "asc")
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, 1, 30, "asc", "", false)
 		require.Nil(t, err)
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
 
 		// query a non existing tx with page 1 and txsPerPage 1
-		result, err = c.TxSearch("app.creator='Cosmoshi Neetowoko'", true, 1, 1, "asc")
+		result, err = c.TxSearch("app.creator='Cosmoshi Neetowoko'", true, 1, 1, "asc", "", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 0)
 
@@ -482,19 +512,43 @@ func TestTxSearch(t *testing.T) {
 		require.Nil(t, err)
 
 		// chech sorting
-		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "asc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "asc", "", false)
 		require.Nil(t, err)
 		for k := 0; k < len(result.Txs)-1; k++ {
 			require.LessOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
 			require.LessOrEqual(t, result.Txs[k].Index, result.Txs[k+1].Index)
 		}
 
-		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "desc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "desc", "", false)
 		require.Nil(t, err)
 		for k := 0; k < len(result.Txs)-1; k++ {
 			require.GreaterOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
 			require.GreaterOrEqual(t, result.Txs[k].Index, result.Txs[k+1].Index)
 		}
+
+		// page through all txs using the cursor
+		all, err := c.TxSearch("tx.height >= 1", false, 1, 100, "desc", "", false)
+		require.Nil(t, err)
+		require.True(t, len(all.Txs) >= 2)
+		var (
+			cursor string
+			paged  []*ctypes.ResultTx
+		)
+		for {
+			result, err = c.TxSearch("tx.height >= 1", false, 0, 1, "desc", cursor, false)
+			require.Nil(t, err)
+			require.Len(t, result.Txs, 1)
+			if cursor != "" {
+				// the search stops at the end of pages requested with a cursor
+				assert.Equal(t, -1, result.TotalCount)
+			}
+			paged = append(paged, result.Txs[0])
+			if result.NextCursor == "" || len(paged) == len(all.Txs) {
+				break
+			}
+			cursor = result.NextCursor
+		}
+		assert.Equal(t, all.Txs, paged)
 	}
 }
 
@@ -3,21 +3,31 @@ package core
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	tmmath "github.com/tendermint/tendermint/libs/math"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
 
 // BlockchainInfo gets block headers for minHeight <= height <= maxHeight.
-// Block headers are returned in descending order (highest first).
+// Block headers are returned in descending order (highest first). Heights below
+// the block store base (i.e. pruned blocks) are not returned.
 // More: https://docs.tendermint.com/master/rpc/#/Info/blockchain
 func BlockchainInfo(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	// maximum 20 block metas
 	const limit int64 = 20
 	var err error
-	minHeight, maxHeight, err = filterMinMax(blockStore.Height(), minHeight, maxHeight, limit)
+	minHeight, maxHeight, err = filterMinMax(
+		blockStore.Base(),
+		blockStore.Height(),
+		minHeight,
+		maxHeight,
+		limit)
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +47,8 @@ func BlockchainInfo(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.
 // error if either min or max are negative or min < max
 // if 0, use 1 for min, latest block height for max
 // enforce limit.
-// error if min > max
-func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
+// min is raised to the block store base, if any.
+func filterMinMax(base, height, min, max, limit int64) (int64, int64, error) {
 	// filter negatives
 	if min < 0 || max < 0 {
 		return min, max, fmt.Errorf("heights must be non-negative")
@@ -55,6 +65,9 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 	// limit max to the height
 	max = tmmath.MinInt64(height, max)
 
+	// limit min to the base
+	min = tmmath.MaxInt64(base, min)
+
 	// limit min to within `limit` of max
 	// so the total number of blocks returned will be `limit`
 	min = tmmath.MaxInt64(min, max-limit+1)
@@ -70,7 +83,7 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 // More: https://docs.tendermint.com/master/rpc/#/Info/block
 func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error) {
 	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), storeHeight, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +113,7 @@ func BlockByHash(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultBlock, error
 // More: https://docs.tendermint.com/master/rpc/#/Info/commit
 func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
 	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), storeHeight, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +128,13 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 	// use a non-canonical commit
 	if height == storeHeight {
 		commit := blockStore.LoadSeenCommit(height)
+		// Aggregate its signatures, as in the canonical commits. The commit is
+		// returned as is if the validators which signed it are not known.
+		if vals, err := sm.LoadValidators(stateDB, height); err == nil {
+			if aggCommit, err := types.AggregateCommit(commit, vals); err == nil {
+				commit = aggCommit
+			}
+		}
 		return ctypes.NewResultCommit(&header, commit, false), nil
 	}
 
@@ -132,7 +152,7 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 // More: https://docs.tendermint.com/master/rpc/#/Info/block_results
 func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockResults, error) {
 	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), storeHeight, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +172,51 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 	}, nil
 }
 
-func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
+// BlockSearch searches for blocks by their BeginBlock and EndBlock events. It
+// returns a list of block heights (maximum ?per_page entries) and the total
+// count.
+// More: https://docs.tendermint.com/master/rpc/#/Info/block_search
+func BlockSearch(ctx *rpctypes.Context, query string, page, perPage int, orderBy string) (
+	*ctypes.ResultBlockSearch, error) {
+	// if index is disabled, return error
+	if _, ok := blockIndexer.(*null.BlockIndex); ok {
+		return nil, errors.New("block indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := blockIndexer.Search(q)
+	if err != nil {
+		return nil, err
+	}
+
+	// results are in ascending order
+	switch orderBy {
+	case "desc":
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
+	case "asc", "":
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	totalCount := len(results)
+	perPage = validatePerPage(perPage)
+	page, err = validatePage(page, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+	skipCount := validateSkipCount(page, perPage)
+
+	heights := results[skipCount : skipCount+tmmath.MinInt(perPage, totalCount-skipCount)]
+	return &ctypes.ResultBlockSearch{Heights: heights, TotalCount: totalCount}, nil
+}
+
+func getHeight(currentBase int64, currentHeight int64, heightPtr *int64) (int64, error) {
 	if heightPtr != nil {
 		height := *heightPtr
 		if height <= 0 {
@@ -161,6 +225,10 @@ func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
 		if height > currentHeight {
 			return 0, fmt.Errorf("height must be less than or equal to the current blockchain height")
 		}
+		if height < currentBase {
+			return 0, fmt.Errorf("height %v is not available, blocks pruned at height %v",
+				height, currentBase)
+		}
 		return height, nil
 	}
 	return currentHeight, nil
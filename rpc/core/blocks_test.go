@@ -18,42 +18,49 @@ import (
 func TestBlockchainInfo(t *testing.T) {
 	cases := []struct {
 		min, max     int64
-		height       int64
+		base, height int64
 		limit        int64
 		resultLength int64
 		wantErr      bool
 	}{
 
 		// min > max
-		{0, 0, 0, 10, 0, true},  // min set to 1
-		{0, 1, 0, 10, 0, true},  // max set to height (0)
-		{0, 0, 1, 10, 1, false}, // max set to height (1)
-		{2, 0, 1, 10, 0, true},  // max set to height (1)
-		{2, 1, 5, 10, 0, true},
+		{0, 0, 0, 0, 10, 0, true},  // min set to 1
+		{0, 1, 0, 0, 10, 0, true},  // max set to height (0)
+		{0, 0, 1, 1, 10, 1, false}, // max set to height (1)
+		{2, 0, 1, 1, 10, 0, true},  // max set to height (1)
+		{2, 1, 1, 5, 10, 0, true},
 
 		// negative
-		{1, 10, 14, 10, 10, false}, // control
-		{-1, 10, 14, 10, 0, true},
-		{1, -10, 14, 10, 0, true},
-		{-9223372036854775808, -9223372036854775788, 100, 20, 0, true},
+		{1, 10, 1, 14, 10, 10, false}, // control
+		{-1, 10, 1, 14, 10, 0, true},
+		{1, -10, 1, 14, 10, 0, true},
+		{-9223372036854775808, -9223372036854775788, 1, 100, 20, 0, true},
+
+		// check base
+		{1, 1, 2, 5, 10, 0, true},
+		{0, 0, 3, 10, 10, 8, false},
+		{1, 5, 3, 10, 10, 3, false},
+		{4, 5, 3, 10, 10, 2, false},
+		{0, 0, 10, 10, 10, 1, false},
 
 		// check limit and height
-		{1, 1, 1, 10, 1, false},
-		{1, 1, 5, 10, 1, false},
-		{2, 2, 5, 10, 1, false},
-		{1, 2, 5, 10, 2, false},
-		{1, 5, 1, 10, 1, false},
-		{1, 5, 10, 10, 5, false},
-		{1, 15, 10, 10, 10, false},
-		{1, 15, 15, 10, 10, false},
-		{1, 15, 15, 20, 15, false},
-		{1, 20, 15, 20, 15, false},
-		{1, 20, 20, 20, 20, false},
+		{1, 1, 1, 1, 10, 1, false},
+		{1, 1, 1, 5, 10, 1, false},
+		{2, 2, 1, 5, 10, 1, false},
+		{1, 2, 1, 5, 10, 2, false},
+		{1, 5, 1, 1, 10, 1, false},
+		{1, 5, 1, 10, 10, 5, false},
+		{1, 15, 1, 10, 10, 10, false},
+		{1, 15, 1, 15, 10, 10, false},
+		{1, 15, 1, 15, 20, 15, false},
+		{1, 20, 1, 15, 20, 15, false},
+		{1, 20, 1, 20, 20, 20, false},
 	}
 
 	for i, c := range cases {
 		caseString := fmt.Sprintf("test %d failed", i)
-		min, max, err := filterMinMax(c.height, c.min, c.max, c.limit)
+		min, max, err := filterMinMax(c.base, c.height, c.min, c.max, c.limit)
 		if c.wantErr {
 			require.Error(t, err, caseString)
 		} else {
@@ -111,6 +118,7 @@ type mockBlockStore struct {
 	height int64
 }
 
+func (mockBlockStore) Base() int64                                       { return 1 }
 func (store mockBlockStore) Height() int64                               { return store.height }
 func (mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta       { return nil }
 func (mockBlockStore) LoadBlock(height int64) *types.Block               { return nil }
@@ -120,3 +128,4 @@ func (mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { retur
 func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
 func (mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (mockBlockStore) PruneBlocks(height int64) (uint64, error) { return 0, nil }
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -25,6 +26,7 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
+			TrustScore:       p2pPeers.PeerTrustScore(peer.ID()),
 		})
 	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
@@ -68,6 +70,48 @@ func UnsafeDialPeers(ctx *rpctypes.Context, peers []string, persistent bool) (*c
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeBanPeer bans the given node ID, IP or CIDR for the given duration
+// (e.g. "24h"), or forever if it is empty, and disconnects the peers it
+// matches. Bans are saved, so they survive restarts.
+func UnsafeBanPeer(ctx *rpctypes.Context, peer string, duration string) (*ctypes.ResultBanPeer, error) {
+	if peer == "" {
+		return &ctypes.ResultBanPeer{}, errors.New("no peer provided")
+	}
+	var until time.Time
+	if duration != "" {
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return &ctypes.ResultBanPeer{}, errors.Wrap(err, "invalid duration")
+		}
+		if d <= 0 {
+			return &ctypes.ResultBanPeer{}, errors.New("duration must be positive")
+		}
+		until = time.Now().Add(d)
+	}
+	logger.Info("BanPeer", "peer", peer, "duration", duration)
+	if err := p2pPeers.Ban(peer, until); err != nil {
+		return &ctypes.ResultBanPeer{}, err
+	}
+	return &ctypes.ResultBanPeer{Log: fmt.Sprintf("Banned %s. See /list_bans for details", peer)}, nil
+}
+
+// UnsafeUnbanPeer lifts the ban of the given node ID, IP or CIDR.
+func UnsafeUnbanPeer(ctx *rpctypes.Context, peer string) (*ctypes.ResultUnbanPeer, error) {
+	if peer == "" {
+		return &ctypes.ResultUnbanPeer{}, errors.New("no peer provided")
+	}
+	logger.Info("UnbanPeer", "peer", peer)
+	if err := p2pPeers.BanList().Unban(peer); err != nil {
+		return &ctypes.ResultUnbanPeer{}, err
+	}
+	return &ctypes.ResultUnbanPeer{Log: fmt.Sprintf("Unbanned %s", peer)}, nil
+}
+
+// UnsafeListBans returns the banned node IDs, IPs and CIDRs.
+func UnsafeListBans(ctx *rpctypes.Context) (*ctypes.ResultListBans, error) {
+	return &ctypes.ResultListBans{Bans: p2pPeers.BanList().Bans()}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.tendermint.com/master/rpc/#/Info/genesis
 func Genesis(ctx *rpctypes.Context) (*ctypes.ResultGenesis, error) {
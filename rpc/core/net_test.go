@@ -71,3 +71,53 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+func TestUnsafeBanPeer(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123",
+		func(n int, sw *p2p.Switch) *p2p.Switch { return sw })
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	logger = log.TestingLogger()
+	p2pPeers = sw
+
+	testCases := []struct {
+		peer     string
+		duration string
+		isErr    bool
+	}{
+		{"", "", true},
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", "", false},
+		{"10.0.0.0/8", "1h", false},
+		{"127.0.0.1", "1h", false},
+		{"127.0.0.1:41198", "", true},
+		{"127.0.0.1", "forever", true},
+		{"127.0.0.1", "-1h", true},
+	}
+
+	for _, tc := range testCases {
+		res, err := UnsafeBanPeer(&rpctypes.Context{}, tc.peer, tc.duration)
+		if tc.isErr {
+			assert.Error(t, err, tc.peer)
+		} else {
+			assert.NoError(t, err, tc.peer)
+			assert.NotNil(t, res)
+		}
+	}
+
+	bans, err := UnsafeListBans(&rpctypes.Context{})
+	require.NoError(t, err)
+	require.Len(t, bans.Bans, 3)
+	assert.Equal(t, "10.0.0.0/8", bans.Bans[0].Target)
+	assert.True(t, bans.Bans[2].Until.IsZero())
+
+	_, err = UnsafeUnbanPeer(&rpctypes.Context{}, "10.0.0.0/8")
+	assert.NoError(t, err)
+	_, err = UnsafeUnbanPeer(&rpctypes.Context{}, "10.0.0.0/8")
+	assert.Error(t, err)
+
+	bans, err = UnsafeListBans(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Len(t, bans.Bans, 2)
+}
@@ -48,6 +48,9 @@ type peers interface {
 	AddPersistentPeers([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	PeerTrustScore(p2p.ID) int
+	Ban(target string, until time.Time) error
+	BanList() *p2p.BanList
 }
 
 //----------------------------------------------
@@ -70,6 +73,7 @@ var (
 	pubKey           crypto.PubKey
 	genDoc           *types.GenesisDoc // cache the genesis structure
 	txIndexer        txindex.TxIndexer
+	blockIndexer     txindex.BlockIndexer
 	consensusReactor *consensus.Reactor
 	eventBus         *types.EventBus // thread safe
 	mempool          mempl.Mempool
@@ -123,6 +127,10 @@ func SetTxIndexer(indexer txindex.TxIndexer) {
 	txIndexer = indexer
 }
 
+func SetBlockIndexer(indexer txindex.BlockIndexer) {
+	blockIndexer = indexer
+}
+
 func SetConsensusReactor(conR *consensus.Reactor) {
 	consensusReactor = conR
 }
@@ -13,9 +13,24 @@ import (
 )
 
 // Status returns Tendermint status including node info, pubkey, latest block
-// hash, app hash, block height and time.
+// hash, app hash, block height and time, and the earliest block still available
+// in the block store.
 // More: https://docs.tendermint.com/master/rpc/#/Info/status
 func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
+	var (
+		earliestBlockMeta     *types.BlockMeta
+		earliestBlockHash     tmbytes.HexBytes
+		earliestAppHash       tmbytes.HexBytes
+		earliestBlockTimeNano int64
+	)
+	earliestBlockHeight := blockStore.Base()
+	if earliestBlockHeight != 0 {
+		earliestBlockMeta = blockStore.LoadBlockMeta(earliestBlockHeight)
+		earliestBlockHash = earliestBlockMeta.BlockID.Hash
+		earliestAppHash = earliestBlockMeta.Header.AppHash
+		earliestBlockTimeNano = earliestBlockMeta.Header.Time.UnixNano()
+	}
+
 	var latestHeight int64
 	if consensusReactor.FastSync() {
 		latestHeight = blockStore.Height()
@@ -50,7 +65,13 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			LatestAppHash:     latestAppHash,
 			LatestBlockHeight: latestHeight,
 			LatestBlockTime:   latestBlockTime,
-			CatchingUp:        consensusReactor.FastSync(),
+
+			EarliestBlockHash:   earliestBlockHash,
+			EarliestAppHash:     earliestAppHash,
+			EarliestBlockHeight: earliestBlockHeight,
+			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
+
+			CatchingUp: consensusReactor.FastSync(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     pubKey.Address(),
@@ -6,10 +6,10 @@ import (
 
 	"github.com/pkg/errors"
 
-	tmmath "github.com/tendermint/tendermint/libs/math"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
@@ -53,10 +53,16 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 }
 
 // TxSearch allows you to query for multiple transactions results. It returns a
-// list of transactions (maximum ?per_page entries) and the total count.
+// list of transactions (maximum ?per_page entries), and the total count if
+// ?count_total is set.
+//
+// Results are ordered by height and index. Pages can be selected either by
+// number (?page), or by passing the next_cursor of the previous page as ?cursor,
+// in which case ?page is ignored. Unless the total count is requested, the
+// search stops at the end of the page and the total count is -1.
 // More: https://docs.tendermint.com/master/rpc/#/Info/tx_search
-func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int, orderBy string) (
-	*ctypes.ResultTxSearch, error) {
+func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int, orderBy, cursor string,
+	countTotal bool) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := txIndexer.(*null.TxIndex); ok {
 		return nil, errors.New("transaction indexing is disabled")
@@ -67,24 +73,51 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int
 		return nil, err
 	}
 
-	results, err := txIndexer.Search(q)
-	if err != nil {
-		return nil, err
+	var orderDesc bool
+	switch orderBy {
+	case "desc":
+		orderDesc = true
+	case "asc", "":
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
 	}
 
-	totalCount := len(results)
 	perPage = validatePerPage(perPage)
-	page, err = validatePage(page, perPage, totalCount)
+	opts := txindex.PageOptions{OrderDesc: orderDesc, Limit: perPage, CountTotal: countTotal}
+	if cursor != "" {
+		after, err := txindex.ParseCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		opts.After = &after
+	} else {
+		opts.Skip = validateSkipCount(page, perPage)
+	}
+
+	var results *txindex.Page
+	if pagedIndexer, ok := txIndexer.(txindex.PagedTxIndexer); ok {
+		results, err = pagedIndexer.SearchPage(q, opts)
+	} else {
+		results, err = searchPage(q, opts)
+	}
 	if err != nil {
 		return nil, err
 	}
-	skipCount := validateSkipCount(page, perPage)
 
-	apiResults := make([]*ctypes.ResultTx, tmmath.MinInt(perPage, totalCount-skipCount))
+	switch {
+	case cursor != "":
+	case countTotal:
+		if _, err := validatePage(page, perPage, results.TotalCount); err != nil {
+			return nil, err
+		}
+	case page > 1 && len(results.Txs) == 0:
+		// without the total count, pages past the end are only known to be empty
+		return nil, fmt.Errorf("page %d is past the last page", page)
+	}
+
+	apiResults := make([]*ctypes.ResultTx, len(results.Txs))
 	var proof types.TxProof
-	// if there's no tx in the results array, we don't need to loop through the apiResults array
-	for i := 0; i < len(apiResults); i++ {
-		r := results[skipCount+i]
+	for i, r := range results.Txs {
 		height := r.Height
 		index := r.Index
 
@@ -103,26 +136,50 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int
 		}
 	}
 
-	if len(apiResults) > 1 {
-		switch orderBy {
-		case "desc":
-			sort.Slice(apiResults, func(i, j int) bool {
-				if apiResults[i].Height == apiResults[j].Height {
-					return apiResults[i].Index > apiResults[j].Index
-				}
-				return apiResults[i].Height > apiResults[j].Height
-			})
-		case "asc", "":
-			sort.Slice(apiResults, func(i, j int) bool {
-				if apiResults[i].Height == apiResults[j].Height {
-					return apiResults[i].Index < apiResults[j].Index
-				}
-				return apiResults[i].Height < apiResults[j].Height
-			})
-		default:
-			return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
-		}
+	var nextCursor string
+	if results.Next != nil {
+		nextCursor = results.Next.String()
+	}
+	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: results.TotalCount, NextCursor: nextCursor}, nil
+}
+
+// searchPage pages through the results of indexers which don't implement
+// txindex.PagedTxIndexer, by loading and sorting all of them.
+func searchPage(q *tmquery.Query, opts txindex.PageOptions) (*txindex.Page, error) {
+	results, err := txIndexer.Search(q)
+	if err != nil {
+		return nil, err
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	sort.Slice(results, func(i, j int) bool {
+		if opts.OrderDesc {
+			return txindex.CursorFor(results[j]).Before(txindex.CursorFor(results[i]))
+		}
+		return txindex.CursorFor(results[i]).Before(txindex.CursorFor(results[j]))
+	})
+
+	page := &txindex.Page{Txs: make([]*types.TxResult, 0), TotalCount: len(results)}
+	if !opts.CountTotal {
+		page.TotalCount = -1
+	}
+	skipped := 0
+	for _, r := range results {
+		pos := txindex.CursorFor(r)
+		if opts.After != nil {
+			if opts.OrderDesc && !pos.Before(*opts.After) || !opts.OrderDesc && !opts.After.Before(pos) {
+				continue
+			}
+		}
+		if skipped < opts.Skip {
+			skipped++
+			continue
+		}
+		if len(page.Txs) >= opts.Limit {
+			next := txindex.CursorFor(page.Txs[len(page.Txs)-1])
+			page.Next = &next
+			break
+		}
+		page.Txs = append(page.Txs, r)
+	}
+	return page, nil
 }
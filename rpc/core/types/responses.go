@@ -65,7 +65,13 @@ type SyncInfo struct {
 	LatestAppHash     bytes.HexBytes `json:"latest_app_hash"`
 	LatestBlockHeight int64          `json:"latest_block_height"`
 	LatestBlockTime   time.Time      `json:"latest_block_time"`
-	CatchingUp        bool           `json:"catching_up"`
+
+	EarliestBlockHash   bytes.HexBytes `json:"earliest_block_hash"`
+	EarliestAppHash     bytes.HexBytes `json:"earliest_app_hash"`
+	EarliestBlockHeight int64          `json:"earliest_block_height"`
+	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
+
+	CatchingUp bool `json:"catching_up"`
 }
 
 // Info about the node's validator
@@ -108,12 +114,28 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Log from banning a peer
+type ResultBanPeer struct {
+	Log string `json:"log"`
+}
+
+// Log from unbanning a peer
+type ResultUnbanPeer struct {
+	Log string `json:"log"`
+}
+
+// Banned node IDs, IPs and CIDRs
+type ResultListBans struct {
+	Bans []p2p.Ban `json:"bans"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	TrustScore       int                  `json:"trust_score"`
 }
 
 // Validators for a height
@@ -148,9 +170,10 @@ type ResultConsensusState struct {
 
 // CheckTx result
 type ResultBroadcastTx struct {
-	Code uint32         `json:"code"`
-	Data bytes.HexBytes `json:"data"`
-	Log  string         `json:"log"`
+	Code         uint32         `json:"code"`
+	Data         bytes.HexBytes `json:"data"`
+	Log          string         `json:"log"`
+	MempoolError string         `json:"mempool_error,omitempty"`
 
 	Hash bytes.HexBytes `json:"hash"`
 }
@@ -177,6 +200,15 @@ type ResultTx struct {
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	// NextCursor is passed as cursor to get the next page of results. It is
+	// empty if there are no more results.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Result of searching for blocks
+type ResultBlockSearch struct {
+	Heights    []int64 `json:"heights"`
+	TotalCount int     `json:"total_count"`
 }
 
 // List of mempool txs
@@ -87,25 +87,140 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
-// The rest is given to txs, up to the max gas.
+// The rest, less the vote extensions in the commit, is given to txs, up to the
+// max gas. The txs reaped from the mempool are passed to the app, which
+// returns the txs of the block in PrepareProposal. The signatures of the
+// validators with BLS12-381 keys are aggregated in the block's commit.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
 	proposerAddr []byte,
-) (*types.Block, *types.PartSet) {
+) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	maxGas := state.ConsensusParams.Block.MaxGas
 
 	// Fetch a limited amount of valid evidence
-	maxNumEvidence, _ := types.MaxEvidencePerBlock(maxBytes)
+	maxNumEvidence, _ := types.MaxEvidencePerBlock(maxBytes, state.ConsensusParams.Validator)
 	evidence := blockExec.evpool.PendingEvidence(maxNumEvidence)
 
 	// Fetch a limited amount of valid txs
-	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
+	maxDataBytes := types.MaxDataBytes(maxBytes, state.ConsensusParams.Validator, state.Validators.Size(), len(evidence))
+	maxDataBytes -= commit.ExtensionsSize()
+	if maxDataBytes < 0 {
+		maxDataBytes = 0
+	}
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	// Let the app reorder, drop or add txs
+	localLastCommit := abci.LastCommitInfo{Round: int32(commit.Round)}
+	if height > 1 {
+		localLastCommit = getLastCommitInfo(commit, state.LastValidators)
+	}
+	txBytes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		txBytes[i] = tx
+	}
+	res, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+		Height:          height,
+		ProposerAddress: proposerAddr,
+		MaxTxBytes:      maxDataBytes,
+		Txs:             txBytes,
+		LocalLastCommit: localLastCommit,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs = make([]types.Tx, len(res.Txs))
+	totalBytes := int64(0)
+	for i, tx := range res.Txs {
+		txs[i] = tx
+		totalBytes += int64(len(tx)) + types.ComputeAminoOverhead(tx, 1)
+	}
+	if totalBytes > maxDataBytes {
+		return nil, nil, fmt.Errorf("txs returned by the app are too big: %d bytes (max: %d)",
+			totalBytes, maxDataBytes)
+	}
+
+	if height > 1 {
+		commit, err = types.AggregateCommit(commit, state.LastValidators)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	block, parts := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	return block, parts, nil
+}
+
+// ProcessProposal asks the app whether a proposed block, which passed
+// ValidateBlock, is acceptable. If the app rejects it, it returns
+// ErrProposalRejected.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block) error {
+	commitInfo, byzVals := getBeginBlockValidatorInfo(block, blockExec.db)
+	txs := make([][]byte, len(block.Txs))
+	for i, tx := range block.Txs {
+		txs[i] = tx
+	}
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Hash:                block.Hash(),
+		Header:              types.TM2PB.Header(&block.Header),
+		Txs:                 txs,
+		ProposedLastCommit:  commitInfo,
+		ByzantineValidators: byzVals,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Status != abci.ResponseProcessProposal_ACCEPT {
+		return ErrProposalRejected
+	}
+	return nil
+}
+
+// ExtendVote asks the app for the extension of our precommit for a block, and
+// sets it on the vote. Extensions bigger than maxBytes are rejected (see
+// types.MaxVoteExtensionBytes).
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote, maxBytes int64) error {
+	res, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+		Round:  int32(vote.Round),
+	})
+	if err != nil {
+		return err
+	}
+	if int64(len(res.VoteExtension)) > maxBytes {
+		return fmt.Errorf("vote extension is too big: %d bytes (max: %d)",
+			len(res.VoteExtension), maxBytes)
+	}
+	vote.Extension = res.VoteExtension
+	return nil
+}
+
+// VerifyVoteExtension asks the app whether the extension of another
+// validator's precommit for a block is valid. Extensions bigger than maxBytes
+// are rejected without asking the app (see types.MaxVoteExtensionBytes).
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote, maxBytes int64) error {
+	if int64(len(vote.Extension)) > maxBytes {
+		return fmt.Errorf("vote extension is too big: %d bytes (max: %d)",
+			len(vote.Extension), maxBytes)
+	}
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		Round:            int32(vote.Round),
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Status != abci.ResponseVerifyVoteExtension_ACCEPT {
+		return types.ErrVoteInvalidExtension
+	}
+	return nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -121,10 +236,14 @@ func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) e
 // It's the only function that needs to be called
 // from outside this package to process and commit an entire block.
 // It takes a blockID to avoid recomputing the parts hash.
-func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, block *types.Block) (State, error) {
+// It also returns the retain height requested by the app in ResponseCommit, below
+// which blocks and states may be pruned (0 means no pruning).
+func (blockExec *BlockExecutor) ApplyBlock(
+	state State, blockID types.BlockID, block *types.Block,
+) (State, int64, error) {
 
 	if err := blockExec.ValidateBlock(state, block); err != nil {
-		return state, ErrInvalidBlock(err)
+		return state, 0, ErrInvalidBlock(err)
 	}
 
 	startTime := time.Now().UnixNano()
@@ -132,7 +251,7 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	endTime := time.Now().UnixNano()
 	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
 	if err != nil {
-		return state, ErrProxyAppConn(err)
+		return state, 0, ErrProxyAppConn(err)
 	}
 
 	fail.Fail() // XXX
@@ -146,11 +265,11 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
 	err = validateValidatorUpdates(abciValUpdates, state.ConsensusParams.Validator)
 	if err != nil {
-		return state, fmt.Errorf("error in validator updates: %v", err)
+		return state, 0, fmt.Errorf("error in validator updates: %v", err)
 	}
 	validatorUpdates, err := types.PB2TM.ValidatorUpdates(abciValUpdates)
 	if err != nil {
-		return state, err
+		return state, 0, err
 	}
 	if len(validatorUpdates) > 0 {
 		blockExec.logger.Info("Updates to validators", "updates", types.ValidatorListString(validatorUpdates))
@@ -159,13 +278,13 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	// Update the state with the block and responses.
 	state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates)
 	if err != nil {
-		return state, fmt.Errorf("commit failed for application: %v", err)
+		return state, 0, fmt.Errorf("commit failed for application: %v", err)
 	}
 
 	// Lock mempool, commit app state, update mempoool.
-	appHash, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
+	appHash, retainHeight, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
 	if err != nil {
-		return state, fmt.Errorf("commit failed for application: %v", err)
+		return state, 0, fmt.Errorf("commit failed for application: %v", err)
 	}
 
 	// Update evpool with the block and state.
@@ -183,12 +302,12 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
 	fireEvents(blockExec.logger, blockExec.eventBus, block, abciResponses, validatorUpdates)
 
-	return state, nil
+	return state, retainHeight, nil
 }
 
 // Commit locks the mempool, runs the ABCI Commit message, and updates the
 // mempool.
-// It returns the result of calling abci.Commit (the AppHash), and an error.
+// It returns the result of calling abci.Commit (the AppHash) and the height to retain (if any).
 // The Mempool must be locked during commit and update because state is
 // typically reset on Commit and old txs must be replayed against committed
 // state before new txs are run in the mempool, lest they be invalid.
@@ -196,7 +315,7 @@ func (blockExec *BlockExecutor) Commit(
 	state State,
 	block *types.Block,
 	deliverTxResponses []*abci.ResponseDeliverTx,
-) ([]byte, error) {
+) ([]byte, int64, error) {
 	blockExec.mempool.Lock()
 	defer blockExec.mempool.Unlock()
 
@@ -205,7 +324,7 @@ func (blockExec *BlockExecutor) Commit(
 	err := blockExec.mempool.FlushAppConn()
 	if err != nil {
 		blockExec.logger.Error("Client error during mempool.FlushAppConn", "err", err)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Commit block, get hash back
@@ -215,7 +334,7 @@ func (blockExec *BlockExecutor) Commit(
 			"Client error during proxyAppConn.CommitSync",
 			"err", err,
 		)
-		return nil, err
+		return nil, 0, err
 	}
 	// ResponseCommit has no error code - just data
 
@@ -235,7 +354,7 @@ func (blockExec *BlockExecutor) Commit(
 		TxPostCheck(state),
 	)
 
-	return res.Data, err
+	return res.Data, res.RetainHeight, err
 }
 
 //---------------------------------------------------------
@@ -309,10 +428,13 @@ func execBlockOnProxyApp(
 }
 
 func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCommitInfo, []abci.Evidence) {
-	voteInfos := make([]abci.VoteInfo, block.LastCommit.Size())
 	// block.Height=1 -> LastCommitInfo.Votes are empty.
 	// Remember that the first LastCommit is intentionally empty, so it makes
 	// sense for LastCommitInfo.Votes to also be empty.
+	lastCommitInfo := abci.LastCommitInfo{
+		Round: int32(block.LastCommit.Round),
+		Votes: make([]abci.VoteInfo, block.LastCommit.Size()),
+	}
 	if block.Height > 1 {
 		lastValSet, err := LoadValidators(stateDB, block.Height-1)
 		if err != nil {
@@ -330,13 +452,7 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 				commitSize, valSetLen, block.Height, block.LastCommit.Signatures, lastValSet.Validators))
 		}
 
-		for i, val := range lastValSet.Validators {
-			commitSig := block.LastCommit.Signatures[i]
-			voteInfos[i] = abci.VoteInfo{
-				Validator:       types.TM2PB.Validator(val),
-				SignedLastBlock: !commitSig.Absent(),
-			}
-		}
+		lastCommitInfo = getLastCommitInfo(block.LastCommit, lastValSet)
 	}
 
 	byzVals := make([]abci.Evidence, len(block.Evidence.Evidence))
@@ -351,10 +467,25 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 		byzVals[i] = types.TM2PB.Evidence(ev, valset, block.Time)
 	}
 
+	return lastCommitInfo, byzVals
+}
+
+// getLastCommitInfo returns the votes of the given validators in a commit,
+// which must have a signature for each of them.
+func getLastCommitInfo(commit *types.Commit, valSet *types.ValidatorSet) abci.LastCommitInfo {
+	voteInfos := make([]abci.VoteInfo, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		commitSig := commit.Signatures[i]
+		voteInfos[i] = abci.VoteInfo{
+			Validator:       types.TM2PB.Validator(val),
+			SignedLastBlock: !commitSig.Absent(),
+			VoteExtension:   commitSig.Extension,
+		}
+	}
 	return abci.LastCommitInfo{
-		Round: int32(block.LastCommit.Round),
+		Round: int32(commit.Round),
 		Votes: voteInfos,
-	}, byzVals
+	}
 }
 
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
@@ -9,8 +10,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/mock"
 	"github.com/tendermint/tendermint/proxy"
@@ -41,7 +45,7 @@ func TestApplyBlock(t *testing.T) {
 	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
 
 	//nolint:ineffassign
-	state, err = blockExec.ApplyBlock(state, blockID, block)
+	state, _, err = blockExec.ApplyBlock(state, blockID, block)
 	require.Nil(t, err)
 
 	// TODO check state and mempool
@@ -74,6 +78,7 @@ func TestBeginBlockValidators(t *testing.T) {
 			now)
 		absentSig = types.NewCommitSigAbsent()
 	)
+	commitSig1.Extension = []byte("price=1")
 
 	testCases := []struct {
 		desc                     string
@@ -101,9 +106,12 @@ func TestBeginBlockValidators(t *testing.T) {
 				tc.expectedAbsentValidators[ctr] == i {
 
 				assert.False(t, v.SignedLastBlock)
+				assert.Empty(t, v.VoteExtension)
 				ctr++
 			} else {
 				assert.True(t, v.SignedLastBlock)
+				// and the extensions of their precommits
+				assert.Equal(t, tc.lastCommitSigs[i].Extension, v.VoteExtension)
 			}
 		}
 	}
@@ -168,6 +176,165 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 	}
 }
 
+// proposalApp reverses the txs it is given in PrepareProposal, dropping the
+// given tx and adding another one, and accepts or rejects proposals.
+type proposalApp struct {
+	abci.BaseApplication
+
+	dropTx, addTx []byte
+	accept        bool
+
+	prepareReq abci.RequestPrepareProposal
+	processReq abci.RequestProcessProposal
+}
+
+func (app *proposalApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.prepareReq = req
+	var txs [][]byte
+	for i := len(req.Txs) - 1; i >= 0; i-- {
+		if !bytes.Equal(req.Txs[i], app.dropTx) {
+			txs = append(txs, req.Txs[i])
+		}
+	}
+	return abci.ResponsePrepareProposal{Txs: append(txs, app.addTx)}
+}
+
+func (app *proposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	app.processReq = req
+	if app.accept {
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+}
+
+// txsMempool reaps the given txs.
+type txsMempool struct {
+	mock.Mempool
+	txs types.Txs
+}
+
+func (mem txsMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return mem.txs }
+
+func TestCreateProposalBlockPrepareProposal(t *testing.T) {
+	app := &proposalApp{dropTx: []byte("b"), addTx: []byte("d")}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(2, 2)
+	mempool := txsMempool{txs: types.Txs{[]byte("a"), []byte("b"), []byte("c")}}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mempool, sm.MockEvidencePool{})
+
+	prevBlockID := types.BlockID{Hash: state.LastBlockID.Hash}
+	commitSig0 := types.NewCommitSigForBlock(
+		[]byte("Signature1"), state.LastValidators.Validators[0].Address, tmtime.Now())
+	commitSig0.Extension = []byte("price=1")
+	commit := types.NewCommit(1, 0, prevBlockID, []types.CommitSig{commitSig0, types.NewCommitSigAbsent()})
+	proposerAddr := state.Validators.GetProposer().Address
+
+	block, _, err := blockExec.CreateProposalBlock(2, state, commit, proposerAddr)
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{[]byte("c"), []byte("a"), []byte("d")}, block.Txs)
+
+	// the app is given the reaped txs and our commit, with its vote extensions
+	req := app.prepareReq
+	assert.EqualValues(t, 2, req.Height)
+	assert.EqualValues(t, proposerAddr, req.ProposerAddress)
+	assert.True(t, req.MaxTxBytes > 0)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("c")}, req.Txs)
+	require.Len(t, req.LocalLastCommit.Votes, 2)
+	assert.True(t, req.LocalLastCommit.Votes[0].SignedLastBlock)
+	assert.Equal(t, []byte("price=1"), req.LocalLastCommit.Votes[0].VoteExtension)
+	assert.False(t, req.LocalLastCommit.Votes[1].SignedLastBlock)
+
+	// txs too big for the block are an error
+	app.addTx = make([]byte, req.MaxTxBytes)
+	_, _, err = blockExec.CreateProposalBlock(2, state, commit, proposerAddr)
+	assert.Error(t, err)
+}
+
+func TestCreateProposalBlockAggregatesCommit(t *testing.T) {
+	app := &proposalApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(2, 2)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	// the last block was signed by validators with BLS12-381 keys
+	privKeys := map[string]crypto.PrivKey{}
+	vals := make([]*types.Validator, 3)
+	for i := range vals {
+		privKey := bls12381.GenPrivKey()
+		vals[i] = types.NewValidator(privKey.PubKey(), 10)
+		privKeys[string(vals[i].Address)] = privKey
+	}
+	state.LastValidators = types.NewValidatorSet(vals)
+	privVals := make([]types.PrivValidator, len(vals))
+	for i, val := range state.LastValidators.Validators {
+		privVals[i] = types.NewMockPVWithParams(privKeys[string(val.Address)], false, false)
+	}
+	blockID := types.BlockID{Hash: tmhash.Sum([]byte("block")),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))}}
+	voteSet := types.NewVoteSet(state.ChainID, 1, 0, types.PrecommitType, state.LastValidators)
+	commit, err := types.MakeCommit(blockID, 1, 0, voteSet, privVals)
+	require.NoError(t, err)
+
+	block, _, err := blockExec.CreateProposalBlock(2, state, commit, state.Validators.GetProposer().Address)
+	require.NoError(t, err)
+	assert.NotEmpty(t, block.LastCommit.AggregatedSignature)
+	for _, commitSig := range block.LastCommit.Signatures {
+		assert.True(t, commitSig.Aggregated())
+	}
+	assert.NoError(t, state.LastValidators.VerifyCommit(state.ChainID, blockID, 1, block.LastCommit))
+}
+
+func TestProcessProposal(t *testing.T) {
+	app := &proposalApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+	block := makeBlock(state, 1)
+
+	app.accept = true
+	require.NoError(t, blockExec.ProcessProposal(block))
+	assert.Equal(t, block.Hash().Bytes(), app.processReq.Hash)
+	assert.Equal(t, block.Height, app.processReq.Header.Height)
+	assert.Len(t, app.processReq.Txs, len(block.Txs))
+
+	app.accept = false
+	assert.Equal(t, sm.ErrProposalRejected, blockExec.ProcessProposal(block))
+}
+
+func TestVerifyVoteExtensionMaxBytes(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&proposalApp{})
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	_, stateDB, _ := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	vote := &types.Vote{Type: types.PrecommitType, Height: 1, Extension: []byte("price=1")}
+	assert.NoError(t, blockExec.VerifyVoteExtension(vote, int64(len(vote.Extension))))
+	assert.Error(t, blockExec.VerifyVoteExtension(vote, int64(len(vote.Extension))-1))
+}
+
 func TestValidateValidatorUpdates(t *testing.T) {
 	pubkey1 := ed25519.GenPrivKey().PubKey()
 	pubkey2 := ed25519.GenPrivKey().PubKey()
@@ -355,7 +522,7 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 		{PubKey: types.TM2PB.PubKey(pubkey), Power: 10},
 	}
 
-	state, err = blockExec.ApplyBlock(state, blockID, block)
+	state, _, err = blockExec.ApplyBlock(state, blockID, block)
 	require.Nil(t, err)
 
 	// test new validator was added to NextValidators
@@ -409,7 +576,7 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 		{PubKey: types.TM2PB.PubKey(state.Validators.Validators[0].PubKey), Power: 0},
 	}
 
-	assert.NotPanics(t, func() { state, err = blockExec.ApplyBlock(state, blockID, block) })
+	assert.NotPanics(t, func() { state, _, err = blockExec.ApplyBlock(state, blockID, block) })
 	assert.NotNil(t, err)
 	assert.NotEmpty(t, state.NextValidators.Validators)
 
@@ -10,6 +10,7 @@ import (
 func TxPreCheck(state State) mempl.PreCheckFunc {
 	maxDataBytes := types.MaxDataBytesUnknownEvidence(
 		state.ConsensusParams.Block.MaxBytes,
+		state.ConsensusParams.Validator,
 		state.Validators.Size(),
 	)
 	return mempl.PreCheckAminoMaxBytes(maxDataBytes)
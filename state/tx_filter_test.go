@@ -24,10 +24,10 @@ func TestTxFilter(t *testing.T) {
 		isErr bool
 	}{
 		{types.Tx(tmrand.Bytes(250)), false},
-		{types.Tx(tmrand.Bytes(1811)), false},
-		{types.Tx(tmrand.Bytes(1831)), false},
-		{types.Tx(tmrand.Bytes(1838)), true},
-		{types.Tx(tmrand.Bytes(1839)), true},
+		{types.Tx(tmrand.Bytes(1779)), false},
+		{types.Tx(tmrand.Bytes(1799)), false},
+		{types.Tx(tmrand.Bytes(1806)), true},
+		{types.Tx(tmrand.Bytes(1807)), true},
 		{types.Tx(tmrand.Bytes(3000)), true},
 	}
 
@@ -0,0 +1,96 @@
+package txindex
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+)
+
+// cursorSize is the size of an encoded Cursor: height (8 bytes) and index (4
+// bytes), both big-endian.
+const cursorSize = 12
+
+// Cursor is the position of a tx in search results, which are ordered by
+// height and index within the block.
+type Cursor struct {
+	Height int64
+	Index  uint32
+}
+
+// CursorFor returns the position of the given tx.
+func CursorFor(result *types.TxResult) Cursor {
+	return Cursor{Height: result.Height, Index: result.Index}
+}
+
+// ParseCursor decodes a cursor token, as returned by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	bz, err := hex.DecodeString(s)
+	if err != nil || len(bz) != cursorSize {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	c := Cursor{
+		Height: int64(binary.BigEndian.Uint64(bz[:8])),
+		Index:  binary.BigEndian.Uint32(bz[8:]),
+	}
+	if c.Height < 0 {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	return c, nil
+}
+
+// String encodes the cursor as an opaque token, which can be passed to
+// ParseCursor.
+func (c Cursor) String() string {
+	bz := make([]byte, cursorSize)
+	binary.BigEndian.PutUint64(bz[:8], uint64(c.Height))
+	binary.BigEndian.PutUint32(bz[8:], c.Index)
+	return hex.EncodeToString(bz)
+}
+
+// Before returns true if c comes before other in ascending order.
+func (c Cursor) Before(other Cursor) bool {
+	if c.Height == other.Height {
+		return c.Index < other.Index
+	}
+	return c.Height < other.Height
+}
+
+// PageOptions controls which page of search results is returned by
+// PagedTxIndexer.SearchPage.
+type PageOptions struct {
+	// OrderDesc returns txs in descending order of height and index, instead of
+	// ascending.
+	OrderDesc bool
+	// After, if set, only returns txs after the given position, in the
+	// requested order.
+	After *Cursor
+	// Skip is the number of matching txs to skip, after After if set.
+	Skip int
+	// Limit is the maximum number of txs to return.
+	Limit int
+	// CountTotal counts all the matching txs in Page.TotalCount, which
+	// requires going through all of them instead of stopping at the end of the
+	// page.
+	CountTotal bool
+}
+
+// Page is a page of search results.
+type Page struct {
+	Txs []*types.TxResult
+	// TotalCount is the number of txs matching the query, regardless of the
+	// After and Skip options, or -1 if they were not counted (see
+	// PageOptions.CountTotal).
+	TotalCount int
+	// Next is the position to resume the search from, or nil if there are no
+	// more results.
+	Next *Cursor
+}
+
+// PagedTxIndexer is an optional interface for TxIndexers which can page through
+// search results in order, without loading all of them into memory.
+type PagedTxIndexer interface {
+	SearchPage(q *query.Query, opts PageOptions) (*Page, error)
+}
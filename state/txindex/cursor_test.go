@@ -0,0 +1,25 @@
+package txindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	c := Cursor{Height: 12345, Index: 7}
+	parsed, err := ParseCursor(c.String())
+	require.NoError(t, err)
+	assert.Equal(t, c, parsed)
+
+	assert.True(t, c.Before(Cursor{Height: 12345, Index: 8}))
+	assert.True(t, c.Before(Cursor{Height: 12346, Index: 0}))
+	assert.False(t, c.Before(c))
+	assert.False(t, c.Before(Cursor{Height: 12344, Index: 9}))
+
+	for _, s := range []string{"", "zz", "0000", c.String() + "00", "ffffffffffffffff00000000"} {
+		_, err := ParseCursor(s)
+		assert.Error(t, err, s)
+	}
+}
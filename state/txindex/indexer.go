@@ -24,6 +24,20 @@ type TxIndexer interface {
 	Search(q *query.Query) ([]*types.TxResult, error)
 }
 
+// BlockIndexer defines methods to index and search blocks by their
+// BeginBlock and EndBlock events.
+type BlockIndexer interface {
+	// Has returns true if the block at the given height has been indexed.
+	Has(height int64) (bool, error)
+
+	// Index analyzes, indexes and stores a block header and its events.
+	Index(header types.EventDataNewBlockHeader) error
+
+	// Search allows you to query for blocks. It returns the heights of the
+	// matching blocks, in ascending order.
+	Search(q *query.Query) ([]int64, error)
+}
+
 //----------------------------------------------------
 // Txs are written as a batch
 
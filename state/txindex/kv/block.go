@@ -0,0 +1,287 @@
+package kv
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	tmstring "github.com/tendermint/tendermint/libs/strings"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+// blockIndexPrefix separates block keys from tx keys, so that a BlockIndex and
+// a TxIndex can share the same store.
+const blockIndexPrefix = "block_events/"
+
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// BlockIndex indexes blocks by their BeginBlock and EndBlock events, backed by
+// key-value storage (levelDB). Every block is indexed by its height
+// ("block.height"), other events are indexed as configured.
+type BlockIndex struct {
+	store                dbm.DB
+	compositeKeysToIndex []string
+	indexAllEvents       bool
+}
+
+// NewBlockIndex creates new KV block indexer.
+func NewBlockIndex(store dbm.DB, options ...func(*BlockIndex)) *BlockIndex {
+	bi := &BlockIndex{
+		store:                dbm.NewPrefixDB(store, []byte(blockIndexPrefix)),
+		compositeKeysToIndex: make([]string, 0),
+		indexAllEvents:       false,
+	}
+	for _, o := range options {
+		o(bi)
+	}
+	return bi
+}
+
+// IndexBlockEvents is an option for setting which composite keys to index.
+func IndexBlockEvents(compositeKeys []string) func(*BlockIndex) {
+	return func(bi *BlockIndex) {
+		bi.compositeKeysToIndex = compositeKeys
+	}
+}
+
+// IndexAllBlockEvents is an option for indexing all events.
+func IndexAllBlockEvents() func(*BlockIndex) {
+	return func(bi *BlockIndex) {
+		bi.indexAllEvents = true
+	}
+}
+
+// Has returns true if the block at the given height has been indexed.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	return bi.store.Has(keyForBlock(types.BlockHeightKey, fmt.Sprint(height), height))
+}
+
+// Index indexes the BeginBlock and EndBlock events of the given block. Each key
+// that indexed from the block's events is a composite of the event type and
+// the respective attribute's key delimited by a "." (eg. "reward.amount").
+// Any event with an empty type is not indexed.
+func (bi *BlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	b := bi.store.NewBatch()
+	defer b.Close()
+
+	height := header.Header.Height
+	heightBz := []byte(fmt.Sprint(height))
+
+	// index block by height
+	b.Set(keyForBlock(types.BlockHeightKey, fmt.Sprint(height), height), heightBz)
+
+	// index block by events
+	bi.indexEvents(header.ResultBeginBlock.Events, height, heightBz, b)
+	bi.indexEvents(header.ResultEndBlock.Events, height, heightBz, b)
+
+	return b.WriteSync()
+}
+
+func (bi *BlockIndex) indexEvents(events []abci.Event, height int64, heightBz []byte, store dbm.SetDeleter) {
+	for _, event := range events {
+		// only index events with a non-empty type
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			if compositeKey == types.BlockHeightKey {
+				continue
+			}
+			if bi.indexAllEvents || tmstring.StringInSlice(compositeKey, bi.compositeKeysToIndex) {
+				store.Set(keyForBlock(compositeKey, string(attr.Value), height), heightBz)
+			}
+		}
+	}
+}
+
+// Search performs a search using the given query. It returns the heights of
+// all blocks matching every condition, in ascending order. Queries with OR or
+// NOT are not supported.
+//
+// It breaks the query into conditions (like "block.height > 5"). For each
+// condition, it queries the DB index. Range conditions on the same key are
+// merged, so for range queries it is better for the client to provide both
+// lower and upper bounds.
+func (bi *BlockIndex) Search(q *query.Query) ([]int64, error) {
+	conditions, complete, err := q.RequiredConditions()
+	if err != nil {
+		return nil, errors.Wrap(err, "error during parsing conditions from query")
+	}
+	if !complete {
+		return nil, errors.New("OR and NOT are not supported when searching blocks")
+	}
+
+	var (
+		filteredHeights map[int64]struct{}
+		firstRun        = true
+	)
+	intersect := func(heights map[int64]struct{}) {
+		if firstRun {
+			filteredHeights = heights
+			firstRun = false
+			return
+		}
+		for h := range filteredHeights {
+			if _, ok := heights[h]; !ok {
+				delete(filteredHeights, h)
+			}
+		}
+	}
+
+	ranges, rangeIndexes := lookForRanges(conditions)
+	for _, r := range ranges {
+		heights, err := bi.matchRange(r)
+		if err != nil {
+			return nil, err
+		}
+		intersect(heights)
+		if len(filteredHeights) == 0 {
+			return []int64{}, nil
+		}
+	}
+
+	for i, c := range conditions {
+		if intInSlice(i, rangeIndexes) {
+			continue
+		}
+		heights, err := bi.match(c)
+		if err != nil {
+			return nil, err
+		}
+		intersect(heights)
+		if len(filteredHeights) == 0 {
+			return []int64{}, nil
+		}
+	}
+
+	results := make([]int64, 0, len(filteredHeights))
+	for h := range filteredHeights {
+		results = append(results, h)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	return results, nil
+}
+
+// match returns the heights of all blocks which meet the given condition.
+func (bi *BlockIndex) match(c query.Condition) (map[int64]struct{}, error) {
+	heights := make(map[int64]struct{})
+
+	switch c.Op {
+	case query.OpEqual:
+		it, err := dbm.IteratePrefix(bi.store, startKey(c.CompositeKey, c.Operand))
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			h, err := parseBlockHeight(it.Value())
+			if err != nil {
+				return nil, err
+			}
+			heights[h] = struct{}{}
+		}
+
+	case query.OpContains:
+		prefix := startKey(c.CompositeKey)
+		it, err := dbm.IteratePrefix(bi.store, prefix)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			if strings.Contains(extractValueFromBlockKey(it.Key(), prefix), c.Operand.(string)) {
+				h, err := parseBlockHeight(it.Value())
+				if err != nil {
+					return nil, err
+				}
+				heights[h] = struct{}{}
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported operator %v", c.Op)
+	}
+
+	return heights, nil
+}
+
+// matchRange returns the heights of all blocks which meet the given
+// queryRange. Only integer ranges are supported.
+func (bi *BlockIndex) matchRange(r queryRange) (map[int64]struct{}, error) {
+	if _, ok := r.AnyBound().(int64); !ok {
+		return nil, fmt.Errorf("unsupported range bound for %s, only integers are supported", r.key)
+	}
+
+	heights := make(map[int64]struct{})
+	lowerBound := r.lowerBoundValue()
+	upperBound := r.upperBoundValue()
+
+	prefix := startKey(r.key)
+	it, err := dbm.IteratePrefix(bi.store, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		v, err := strconv.ParseInt(extractValueFromBlockKey(it.Key(), prefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		if lowerBound != nil && v < lowerBound.(int64) {
+			continue
+		}
+		if upperBound != nil && v > upperBound.(int64) {
+			continue
+		}
+
+		h, err := parseBlockHeight(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		heights[h] = struct{}{}
+	}
+
+	return heights, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Keys
+
+func keyForBlock(compositeKey, value string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", compositeKey, value, height))
+}
+
+// extractValueFromBlockKey returns the value of a key created by keyForBlock,
+// given the "compositeKey/" prefix. Values may contain the separator.
+func extractValueFromBlockKey(key, prefix []byte) string {
+	value := string(key[len(prefix):])
+	if i := strings.LastIndex(value, tagKeySeparator); i >= 0 {
+		value = value[:i]
+	}
+	return value
+}
+
+func parseBlockHeight(bz []byte) (int64, error) {
+	h, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid block height %q", bz)
+	}
+	return h, nil
+}
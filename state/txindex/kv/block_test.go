@@ -0,0 +1,107 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+)
+
+func blockHeader(height int64, reward, proposer string) types.EventDataNewBlockHeader {
+	return types.EventDataNewBlockHeader{
+		Header: types.Header{Height: height},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{
+			{Type: "begin", Attributes: []kv.Pair{{Key: []byte("proposer"), Value: []byte(proposer)}}},
+		}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{
+			{Type: "end", Attributes: []kv.Pair{
+				{Key: []byte("reward"), Value: []byte(reward)},
+				{Key: []byte(""), Value: []byte("skipped")},
+			}},
+			{Type: "", Attributes: []kv.Pair{{Key: []byte("skipped"), Value: []byte("skipped")}}},
+		}},
+	}
+}
+
+func TestBlockIndexSearch(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewBlockIndex(store, IndexAllBlockEvents())
+
+	for h := int64(1); h <= 10; h++ {
+		proposer := "alice"
+		if h%2 == 0 {
+			proposer = "bob/smith"
+		}
+		require.NoError(t, indexer.Index(blockHeader(h, fmt.Sprint(h*10), proposer)))
+	}
+
+	// a tx indexer can share the same store
+	txIndexer := NewTxIndex(store, IndexAllEvents())
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "end", Attributes: []kv.Pair{{Key: []byte("reward"), Value: []byte("10")}}},
+	})
+	require.NoError(t, txIndexer.Index(txResult))
+
+	testCases := []struct {
+		q       string
+		heights []int64
+	}{
+		{"block.height = 5", []int64{5}},
+		{"block.height = 11", []int64{}},
+		{"block.height >= 3 AND block.height < 6", []int64{3, 4, 5}},
+		{"block.height > 8", []int64{9, 10}},
+		{"end.reward = 10", []int64{1}},
+		{"end.reward > 75", []int64{8, 9, 10}},
+		{"begin.proposer = 'alice'", []int64{1, 3, 5, 7, 9}},
+		{"begin.proposer = 'bob/smith' AND end.reward <= 60", []int64{2, 4, 6}},
+		{"begin.proposer CONTAINS 'smith'", []int64{2, 4, 6, 8, 10}},
+		{"begin.proposer CONTAINS 'smith' AND block.height > 4 AND block.height < 9", []int64{6, 8}},
+		{"begin.proposer = 'carol'", []int64{}},
+		{"end.skipped = 'skipped'", []int64{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			heights, err := indexer.Search(query.MustParse(tc.q))
+			require.NoError(t, err)
+			assert.Equal(t, tc.heights, heights)
+		})
+	}
+
+	_, err := indexer.Search(query.MustParse("block.height = 1 OR block.height = 2"))
+	assert.Error(t, err)
+
+	has, err := indexer.Has(4)
+	require.NoError(t, err)
+	assert.True(t, has)
+	has, err = indexer.Has(11)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestBlockIndexEvents(t *testing.T) {
+	indexer := NewBlockIndex(db.NewMemDB(), IndexBlockEvents([]string{"end.reward"}))
+	require.NoError(t, indexer.Index(blockHeader(1, "10", "alice")))
+
+	// the height is always indexed
+	heights, err := indexer.Search(query.MustParse("block.height = 1"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, heights)
+
+	heights, err = indexer.Search(query.MustParse("end.reward = 10"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, heights)
+
+	heights, err = indexer.Search(query.MustParse("begin.proposer = 'alice'"))
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +14,7 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/libs/pubsub/query"
 	tmstring "github.com/tendermint/tendermint/libs/strings"
 	"github.com/tendermint/tendermint/state/txindex"
@@ -23,6 +26,7 @@ const (
 )
 
 var _ txindex.TxIndexer = (*TxIndex)(nil)
+var _ txindex.PagedTxIndexer = (*TxIndex)(nil)
 
 // TxIndex is the simplest possible indexer, backed by key-value storage (levelDB).
 type TxIndex struct {
@@ -93,9 +97,7 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 		txi.indexEvents(result, hash, storeBatch)
 
 		// index tx by height
-		if txi.indexAllEvents || tmstring.StringInSlice(types.TxHeightKey, txi.compositeKeysToIndex) {
-			storeBatch.Set(keyForHeight(result), hash)
-		}
+		storeBatch.Set(keyForHeight(result), hash)
 
 		// index tx by hash
 		rawBytes, err := cdc.MarshalBinaryBare(result)
@@ -123,9 +125,7 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 	txi.indexEvents(result, hash, b)
 
 	// index tx by height
-	if txi.indexAllEvents || tmstring.StringInSlice(types.TxHeightKey, txi.compositeKeysToIndex) {
-		b.Set(keyForHeight(result), hash)
-	}
+	b.Set(keyForHeight(result), hash)
 
 	// index tx by hash
 	rawBytes, err := cdc.MarshalBinaryBare(result)
@@ -152,126 +152,412 @@ func (txi *TxIndex) indexEvents(result *types.TxResult, hash []byte, store dbm.S
 			}
 
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
-			if txi.indexAllEvents || tmstring.StringInSlice(compositeTag, txi.compositeKeysToIndex) {
+			if txi.isIndexed(compositeTag) {
 				store.Set(keyForEvent(compositeTag, attr.Value, result), hash)
 			}
 		}
 	}
 }
 
-// Search performs a search using the given query.
-//
-// It breaks the query into conditions (like "tx.height > 5"). For each
-// condition, it queries the DB index. One special use cases here: (1) if
-// "tx.hash" is found, it returns tx result for it (2) for range queries it is
-// better for the client to provide both lower and upper bounds, so we are not
-// performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// isIndexed returns true if txs are indexed by the given composite key.
+func (txi *TxIndex) isIndexed(compositeKey string) bool {
+	return txi.indexAllEvents || tmstring.StringInSlice(compositeKey, txi.compositeKeysToIndex)
+}
+
+// Search performs a search using the given query, and returns all matching txs
+// in ascending order of height and index. Use SearchPage to page through large
+// result sets with bounded memory.
 func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
+	page, err := txi.SearchPage(q, txindex.PageOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return page.Txs, nil
+}
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+// SearchPage performs a search using the given query, and returns a page of the
+// matching txs in order of height and index. A zero or negative opts.Limit
+// returns all matching txs. Unless opts.CountTotal is set, the search stops at
+// the first match after the page.
+//
+// It breaks the query into the conditions required by every match (like
+// "tx.height > 5"). If there is a "tx.hash" condition, the tx is looked up
+// directly. Otherwise, the txs are streamed in order from a single index: the
+// one of the first "=" condition on an indexed key, or the height index bounded
+// by any "tx.height" conditions. The remaining conditions, and the whole query
+// if it has OR or NOT, are checked against the events of each tx, so only the
+// returned page is held in memory. If opts.After is set (and the total is not
+// counted), the iteration starts right after it.
+//
+// If there is neither an "=" condition on an indexed key nor a bound on the
+// height, but some other condition on an indexed key (like "transfer.amount >
+// 5"), that condition is checked against the values in the keys of its index
+// instead of loading every tx, and the positions of the matching txs are held
+// in memory to be sorted.
+func (txi *TxIndex) SearchPage(q *query.Query, opts txindex.PageOptions) (*txindex.Page, error) {
+	// get a list of required conditions (like "tx.height > 5")
+	conditions, complete, err := q.RequiredConditions()
 	if err != nil {
 		return nil, errors.Wrap(err, "error during parsing conditions from query")
 	}
+	// queries with OR or NOT must be matched as a whole
+	var fullQuery *query.Query
+	if !complete {
+		fullQuery = q
+	}
+	// the events of txs only include indexed keys, so NOT on other keys would
+	// match every tx
+	for _, c := range q.NegatedConditions() {
+		if c.CompositeKey != types.TxHashKey && c.CompositeKey != types.TxHeightKey && !txi.isIndexed(c.CompositeKey) {
+			return nil, fmt.Errorf("can't search with NOT on %s, which is not indexed", c.CompositeKey)
+		}
+	}
 
-	// if there is a hash condition, return the result immediately
+	page := &txindex.Page{Txs: make([]*types.TxResult, 0)}
+	skipped := 0
+	collect := func(pos txindex.Cursor, hash []byte, result *types.TxResult) error {
+		page.TotalCount++
+		if opts.After != nil && !isAfter(pos, *opts.After, opts.OrderDesc) {
+			return nil
+		}
+		if skipped < opts.Skip {
+			skipped++
+			return nil
+		}
+		if opts.Limit > 0 && len(page.Txs) >= opts.Limit {
+			if page.Next == nil {
+				next := txindex.CursorFor(page.Txs[len(page.Txs)-1])
+				page.Next = &next
+			}
+			if !opts.CountTotal {
+				return errStopScan
+			}
+			return nil
+		}
+		if result == nil {
+			var err error
+			if result, err = txi.Get(hash); err != nil {
+				return errors.Wrapf(err, "failed to get Tx{%X}", hash)
+			} else if result == nil {
+				return fmt.Errorf("indexed Tx{%X} not found", hash)
+			}
+		}
+		page.Txs = append(page.Txs, result)
+		return nil
+	}
+
+	// if there is a hash condition, look the tx up directly
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
 		return nil, errors.Wrap(err, "error during searching for a hash in the query")
 	} else if ok {
-		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*types.TxResult{}, errors.Wrap(err, "error while retrieving the result")
-		case res == nil:
-			return []*types.TxResult{}, nil
-		default:
-			return []*types.TxResult{res}, nil
-		}
-	}
-
-	// conditions to skip because they're handled before "everything else"
-	skipIndexes := make([]int, 0)
-
-	// extract ranges
-	// if both upper and lower bounds exist, it's better to get them in order not
-	// no iterate over kvs that are not within range.
-	ranges, rangeIndexes := lookForRanges(conditions)
-	if len(ranges) > 0 {
-		skipIndexes = append(skipIndexes, rangeIndexes...)
-
-		for _, r := range ranges {
-			if !hashesInitialized {
-				filteredHashes = txi.matchRange(r, startKey(r.key), filteredHashes, true)
-				hashesInitialized = true
-
-				// Ignore any remaining conditions if the first condition resulted
-				// in no matches (assuming implicit AND operand).
-				if len(filteredHashes) == 0 {
-					break
-				}
-			} else {
-				filteredHashes = txi.matchRange(r, startKey(r.key), filteredHashes, false)
+		result, err := txi.Get(hash)
+		if err != nil {
+			return nil, errors.Wrap(err, "error while retrieving the result")
+		}
+		if result != nil && txi.matchesAll(result, conditions, fullQuery) {
+			if err := collect(txindex.CursorFor(result), hash, result); err != nil {
+				return nil, err
 			}
 		}
+		if !opts.CountTotal {
+			page.TotalCount = -1
+		}
+		return page, nil
 	}
 
-	// if there is a height condition ("tx.height=3"), extract it
-	height := lookForHeight(conditions)
+	plan := txi.planSearch(conditions)
+	plan.query = fullQuery
+	if !opts.CountTotal {
+		plan.after = opts.After
+	}
+	if err := txi.scan(plan, opts.OrderDesc, collect); err != nil && err != errStopScan {
+		return nil, err
+	}
+	if !opts.CountTotal {
+		page.TotalCount = -1
+	}
+	return page, nil
+}
+
+// errStopScan is returned by the function called by scan for each tx to stop
+// the iteration.
+var errStopScan = errors.New("stop scan")
+
+// searchPlan describes how to stream the txs matching a query: by iterating in
+// order over the keys with the given prefix, for heights within the given
+// bounds, and checking the filters against the events of each tx.
+type searchPlan struct {
+	prefix []byte
+	// separators is the number of separators in a key after the prefix, i.e. 1
+	// for event keys ("height/index"), 2 for height keys ("height/height/index").
+	separators int
+	minHeight  int64
+	maxHeight  int64
+	filters    []query.Condition
+	// query, if set, is also checked against the events of each tx.
+	query *query.Query
+	// after, if set, is the position to start the iteration after.
+	after *txindex.Cursor
+	// keyCondition, if set, is checked against the values in the keys with
+	// the given prefix ("key/value/height/index"), whose txs are then sorted.
+	keyCondition *query.Condition
+}
 
-	// for all other conditions
+func (txi *TxIndex) planSearch(conditions []query.Condition) searchPlan {
+	plan := searchPlan{
+		prefix:     startKey(types.TxHeightKey),
+		separators: 2,
+		minHeight:  0,
+		maxHeight:  math.MaxInt64,
+		filters:    make([]query.Condition, 0, len(conditions)),
+	}
+
+	var driver *query.Condition
 	for i, c := range conditions {
-		if intInSlice(i, skipIndexes) {
+		// height conditions bound the iteration
+		if height, ok := c.Operand.(int64); ok && c.CompositeKey == types.TxHeightKey && isHeightBound(c.Op) {
+			switch c.Op {
+			case query.OpEqual:
+				plan.minHeight = tmmath.MaxInt64(plan.minHeight, height)
+				plan.maxHeight = tmmath.MinInt64(plan.maxHeight, height)
+			case query.OpGreater:
+				plan.minHeight = tmmath.MaxInt64(plan.minHeight, height+1)
+			case query.OpGreaterEqual:
+				plan.minHeight = tmmath.MaxInt64(plan.minHeight, height)
+			case query.OpLess:
+				plan.maxHeight = tmmath.MinInt64(plan.maxHeight, height-1)
+			case query.OpLessEqual:
+				plan.maxHeight = tmmath.MinInt64(plan.maxHeight, height)
+			}
 			continue
 		}
 
-		if !hashesInitialized {
-			filteredHashes = txi.match(c, startKeyForCondition(c, height), filteredHashes, true)
-			hashesInitialized = true
+		// the first "=" condition on an indexed key selects the index to iterate
+		if driver == nil && c.Op == query.OpEqual && txi.isIndexed(c.CompositeKey) {
+			switch c.Operand.(type) {
+			case string, int64:
+				driver = &conditions[i]
+				continue
+			}
+		}
+
+		plan.filters = append(plan.filters, c)
+	}
 
-			// Ignore any remaining conditions if the first condition resulted
-			// in no matches (assuming implicit AND operand).
-			if len(filteredHashes) == 0 {
+	switch {
+	case driver != nil:
+		plan.prefix = startKey(driver.CompositeKey, driver.Operand)
+		plan.separators = 1
+	case plan.minHeight == 0 && plan.maxHeight == math.MaxInt64:
+		// without an "=" condition or height bounds, use the index of another
+		// condition rather than loading every tx
+		for i, c := range plan.filters {
+			if c.CompositeKey != types.TxHeightKey && txi.isIndexed(c.CompositeKey) {
+				keyCondition := c
+				plan.keyCondition = &keyCondition
+				plan.prefix = startKey(c.CompositeKey)
+				plan.separators = 1
+				plan.filters = append(plan.filters[:i:i], plan.filters[i+1:]...)
 				break
 			}
-		} else {
-			filteredHashes = txi.match(c, startKeyForCondition(c, height), filteredHashes, false)
 		}
 	}
+	return plan
+}
 
-	results := make([]*types.TxResult, 0, len(filteredHashes))
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get Tx{%X}", h)
+func isHeightBound(op query.Operator) bool {
+	return op == query.OpEqual || isRangeOperation(op)
+}
+
+// scan iterates over the keys of the plan in the given order, and calls fn for
+// every tx which matches the filters. fn is given the tx result only if it had
+// to be loaded to check the filters.
+func (txi *TxIndex) scan(plan searchPlan, desc bool,
+	fn func(pos txindex.Cursor, hash []byte, result *types.TxResult) error) error {
+	if plan.minHeight > plan.maxHeight {
+		return nil
+	}
+	if plan.keyCondition != nil {
+		return txi.scanKeys(plan, desc, fn)
+	}
+
+	// keys are ordered by height and index, so heights and the cursor bound
+	// the iteration
+	start, end := plan.prefix, prefixEnd(plan.prefix)
+	if plan.minHeight > 0 {
+		start = append(cp(plan.prefix), []byte(fmt.Sprintf("%019d", plan.minHeight))...)
+	}
+	if plan.maxHeight < math.MaxInt64 {
+		end = append(cp(plan.prefix), []byte(fmt.Sprintf("%019d", plan.maxHeight+1))...)
+	}
+	if plan.after != nil {
+		after := plan.positionKey(*plan.after)
+		if desc && (end == nil || bytes.Compare(after, end) < 0) {
+			end = after
+		} else if !desc && bytes.Compare(after, start) > 0 {
+			start = after
 		}
-		results = append(results, res)
 	}
 
-	return results, nil
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if desc {
+		it, err = txi.store.ReverseIterator(start, end)
+	} else {
+		it, err = txi.store.Iterator(start, end)
+	}
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		pos, ok := parsePosition(it.Key()[len(plan.prefix):], plan.separators)
+		if !ok || pos.Height < plan.minHeight || pos.Height > plan.maxHeight {
+			continue
+		}
+
+		if err := txi.filter(plan, pos, it.Value(), fn); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
+// scanKeys iterates over the keys of the plan, checking its keyCondition
+// against their values, and calls fn in the given order for every tx which
+// matches it and the filters.
+func (txi *TxIndex) scanKeys(plan searchPlan, desc bool,
+	fn func(pos txindex.Cursor, hash []byte, result *types.TxResult) error) error {
+	it, err := txi.store.Iterator(plan.prefix, prefixEnd(plan.prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	type match struct {
+		pos  txindex.Cursor
+		hash []byte
+	}
+	var matches []match
+	for ; it.Valid(); it.Next() {
+		// values may contain separators, unlike heights and indexes
+		suffix := string(it.Key()[len(plan.prefix):])
+		parts := strings.Split(suffix, tagKeySeparator)
+		if len(parts) < 3 {
+			continue
+		}
+		value := strings.Join(parts[:len(parts)-2], tagKeySeparator)
+		pos, ok := parsePosition([]byte(strings.Join(parts[len(parts)-2:], tagKeySeparator)), 1)
+		if !ok || plan.after != nil && !isAfter(pos, *plan.after, desc) {
+			continue
+		}
+		events := map[string][]string{plan.keyCondition.CompositeKey: {value}}
+		if ok, err := plan.keyCondition.Matches(events); err != nil || !ok {
+			continue
+		}
+		matches = append(matches, match{pos: pos, hash: cp(it.Value())})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if desc {
+			return matches[j].pos.Before(matches[i].pos)
+		}
+		return matches[i].pos.Before(matches[j].pos)
+	})
+	for i, m := range matches {
+		// a tx may have several matching values
+		if i > 0 && m.pos == matches[i-1].pos {
+			continue
+		}
+		if err := txi.filter(plan, m.pos, m.hash, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filter calls fn for the tx at the given position if it matches the filters
+// and query of the plan, loading it only if needed to check them.
+func (txi *TxIndex) filter(plan searchPlan, pos txindex.Cursor, hash []byte,
+	fn func(pos txindex.Cursor, hash []byte, result *types.TxResult) error) error {
+	var result *types.TxResult
+	if len(plan.filters) > 0 || plan.query != nil {
+		var err error
+		if result, err = txi.Get(hash); err != nil {
+			return errors.Wrapf(err, "failed to get Tx{%X}", hash)
+		}
+		if result == nil || !txi.matchesAll(result, plan.filters, plan.query) {
+			return nil
+		}
+	}
+	return fn(pos, hash, result)
+}
+
+// positionKey returns the key of the tx at the given position in the index
+// iterated over by the plan.
+func (plan searchPlan) positionKey(pos txindex.Cursor) []byte {
+	if plan.separators == 2 {
+		return append(cp(plan.prefix), []byte(fmt.Sprintf("%019d/%019d/%010d", pos.Height, pos.Height, pos.Index))...)
+	}
+	return append(cp(plan.prefix), []byte(fmt.Sprintf("%019d/%010d", pos.Height, pos.Index))...)
+}
+
+// matchesAll returns true if the indexed events of the given tx match all of
+// the conditions, and the query if given. Values which can't be compared with
+// the operand don't match.
+func (txi *TxIndex) matchesAll(result *types.TxResult, conditions []query.Condition, q *query.Query) bool {
+	events := map[string][]string{
+		types.TxHashKey:   {fmt.Sprintf("%X", result.Tx.Hash())},
+		types.TxHeightKey: {fmt.Sprintf("%d", result.Height)},
+	}
+	for _, event := range result.Result.Events {
+		if len(event.Type) == 0 {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			if txi.isIndexed(compositeKey) {
+				events[compositeKey] = append(events[compositeKey], string(attr.Value))
+			}
+		}
+	}
+
 	for _, c := range conditions {
-		if c.CompositeKey == types.TxHashKey {
-			decoded, err := hex.DecodeString(c.Operand.(string))
-			return decoded, true, err
+		if ok, err := c.Matches(events); err != nil || !ok {
+			return false
 		}
 	}
-	return
+	if q != nil {
+		if ok, err := q.Matches(events); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isAfter returns true if pos comes after cursor in the given order.
+func isAfter(pos, cursor txindex.Cursor, desc bool) bool {
+	if desc {
+		return pos.Before(cursor)
+	}
+	return cursor.Before(pos)
 }
 
-// lookForHeight returns a height if there is an "height=X" condition.
-func lookForHeight(conditions []query.Condition) (height int64) {
+func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
 	for _, c := range conditions {
-		if c.CompositeKey == types.TxHeightKey && c.Op == query.OpEqual {
-			return c.Operand.(int64)
+		if c.CompositeKey == types.TxHashKey {
+			decoded, err := hex.DecodeString(c.Operand.(string))
+			return decoded, true, err
 		}
 	}
-	return 0
+	return
 }
 
 // special map to hold range conditions
@@ -368,179 +654,14 @@ func isRangeOperation(op query.Operator) bool {
 	}
 }
 
-// match returns all matching txs by hash that meet a given condition and start
-// key. An already filtered result (filteredHashes) is provided such that any
-// non-intersecting matches are removed.
-//
-// NOTE: filteredHashes may be empty if no previous condition has matched.
-func (txi *TxIndex) match(
-	c query.Condition,
-	startKeyBz []byte,
-	filteredHashes map[string][]byte,
-	firstRun bool,
-) map[string][]byte {
-	// A previous match was attempted but resulted in no matches, so we return
-	// no matches (assuming AND operand).
-	if !firstRun && len(filteredHashes) == 0 {
-		return filteredHashes
-	}
-
-	tmpHashes := make(map[string][]byte)
-
-	switch {
-	case c.Op == query.OpEqual:
-		it, err := dbm.IteratePrefix(txi.store, startKeyBz)
-		if err != nil {
-			panic(err)
-		}
-		defer it.Close()
-
-		for ; it.Valid(); it.Next() {
-			tmpHashes[string(it.Value())] = it.Value()
-		}
-
-	case c.Op == query.OpContains:
-		// XXX: startKey does not apply here.
-		// For example, if startKey = "account.owner/an/" and search query = "account.owner CONTAINS an"
-		// we can't iterate with prefix "account.owner/an/" because we might miss keys like "account.owner/Ulan/"
-		it, err := dbm.IteratePrefix(txi.store, startKey(c.CompositeKey))
-		if err != nil {
-			panic(err)
-		}
-		defer it.Close()
-
-		for ; it.Valid(); it.Next() {
-			if !isTagKey(it.Key()) {
-				continue
-			}
-
-			if strings.Contains(extractValueFromKey(it.Key()), c.Operand.(string)) {
-				tmpHashes[string(it.Value())] = it.Value()
-			}
-		}
-	default:
-		panic("other operators should be handled already")
-	}
-
-	if len(tmpHashes) == 0 || firstRun {
-		// Either:
-		//
-		// 1. Regardless if a previous match was attempted, which may have had
-		// results, but no match was found for the current condition, then we
-		// return no matches (assuming AND operand).
-		//
-		// 2. A previous match was not attempted, so we return all results.
-		return tmpHashes
-	}
-
-	// Remove/reduce matches in filteredHashes that were not found in this
-	// match (tmpHashes).
-	for k := range filteredHashes {
-		if tmpHashes[k] == nil {
-			delete(filteredHashes, k)
-		}
-	}
-
-	return filteredHashes
-}
-
-// matchRange returns all matching txs by hash that meet a given queryRange and
-// start key. An already filtered result (filteredHashes) is provided such that
-// any non-intersecting matches are removed.
-//
-// NOTE: filteredHashes may be empty if no previous condition has matched.
-func (txi *TxIndex) matchRange(
-	r queryRange,
-	startKey []byte,
-	filteredHashes map[string][]byte,
-	firstRun bool,
-) map[string][]byte {
-	// A previous match was attempted but resulted in no matches, so we return
-	// no matches (assuming AND operand).
-	if !firstRun && len(filteredHashes) == 0 {
-		return filteredHashes
-	}
-
-	tmpHashes := make(map[string][]byte)
-	lowerBound := r.lowerBoundValue()
-	upperBound := r.upperBoundValue()
-
-	it, err := dbm.IteratePrefix(txi.store, startKey)
-	if err != nil {
-		panic(err)
-	}
-	defer it.Close()
-
-LOOP:
-	for ; it.Valid(); it.Next() {
-		if !isTagKey(it.Key()) {
-			continue
-		}
-
-		if _, ok := r.AnyBound().(int64); ok {
-			v, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
-			if err != nil {
-				continue LOOP
-			}
-
-			include := true
-			if lowerBound != nil && v < lowerBound.(int64) {
-				include = false
-			}
-
-			if upperBound != nil && v > upperBound.(int64) {
-				include = false
-			}
-
-			if include {
-				tmpHashes[string(it.Value())] = it.Value()
-			}
-
-			// XXX: passing time in a ABCI Events is not yet implemented
-			// case time.Time:
-			// 	v := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
-			// 	if v == r.upperBound {
-			// 		break
-			// 	}
-		}
-	}
-
-	if len(tmpHashes) == 0 || firstRun {
-		// Either:
-		//
-		// 1. Regardless if a previous match was attempted, which may have had
-		// results, but no match was found for the current condition, then we
-		// return no matches (assuming AND operand).
-		//
-		// 2. A previous match was not attempted, so we return all results.
-		return tmpHashes
-	}
-
-	// Remove/reduce matches in filteredHashes that were not found in this
-	// match (tmpHashes).
-	for k := range filteredHashes {
-		if tmpHashes[k] == nil {
-			delete(filteredHashes, k)
-		}
-	}
-
-	return filteredHashes
-}
-
 ///////////////////////////////////////////////////////////////////////////////
 // Keys
 
-func isTagKey(key []byte) bool {
-	return strings.Count(string(key), tagKeySeparator) == 3
-}
-
-func extractValueFromKey(key []byte) string {
-	parts := strings.SplitN(string(key), tagKeySeparator, 3)
-	return parts[1]
-}
+// Heights and indexes are zero-padded, so that the keys of a given composite
+// key and value are ordered by height and index.
 
 func keyForEvent(key string, value []byte, result *types.TxResult) []byte {
-	return []byte(fmt.Sprintf("%s/%s/%d/%d",
+	return []byte(fmt.Sprintf("%s/%s/%019d/%010d",
 		key,
 		value,
 		result.Height,
@@ -549,7 +670,7 @@ func keyForEvent(key string, value []byte, result *types.TxResult) []byte {
 }
 
 func keyForHeight(result *types.TxResult) []byte {
-	return []byte(fmt.Sprintf("%s/%d/%d/%d",
+	return []byte(fmt.Sprintf("%s/%019d/%019d/%010d",
 		types.TxHeightKey,
 		result.Height,
 		result.Height,
@@ -557,11 +678,23 @@ func keyForHeight(result *types.TxResult) []byte {
 	))
 }
 
-func startKeyForCondition(c query.Condition, height int64) []byte {
-	if height > 0 {
-		return startKey(c.CompositeKey, c.Operand, height)
+// parsePosition parses the height and index at the end of a key, given the
+// part of the key after its prefix, which must contain the given number of
+// separators.
+func parsePosition(suffix []byte, separators int) (txindex.Cursor, bool) {
+	parts := strings.Split(string(suffix), tagKeySeparator)
+	if len(parts) != separators+1 {
+		return txindex.Cursor{}, false
+	}
+	height, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return txindex.Cursor{}, false
 	}
-	return startKey(c.CompositeKey, c.Operand)
+	index, err := strconv.ParseUint(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return txindex.Cursor{}, false
+	}
+	return txindex.Cursor{Height: height, Index: uint32(index)}, true
 }
 
 func startKey(fields ...interface{}) []byte {
@@ -571,3 +704,21 @@ func startKey(fields ...interface{}) []byte {
 	}
 	return b.Bytes()
 }
+
+// prefixEnd returns the first key after all keys with the given prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := cp(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func cp(bz []byte) []byte {
+	ret := make([]byte, len(bz))
+	copy(ret, bz)
+	return ret
+}
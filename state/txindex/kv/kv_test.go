@@ -148,6 +148,7 @@ func TestTxSearchDeprecatedIndexing(t *testing.T) {
 	// index tx also using deprecated indexing (event as key)
 	txResult2 := txResultWithEvents(nil)
 	txResult2.Tx = types.Tx("HELLO WORLD 2")
+	txResult2.Index = 1
 
 	hash2 := txResult2.Tx.Hash()
 	b := indexer.store.NewBatch()
@@ -272,6 +273,117 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	assert.NoError(t, err)
 
 	require.Len(t, results, 3)
+	assert.Equal(t, []*types.TxResult{txResult3, txResult2, txResult}, results)
+}
+
+func TestTxSearchPage(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB(), IndexEvents([]string{"account.owner", "account.number"}))
+
+	// 3 txs per block at heights 1-10, owned alternately by Ivan and Vlad
+	batch := txindex.NewBatch(0)
+	for height := int64(1); height <= 10; height++ {
+		for index := uint32(0); index < 3; index++ {
+			owner := "Ivan"
+			if (height+int64(index))%2 == 0 {
+				owner = "Vlad"
+			}
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []kv.Pair{
+					{Key: []byte("owner"), Value: []byte(owner)},
+					{Key: []byte("number"), Value: []byte(fmt.Sprint(index))},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx/%d/%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			batch.Ops = append(batch.Ops, txResult)
+		}
+	}
+	require.NoError(t, indexer.AddBatch(batch))
+
+	positions := func(page *txindex.Page) []string {
+		res := make([]string, len(page.Txs))
+		for i, r := range page.Txs {
+			res[i] = fmt.Sprintf("%d/%d", r.Height, r.Index)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		q          string
+		opts       txindex.PageOptions
+		positions  []string
+		totalCount int
+		next       *txindex.Cursor
+	}{
+		{"tx.height >= 9", txindex.PageOptions{},
+			[]string{"9/0", "9/1", "9/2", "10/0", "10/1", "10/2"}, 6, nil},
+		{"tx.height >= 9", txindex.PageOptions{OrderDesc: true, Limit: 2},
+			[]string{"10/2", "10/1"}, 6, &txindex.Cursor{Height: 10, Index: 1}},
+		{"tx.height >= 9", txindex.PageOptions{OrderDesc: true, Limit: 2, After: &txindex.Cursor{Height: 10, Index: 1}},
+			[]string{"10/0", "9/2"}, 6, &txindex.Cursor{Height: 9, Index: 2}},
+		{"tx.height >= 9", txindex.PageOptions{OrderDesc: true, Limit: 2, After: &txindex.Cursor{Height: 9, Index: 2}},
+			[]string{"9/1", "9/0"}, 6, nil},
+		{"tx.height > 2 AND tx.height < 4", txindex.PageOptions{Skip: 1, Limit: 1},
+			[]string{"3/1"}, 3, &txindex.Cursor{Height: 3, Index: 1}},
+		{"tx.height = 3 AND tx.height = 4", txindex.PageOptions{}, []string{}, 0, nil},
+		{"account.owner = 'Vlad' AND tx.height <= 3", txindex.PageOptions{},
+			[]string{"1/1", "2/0", "2/2", "3/1"}, 4, nil},
+		{"account.owner = 'Vlad' AND account.number >= 1",
+			txindex.PageOptions{Limit: 3, After: &txindex.Cursor{Height: 2, Index: 0}},
+			[]string{"2/2", "3/1", "4/2"}, 10, &txindex.Cursor{Height: 4, Index: 2}},
+		{"account.number > 1 AND tx.height < 3", txindex.PageOptions{OrderDesc: true},
+			[]string{"2/2", "1/2"}, 2, nil},
+		{"account.owner CONTAINS 'la'", txindex.PageOptions{Limit: 1},
+			[]string{"1/1"}, 15, &txindex.Cursor{Height: 1, Index: 1}},
+		{"account.number >= 2", txindex.PageOptions{OrderDesc: true, Limit: 2, After: &txindex.Cursor{Height: 10, Index: 2}},
+			[]string{"9/2", "8/2"}, 10, &txindex.Cursor{Height: 8, Index: 2}},
+		{"account.number > 0 AND account.owner CONTAINS 'I'", txindex.PageOptions{Limit: 3},
+			[]string{"1/2", "2/1", "3/2"}, 10, &txindex.Cursor{Height: 3, Index: 2}},
+		{"account.owner = 'Igor'", txindex.PageOptions{}, []string{}, 0, nil},
+		{"account.owner = 'Vlad' AND (tx.height = 1 OR tx.height = 10)", txindex.PageOptions{},
+			[]string{"1/1", "10/0", "10/2"}, 3, nil},
+		{"NOT account.owner = 'Ivan' AND tx.height <= 2", txindex.PageOptions{OrderDesc: true},
+			[]string{"2/2", "2/0", "1/1"}, 3, nil},
+		{"tx.height < 2 AND (account.number = 0 OR account.number = 2)", txindex.PageOptions{},
+			[]string{"1/0", "1/2"}, 2, nil},
+		{"account.owner EXISTS AND tx.height = 1", txindex.PageOptions{},
+			[]string{"1/0", "1/1", "1/2"}, 3, nil},
+		{"tx.height <= 2 AND (account.owner > 5 OR account.number = 1)", txindex.PageOptions{},
+			[]string{"1/1", "2/1"}, 2, nil},
+		{"account.balance EXISTS OR tx.height = 1", txindex.PageOptions{Limit: 1},
+			[]string{"1/0"}, 3, &txindex.Cursor{Height: 1, Index: 0}},
+		{fmt.Sprintf("tx.hash = '%X' AND account.owner = 'Ivan'", types.Tx("tx/1/0").Hash()), txindex.PageOptions{},
+			[]string{"1/0"}, 1, nil},
+		{fmt.Sprintf("tx.hash = '%X' AND account.owner = 'Vlad'", types.Tx("tx/1/0").Hash()), txindex.PageOptions{},
+			[]string{}, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s %+v", tc.q, tc.opts), func(t *testing.T) {
+			page, err := indexer.SearchPage(query.MustParse(tc.q), tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.positions, positions(page))
+			assert.Equal(t, -1, page.TotalCount)
+			assert.Equal(t, tc.next, page.Next)
+
+			// the same page, counting all the txs
+			opts := tc.opts
+			opts.CountTotal = true
+			page, err = indexer.SearchPage(query.MustParse(tc.q), opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.positions, positions(page))
+			assert.Equal(t, tc.totalCount, page.TotalCount)
+			assert.Equal(t, tc.next, page.Next)
+		})
+	}
+
+	// NOT can't be used on keys which are not indexed
+	_, err := indexer.SearchPage(query.MustParse("tx.height = 1 AND NOT account.balance = 5"), txindex.PageOptions{})
+	assert.Error(t, err)
+	_, err = indexer.SearchPage(query.MustParse("account.owner = 'Ivan' AND NOT tx.height = 1"), txindex.PageOptions{})
+	assert.NoError(t, err)
 }
 
 func txResultWithEvents(events []abci.Event) *types.TxResult {
@@ -0,0 +1,163 @@
+package kv
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// keysVersionKey holds the version of the format of the keys of the txs.
+	keysVersionKey = "tx_index_keys_version"
+	// keysVersion is the current version: zero-padded heights and indexes,
+	// and all the txs indexed by height.
+	keysVersion = "1"
+
+	// migrateBatchSize is the number of keys rewritten at once.
+	migrateBatchSize = 1000
+)
+
+// CheckKeysVersion returns an error if the store may hold txs indexed by
+// earlier versions, which must be migrated with MigrateLegacyKeys before the
+// store is used by a TxIndex. Empty stores are marked as being in the current
+// version.
+func CheckKeysVersion(store dbm.DB) error {
+	version, err := store.Get([]byte(keysVersionKey))
+	if err != nil {
+		return err
+	}
+	if string(version) == keysVersion {
+		return nil
+	}
+
+	it, err := store.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	empty := !it.Valid()
+	it.Close()
+	if !empty {
+		return errors.New("the tx index was written by an earlier version, and must be migrated first")
+	}
+	return store.SetSync([]byte(keysVersionKey), []byte(keysVersion))
+}
+
+// MigrateLegacyKeys rewrites the keys of the txs indexed by earlier versions,
+// whose heights and indexes were not zero-padded, so that they are found by
+// searches, and indexes these txs by height. It returns the number of keys
+// rewritten. It does nothing if the keys of the store were already migrated,
+// and must be called before the store is used by a TxIndex.
+func MigrateLegacyKeys(store dbm.DB) (int, error) {
+	version, err := store.Get([]byte(keysVersionKey))
+	if err != nil {
+		return 0, err
+	}
+	if string(version) == keysVersion {
+		return 0, nil
+	}
+
+	migrated := 0
+	var start []byte
+	for {
+		// the keys are collected before being rewritten, as the store can't be
+		// written to while iterating over it
+		keys, values, next, err := nextLegacyKeys(store, start)
+		if err != nil {
+			return migrated, err
+		}
+
+		b := store.NewBatch()
+		for i, key := range keys {
+			newKey, pos := migrateKey(key)
+			b.Delete(key)
+			b.Set(newKey, values[i])
+			// txs were indexed by height only if tx.height was indexed
+			b.Set(keyForHeight(&types.TxResult{Height: pos.Height, Index: pos.Index}), values[i])
+		}
+		err = b.WriteSync()
+		b.Close()
+		if err != nil {
+			return migrated, err
+		}
+		migrated += len(keys)
+
+		if next == nil {
+			break
+		}
+		start = next
+	}
+
+	return migrated, store.SetSync([]byte(keysVersionKey), []byte(keysVersion))
+}
+
+// nextLegacyKeys returns up to migrateBatchSize legacy keys from start on,
+// their values, and the key to continue from, or nil if there are no more.
+func nextLegacyKeys(store dbm.DB, start []byte) (keys, values [][]byte, next []byte, err error) {
+	it, err := store.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if len(keys) == migrateBatchSize {
+			return keys, values, cp(it.Key()), nil
+		}
+		if isLegacyKey(it.Key(), it.Value()) {
+			keys = append(keys, cp(it.Key()))
+			values = append(values, cp(it.Value()))
+		}
+	}
+	return keys, values, nil, nil
+}
+
+// isLegacyKey returns true if key is the key of a tx event or height written
+// by an earlier version, like "account.owner/Ivan/5/0" or "tx.height/5/5/0".
+// Their values are tx hashes, unlike the ones of the block index and of the
+// txs themselves.
+func isLegacyKey(key, value []byte) bool {
+	if len(value) != tmhash.Size {
+		return false
+	}
+	_, height, index, ok := splitLegacyKey(key)
+	return ok && (len(height) != 19 || len(index) != 10)
+}
+
+// splitLegacyKey splits a key ending with "/height/index" into its prefix, the
+// height and the index.
+func splitLegacyKey(key []byte) (prefix, height, index []byte, ok bool) {
+	parts := bytes.Split(key, []byte(tagKeySeparator))
+	if len(parts) < 4 {
+		return nil, nil, nil, false
+	}
+	height, index = parts[len(parts)-2], parts[len(parts)-1]
+	if _, err := strconv.ParseInt(string(height), 10, 64); err != nil {
+		return nil, nil, nil, false
+	}
+	if _, err := strconv.ParseUint(string(index), 10, 32); err != nil {
+		return nil, nil, nil, false
+	}
+	prefix = key[:len(key)-len(height)-len(index)-2]
+	return prefix, height, index, true
+}
+
+// migrateKey returns the key in the current format of a legacy key, and the
+// position of its tx.
+func migrateKey(key []byte) ([]byte, txindex.Cursor) {
+	prefix, height, index, _ := splitLegacyKey(key)
+	h, _ := strconv.ParseInt(string(height), 10, 64)
+	i, _ := strconv.ParseUint(string(index), 10, 32)
+	pos := txindex.Cursor{Height: h, Index: uint32(i)}
+	result := &types.TxResult{Height: pos.Height, Index: pos.Index}
+	if bytes.HasPrefix(prefix, startKey(types.TxHeightKey)) {
+		return keyForHeight(result), pos
+	}
+	return []byte(fmt.Sprintf("%s/%019d/%010d", prefix, pos.Height, pos.Index)), pos
+}
@@ -0,0 +1,97 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestMigrateLegacyKeys(t *testing.T) {
+	store := db.NewMemDB()
+
+	// txs indexed by an earlier version, at heights which were not ordered
+	heights := []int64{1, 2, 10}
+	for _, height := range heights {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []kv.Pair{
+					{Key: []byte("owner"), Value: []byte("Ivan")},
+					{Key: []byte("path"), Value: []byte("a/1")},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx/%d/%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			hash := txResult.Tx.Hash()
+
+			rawBytes, err := cdc.MarshalBinaryBare(txResult)
+			require.NoError(t, err)
+			require.NoError(t, store.Set(hash, rawBytes))
+			require.NoError(t, store.Set([]byte(fmt.Sprintf("account.owner/Ivan/%d/%d", height, index)), hash))
+			require.NoError(t, store.Set([]byte(fmt.Sprintf("account.path/a/1/%d/%d", height, index)), hash))
+			// txs were indexed by height only if tx.height was indexed
+			if height != 2 {
+				require.NoError(t, store.Set([]byte(fmt.Sprintf("tx.height/%d/%d/%d", height, height, index)), hash))
+			}
+		}
+	}
+	// the keys of blocks are left as is
+	blockKey := keyForBlock("block.path", "a/1", 1)
+	require.NoError(t, store.Set(blockKey, []byte("1")))
+
+	// the store must be migrated before it is used
+	assert.Error(t, CheckKeysVersion(store))
+
+	migrated, err := MigrateLegacyKeys(store)
+	require.NoError(t, err)
+	assert.Equal(t, 16, migrated)
+	assert.NoError(t, CheckKeysVersion(store))
+
+	indexer := NewTxIndex(store, IndexAllEvents())
+	for _, q := range []string{"account.owner = 'Ivan'", "account.path = 'a/1'", "tx.height >= 1",
+		"account.owner CONTAINS 'Iv'", "account.path CONTAINS 'a/'"} {
+		page, err := indexer.SearchPage(query.MustParse(q), txindex.PageOptions{CountTotal: true})
+		require.NoError(t, err)
+		assert.Equal(t, 6, page.TotalCount, q)
+
+		var positions []txindex.Cursor
+		for _, r := range page.Txs {
+			positions = append(positions, txindex.CursorFor(r))
+		}
+		expected := []txindex.Cursor{
+			{Height: 1, Index: 0}, {Height: 1, Index: 1},
+			{Height: 2, Index: 0}, {Height: 2, Index: 1},
+			{Height: 10, Index: 0}, {Height: 10, Index: 1},
+		}
+		assert.Equal(t, expected, positions, q)
+	}
+
+	value, err := store.Get(blockKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	// the keys are migrated once
+	migrated, err = MigrateLegacyKeys(store)
+	require.NoError(t, err)
+	assert.Zero(t, migrated)
+}
+
+func TestCheckKeysVersionEmptyStore(t *testing.T) {
+	store := db.NewMemDB()
+	require.NoError(t, CheckKeysVersion(store))
+
+	// the empty store was marked as being in the current version
+	indexer := NewTxIndex(store)
+	require.NoError(t, indexer.Index(txResultWithEvents(nil)))
+	assert.NoError(t, CheckKeysVersion(store))
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ txindex.TxIndexer = (*TxIndex)(nil)
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
 
 // TxIndex acts as a /dev/null.
 type TxIndex struct{}
@@ -31,3 +32,20 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
 	return []*types.TxResult{}, nil
 }
+
+// BlockIndex acts as a /dev/null.
+type BlockIndex struct{}
+
+// Has on a BlockIndex is disabled and returns an error when invoked.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	return false, errors.New(`indexing is disabled (set 'tx_index = "kv"' in config)`)
+}
+
+// Index is a noop and always returns nil.
+func (bi *BlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	return nil
+}
+
+func (bi *BlockIndex) Search(q *query.Query) ([]int64, error) {
+	return []int64{}, nil
+}
@@ -0,0 +1,278 @@
+// Package psql implements an indexer backend which writes blocks, transactions
+// and their ABCI events into a PostgreSQL database, so that they can be queried
+// directly with SQL. The database schema is defined in schema.sql, and must be
+// installed by the operator before use.
+package psql
+
+import (
+	"database/sql"
+	"fmt"
+
+	// register the "postgres" database/sql driver
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	driverName = "postgres"
+
+	// eventTypeBlock is the type of the event recorded for every block, with the
+	// block height as attribute, so that block events can be queried by height.
+	eventTypeBlock = "block"
+	// eventTypeTx is the type of the event recorded for every tx, with the tx
+	// hash and height as attributes (i.e. tx.hash and tx.height).
+	eventTypeTx = "tx"
+)
+
+var _ txindex.TxIndexer = (*EventSink)(nil)
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// EventSink is an indexer backend which writes blocks, transactions and their
+// events into a PostgreSQL database. Searching is meant to be done directly
+// against the database, and is not supported by Search.
+type EventSink struct {
+	store   *sql.DB
+	chainID string
+}
+
+// NewEventSink connects to the PostgreSQL database at connStr and returns an
+// EventSink which indexes blocks of the given chain.
+func NewEventSink(connStr, chainID string) (*EventSink, error) {
+	db, err := sql.Open(driverName, connStr)
+	if err != nil {
+		return nil, err
+	}
+	return NewEventSinkFromDB(db, chainID), nil
+}
+
+// NewEventSinkFromDB returns an EventSink which indexes blocks of the given
+// chain into db. The schema must already be installed.
+func NewEventSinkFromDB(db *sql.DB, chainID string) *EventSink {
+	return &EventSink{
+		store:   db,
+		chainID: chainID,
+	}
+}
+
+// Stop closes the underlying database connection.
+func (es *EventSink) Stop() error {
+	return es.store.Close()
+}
+
+// BlockIndexer returns a txindex.BlockIndexer which indexes blocks into the
+// same database as the sink.
+func (es *EventSink) BlockIndexer() *BlockIndex {
+	return &BlockIndex{es: es}
+}
+
+// IndexBlock records the block and its BeginBlock and EndBlock events. Blocks
+// which were already indexed are skipped.
+func (es *EventSink) IndexBlock(h types.EventDataNewBlockHeader) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		var blockID int64
+		err := dbtx.QueryRow(`
+INSERT INTO blocks (height, chain_id, time) VALUES ($1, $2, $3)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;`,
+			h.Header.Height, es.chainID, h.Header.Time).Scan(&blockID)
+		if err == sql.ErrNoRows {
+			return nil // already indexed
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to index block %d", h.Header.Height)
+		}
+
+		heightEvent := abci.Event{
+			Type:       eventTypeBlock,
+			Attributes: []kv.Pair{{Key: []byte("height"), Value: []byte(fmt.Sprint(h.Header.Height))}},
+		}
+		if err := insertEvents(dbtx, blockID, nil, []abci.Event{heightEvent}); err != nil {
+			return err
+		}
+		if err := insertEvents(dbtx, blockID, nil, h.ResultBeginBlock.Events); err != nil {
+			return errors.Wrap(err, "failed to index BeginBlock events")
+		}
+		if err := insertEvents(dbtx, blockID, nil, h.ResultEndBlock.Events); err != nil {
+			return errors.Wrap(err, "failed to index EndBlock events")
+		}
+		return nil
+	})
+}
+
+// AddBatch indexes a batch of txs and their events in a single database
+// transaction. The block containing the txs must already be indexed.
+func (es *EventSink) AddBatch(b *txindex.Batch) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		for _, result := range b.Ops {
+			if err := es.indexTx(dbtx, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Index indexes a single tx and its events. The block containing the tx must
+// already be indexed.
+func (es *EventSink) Index(result *types.TxResult) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		return es.indexTx(dbtx, result)
+	})
+}
+
+// Get returns the tx with the given hash, or nil if it is not indexed. If the
+// same tx was included in several blocks, the one with the highest height is
+// returned, like the kv indexer which keeps the last one indexed.
+func (es *EventSink) Get(hash []byte) (*types.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var resultData []byte
+	err := es.store.QueryRow(`
+SELECT tx_result FROM tx_results JOIN blocks ON (blocks.rowid = tx_results.block_id)
+  WHERE tx_hash = $1 AND chain_id = $2
+  ORDER BY blocks.height DESC, tx_results.index DESC LIMIT 1;`,
+		fmt.Sprintf("%X", hash), es.chainID).Scan(&resultData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	txResult := new(types.TxResult)
+	if err := cdc.UnmarshalBinaryBare(resultData, txResult); err != nil {
+		return nil, errors.Wrap(err, "error reading TxResult")
+	}
+	return txResult, nil
+}
+
+// Search is not supported; the database should be queried directly instead.
+func (es *EventSink) Search(q *query.Query) ([]*types.TxResult, error) {
+	return nil, errors.New("search is not supported by the psql indexer, query the database directly")
+}
+
+// HasBlock returns true if the block at the given height has been indexed.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var exists bool
+	err := es.store.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM blocks WHERE height = $1 AND chain_id = $2);`,
+		height, es.chainID).Scan(&exists)
+	return exists, err
+}
+
+func (es *EventSink) indexTx(dbtx *sql.Tx, result *types.TxResult) error {
+	var blockID int64
+	err := dbtx.QueryRow(`
+SELECT rowid FROM blocks WHERE height = $1 AND chain_id = $2;`,
+		result.Height, es.chainID).Scan(&blockID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find block %d", result.Height)
+	}
+
+	resultData, err := cdc.MarshalBinaryBare(result)
+	if err != nil {
+		return err
+	}
+	txHash := fmt.Sprintf("%X", types.Tx(result.Tx).Hash())
+
+	var txID int64
+	err = dbtx.QueryRow(`
+INSERT INTO tx_results (block_id, index, tx_hash, tx_result) VALUES ($1, $2, $3, $4)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;`,
+		blockID, result.Index, txHash, resultData).Scan(&txID)
+	if err == sql.ErrNoRows {
+		return nil // already indexed
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to index tx %s", txHash)
+	}
+
+	txEvent := abci.Event{
+		Type: eventTypeTx,
+		Attributes: []kv.Pair{
+			{Key: []byte("hash"), Value: []byte(txHash)},
+			{Key: []byte("height"), Value: []byte(fmt.Sprint(result.Height))},
+		},
+	}
+	if err := insertEvents(dbtx, blockID, &txID, []abci.Event{txEvent}); err != nil {
+		return err
+	}
+	if err := insertEvents(dbtx, blockID, &txID, result.Result.Events); err != nil {
+		return errors.Wrapf(err, "failed to index events of tx %s", txHash)
+	}
+	return nil
+}
+
+// BlockIndex is a txindex.BlockIndexer backed by an EventSink.
+type BlockIndex struct {
+	es *EventSink
+}
+
+// Has returns true if the block at the given height has been indexed.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	return bi.es.HasBlock(height)
+}
+
+// Index records the block and its BeginBlock and EndBlock events.
+func (bi *BlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	return bi.es.IndexBlock(header)
+}
+
+// Search is not supported; the database should be queried directly instead.
+func (bi *BlockIndex) Search(q *query.Query) ([]int64, error) {
+	return nil, errors.New("search is not supported by the psql indexer, query the database directly")
+}
+
+// insertEvents inserts the given events and their attributes. txID is nil for
+// block events. Events with an empty type and attributes with an empty key are
+// skipped.
+func insertEvents(dbtx *sql.Tx, blockID int64, txID *int64, events []abci.Event) error {
+	for _, event := range events {
+		if event.Type == "" {
+			continue
+		}
+
+		var eventID int64
+		err := dbtx.QueryRow(`
+INSERT INTO events (block_id, tx_id, type) VALUES ($1, $2, $3)
+  RETURNING rowid;`,
+			blockID, txID, event.Type).Scan(&eventID)
+		if err != nil {
+			return err
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+			compositeKey := event.Type + "." + string(attr.Key)
+			_, err := dbtx.Exec(`
+INSERT INTO attributes (event_id, key, composite_key, value) VALUES ($1, $2, $3, $4);`,
+				eventID, string(attr.Key), compositeKey, string(attr.Value))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runInTransaction runs query in a database transaction, which is committed if
+// query succeeds and rolled back otherwise.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the original error
+		return err
+	}
+	return dbtx.Commit()
+}
@@ -0,0 +1,168 @@
+package psql
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const chainID = "test-chain"
+
+// newTestEventSink returns an EventSink backed by a mock database.
+func newTestEventSink(t *testing.T) (*EventSink, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return NewEventSinkFromDB(db, chainID), mock
+}
+
+func expectEvent(mock sqlmock.Sqlmock, blockID int64, txID interface{}, eventType string, eventID int64,
+	attrs ...string) {
+	mock.ExpectQuery("INSERT INTO events").
+		WithArgs(blockID, txID, eventType).
+		WillReturnRows(sqlmock.NewRows([]string{"rowid"}).AddRow(eventID))
+	for i := 0; i < len(attrs); i += 2 {
+		mock.ExpectExec("INSERT INTO attributes").
+			WithArgs(eventID, attrs[i], eventType+"."+attrs[i], attrs[i+1]).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+}
+
+func TestEventSinkIndexBlock(t *testing.T) {
+	sink, mock := newTestEventSink(t)
+	now := time.Now().UTC()
+	header := types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 3, Time: now},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{
+			{Type: "reward", Attributes: []kv.Pair{
+				{Key: []byte("amount"), Value: []byte("10")},
+				{Key: []byte(""), Value: []byte("skipped")},
+			}},
+			{Type: "", Attributes: []kv.Pair{{Key: []byte("skipped"), Value: []byte("skipped")}}},
+		}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{
+			{Type: "slash", Attributes: []kv.Pair{{Key: []byte("validator"), Value: []byte("v1")}}},
+		}},
+	}
+
+	var nilTxID *int64
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO blocks").
+		WithArgs(int64(3), chainID, now).
+		WillReturnRows(sqlmock.NewRows([]string{"rowid"}).AddRow(7))
+	expectEvent(mock, 7, nilTxID, "block", 1, "height", "3")
+	expectEvent(mock, 7, nilTxID, "reward", 2, "amount", "10")
+	expectEvent(mock, 7, nilTxID, "slash", 3, "validator", "v1")
+	mock.ExpectCommit()
+
+	require.NoError(t, sink.IndexBlock(header))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// indexing an existing block is a noop
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO blocks").
+		WithArgs(int64(3), chainID, now).
+		WillReturnRows(sqlmock.NewRows([]string{"rowid"}))
+	mock.ExpectCommit()
+
+	require.NoError(t, sink.IndexBlock(header))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(int64(3), chainID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	has, err := sink.BlockIndexer().Has(3)
+	require.NoError(t, err)
+	assert.True(t, has)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventSinkAddBatch(t *testing.T) {
+	sink, mock := newTestEventSink(t)
+	txResult := &types.TxResult{
+		Height: 3,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ResponseDeliverTx{
+			Events: []abci.Event{
+				{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}},
+			},
+		},
+	}
+	txHash := fmt.Sprintf("%X", txResult.Tx.Hash())
+	resultData, err := cdc.MarshalBinaryBare(txResult)
+	require.NoError(t, err)
+
+	batch := txindex.NewBatch(1)
+	require.NoError(t, batch.Add(txResult))
+
+	txID := int64(11)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT rowid FROM blocks").
+		WithArgs(int64(3), chainID).
+		WillReturnRows(sqlmock.NewRows([]string{"rowid"}).AddRow(7))
+	mock.ExpectQuery("INSERT INTO tx_results").
+		WithArgs(int64(7), uint32(0), txHash, resultData).
+		WillReturnRows(sqlmock.NewRows([]string{"rowid"}).AddRow(txID))
+	expectEvent(mock, 7, &txID, "tx", 1, "hash", txHash, "height", "3")
+	expectEvent(mock, 7, &txID, "account", 2, "number", "1")
+	mock.ExpectCommit()
+
+	require.NoError(t, sink.AddBatch(batch))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// txs of a block which was not indexed are rolled back
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT rowid FROM blocks").
+		WithArgs(int64(3), chainID).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	assert.Error(t, sink.Index(txResult))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventSinkGet(t *testing.T) {
+	sink, mock := newTestEventSink(t)
+	txResult := &types.TxResult{
+		Height: 3,
+		Index:  1,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK, Log: "", Events: nil},
+	}
+	resultData, err := cdc.MarshalBinaryBare(txResult)
+	require.NoError(t, err)
+	hash := txResult.Tx.Hash()
+
+	// the tx from the highest block is returned, if it was included in several
+	mock.ExpectQuery(`(?s)SELECT tx_result FROM tx_results.*ORDER BY blocks.height DESC`).
+		WithArgs(fmt.Sprintf("%X", hash), chainID).
+		WillReturnRows(sqlmock.NewRows([]string{"tx_result"}).AddRow(resultData))
+	loaded, err := sink.Get(hash)
+	require.NoError(t, err)
+	assert.Equal(t, txResult, loaded)
+
+	mock.ExpectQuery("SELECT tx_result FROM tx_results").
+		WithArgs(fmt.Sprintf("%X", hash), chainID).
+		WillReturnRows(sqlmock.NewRows([]string{"tx_result"}))
+	loaded, err = sink.Get(hash)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+
+	_, err = sink.Get(nil)
+	assert.Equal(t, txindex.ErrorEmptyHash, err)
+
+	_, err = sink.Search(nil)
+	assert.Error(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -122,7 +122,8 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 	}
 
 	// Limit the amount of evidence
-	maxNumEvidence, _ := types.MaxEvidencePerBlock(state.ConsensusParams.Block.MaxBytes)
+	maxNumEvidence, _ := types.MaxEvidencePerBlock(state.ConsensusParams.Block.MaxBytes,
+		state.ConsensusParams.Validator)
 	numEvidence := int64(len(block.Evidence.Evidence))
 	if numEvidence > maxNumEvidence {
 		return types.NewErrEvidenceOverflow(maxNumEvidence, numEvidence)
@@ -152,12 +153,9 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 	return nil
 }
 
-// VerifyEvidence verifies the evidence fully by checking:
-// - it is sufficiently recent (MaxAge)
-// - it is from a key who was a validator at the given height
-// - it is internally consistent
-// - it was properly signed by the alleged equivocator
-func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error {
+// VerifyEvidenceAge returns an error if the evidence is not sufficiently recent
+// (MaxAge) to be committed after the given state.
+func VerifyEvidenceAge(state State, evidence types.Evidence) error {
 	var (
 		height         = state.LastBlockHeight
 		evidenceParams = state.ConsensusParams.Evidence
@@ -175,6 +173,24 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error
 			evidence.Time(), state.LastBlockTime.Add(evidenceParams.MaxAgeDuration))
 	}
 
+	return nil
+}
+
+// VerifyEvidence verifies the evidence fully by checking:
+// - it is sufficiently recent (MaxAge)
+// - it is from a key who was a validator at the given height
+// - it is internally consistent
+// - it was properly signed by the alleged equivocator
+func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error {
+	// Composite evidence is split up by the evidence pool, and never committed.
+	if _, ok := evidence.(types.CompositeEvidence); ok {
+		return fmt.Errorf("composite evidence %v must be split before it is verified", evidence)
+	}
+
+	if err := VerifyEvidenceAge(state, evidence); err != nil {
+		return err
+	}
+
 	valset, err := LoadValidators(stateDB, evidence.Height())
 	if err != nil {
 		// TODO: if err is just that we cant find it cuz we pruned, ignore.
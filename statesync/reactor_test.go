@@ -0,0 +1,123 @@
+package statesync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p/mock"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+// servingApp is an ABCI app which serves the given snapshots, and chunks for the snapshot at
+// height 1.
+type servingApp struct {
+	abci.BaseApplication
+	snapshots []*abci.Snapshot
+	chunks    map[uint32][]byte
+}
+
+func (app *servingApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseListSnapshots {
+	return abci.ResponseListSnapshots{Snapshots: app.snapshots}
+}
+
+func (app *servingApp) LoadSnapshotChunk(req abci.RequestLoadSnapshotChunk) abci.ResponseLoadSnapshotChunk {
+	if req.Height != 1 {
+		return abci.ResponseLoadSnapshotChunk{}
+	}
+	return abci.ResponseLoadSnapshotChunk{Chunk: app.chunks[req.Chunk]}
+}
+
+// recordingPeer is a peer which records the messages sent to it.
+type recordingPeer struct {
+	*mock.Peer
+	msgs []Message
+}
+
+func (p *recordingPeer) Send(chID byte, msgBytes []byte) bool {
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		panic(err)
+	}
+	p.msgs = append(p.msgs, msg)
+	return true
+}
+
+func setupReactor(t *testing.T, app abci.Application) *Reactor {
+	client := abcicli.NewLocalClient(nil, app)
+	r := NewReactor(proxy.NewAppConnSnapshot(client), proxy.NewAppConnQuery(client), "")
+	r.SetLogger(log.TestingLogger())
+	require.NoError(t, r.Start())
+	return r
+}
+
+func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
+	app := &servingApp{}
+	for height := uint64(1); height <= recentSnapshots+2; height++ {
+		app.snapshots = append(app.snapshots, &abci.Snapshot{Height: height, Format: 1, Chunks: 1, Hash: []byte{1}})
+	}
+	app.snapshots = append(app.snapshots, &abci.Snapshot{Height: 12, Format: 2, Chunks: 1, Hash: []byte{2}})
+	r := setupReactor(t, app)
+	defer r.Stop() // nolint: errcheck
+
+	peer := &recordingPeer{Peer: mock.NewPeer(nil)}
+	r.Receive(SnapshotChannel, peer, cdc.MustMarshalBinaryBare(&snapshotsRequestMessage{}))
+
+	// the most recent snapshots are advertised, newest first
+	require.Len(t, peer.msgs, recentSnapshots)
+	assert.Equal(t, &snapshotsResponseMessage{Height: 12, Format: 2, Chunks: 1, Hash: []byte{2}}, peer.msgs[0])
+	assert.Equal(t, &snapshotsResponseMessage{Height: 12, Format: 1, Chunks: 1, Hash: []byte{1}}, peer.msgs[1])
+	assert.Equal(t, &snapshotsResponseMessage{Height: 4, Format: 1, Chunks: 1, Hash: []byte{1}},
+		peer.msgs[recentSnapshots-1])
+}
+
+func TestReactor_Receive_ChunkRequest(t *testing.T) {
+	r := setupReactor(t, &servingApp{chunks: map[uint32][]byte{0: {1, 2, 3}}})
+	defer r.Stop() // nolint: errcheck
+
+	testcases := map[string]struct {
+		request  *chunkRequestMessage
+		response *chunkResponseMessage
+	}{
+		"chunk is returned": {
+			&chunkRequestMessage{Height: 1, Format: 1, Index: 0},
+			&chunkResponseMessage{Height: 1, Format: 1, Index: 0, Chunk: []byte{1, 2, 3}},
+		},
+		"missing chunk": {
+			&chunkRequestMessage{Height: 1, Format: 1, Index: 1},
+			&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Missing: true},
+		},
+		"missing snapshot": {
+			&chunkRequestMessage{Height: 2, Format: 1, Index: 0},
+			&chunkResponseMessage{Height: 2, Format: 1, Index: 0, Missing: true},
+		},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			peer := &recordingPeer{Peer: mock.NewPeer(nil)}
+			r.Receive(ChunkChannel, peer, cdc.MustMarshalBinaryBare(tc.request))
+			require.Len(t, peer.msgs, 1)
+			assert.Equal(t, tc.response, peer.msgs[0])
+		})
+	}
+}
+
+func TestReactor_Receive_NoSyncInProgress(t *testing.T) {
+	r := setupReactor(t, &servingApp{})
+	defer r.Stop() // nolint: errcheck
+
+	// snapshots and chunks are ignored when not syncing
+	peer := &recordingPeer{Peer: mock.NewPeer(nil)}
+	r.Receive(SnapshotChannel, peer, cdc.MustMarshalBinaryBare(&snapshotsResponseMessage{
+		Height: 1, Format: 1, Chunks: 1, Hash: []byte{1},
+	}))
+	r.Receive(ChunkChannel, peer, cdc.MustMarshalBinaryBare(&chunkResponseMessage{
+		Height: 1, Format: 1, Index: 0, Chunk: []byte{1},
+	}))
+	assert.Empty(t, peer.msgs)
+}
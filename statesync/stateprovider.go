@@ -0,0 +1,295 @@
+package statesync
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
+	liteprovider "github.com/tendermint/tendermint/lite2/provider"
+	litedb "github.com/tendermint/tendermint/lite2/store/db"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// StateProvider is a provider of trusted state data for bootstrapping a node. This refers
+// to the state.State object, not the state machine.
+type StateProvider interface {
+	// AppHash returns the app hash after the given height has been committed.
+	AppHash(height uint64) ([]byte, error)
+	// Commit returns the commit at the given height.
+	Commit(height uint64) (*types.Commit, error)
+	// State returns a state object at the given height.
+	State(height uint64) (sm.State, error)
+}
+
+// lightClientStateProvider is a state provider using the light client.
+type lightClientStateProvider struct {
+	sync.Mutex // lite.Client is not concurrency-safe
+	lc         *lite.Client
+	version    sm.Version
+}
+
+// NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
+// At least two RPC servers must be given: the first one is used as the primary, the rest as
+// witnesses. Validator sets and consensus params are fetched from the primary RPC server and
+// checked against the verified headers.
+func NewLightClientStateProvider(
+	chainID string,
+	version sm.Version,
+	servers []string,
+	trustOptions lite.TrustOptions,
+	logger log.Logger,
+) (StateProvider, error) {
+	if len(servers) < 2 {
+		return nil, errors.Errorf("at least 2 RPC servers are required, got %v", len(servers))
+	}
+
+	providers := make([]liteprovider.Provider, 0, len(servers))
+	for _, server := range servers {
+		provider, err := newRPCProvider(chainID, server)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to set up RPC provider for %v", server)
+		}
+		providers = append(providers, provider)
+	}
+
+	lc, err := lite.NewClient(chainID, trustOptions, providers[0], providers[1:],
+		litedb.New(dbm.NewMemDB(), ""), lite.Logger(logger), lite.MaxRetryAttempts(5))
+	if err != nil {
+		return nil, err
+	}
+	return &lightClientStateProvider{
+		lc:      lc,
+		version: version,
+	}, nil
+}
+
+// AppHash implements StateProvider.
+func (s *lightClientStateProvider) AppHash(height uint64) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// We have to fetch the next height, which contains the app hash for the previous height.
+	header, err := s.verifyHeader(int64(height+1), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *lightClientStateProvider) Commit(height uint64) (*types.Commit, error) {
+	s.Lock()
+	defer s.Unlock()
+	header, err := s.verifyHeader(int64(height), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	state := sm.State{
+		ChainID: s.lc.ChainID(),
+		Version: s.version,
+	}
+
+	// We need to verify up until h+2, to get the validator set. This also prefetches the headers
+	// for h and h+1 in the typical case where the trusted header is after the snapshot height.
+	now := time.Now()
+	lastHeader, err := s.verifyHeader(int64(height+2), now)
+	if err != nil {
+		return sm.State{}, err
+	}
+	header, err := s.verifyHeader(int64(height), now)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextHeader, err := s.verifyHeader(int64(height+1), now)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	state.LastBlockHeight = header.Height
+	state.LastBlockTime = header.Time
+	state.LastBlockID = header.Commit.BlockID
+	state.AppHash = nextHeader.AppHash
+	state.LastResultsHash = nextHeader.LastResultsHash
+
+	// Validator sets and consensus params are fetched from the primary, and checked against the
+	// hashes of the verified headers.
+	primary, ok := s.lc.Primary().(*rpcProvider)
+	if !ok {
+		return sm.State{}, errors.Errorf("unexpected light client primary %T", s.lc.Primary())
+	}
+	state.LastValidators, err = primary.verifiedValidatorSet(header.Height, header.ValidatorsHash)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state.Validators, err = primary.verifiedValidatorSet(nextHeader.Height, nextHeader.ValidatorsHash)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state.NextValidators, err = primary.verifiedValidatorSet(lastHeader.Height, lastHeader.ValidatorsHash)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state.LastHeightValidatorsChanged = lastHeader.Height
+
+	state.ConsensusParams, err = primary.verifiedConsensusParams(nextHeader.Height, nextHeader.ConsensusHash)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state.LastHeightConsensusParamsChanged = nextHeader.Height
+
+	return state, nil
+}
+
+// verifyHeader verifies a header at the given height using the light client, returning a
+// previously verified header if it has already been trusted. The caller must hold the mutex lock.
+func (s *lightClientStateProvider) verifyHeader(height int64, now time.Time) (*types.SignedHeader, error) {
+	lastHeight, err := s.lc.LastTrustedHeight()
+	if err != nil {
+		return nil, err
+	}
+	if height <= lastHeight {
+		return s.lc.TrustedHeader(height, now)
+	}
+	return s.lc.VerifyHeaderAtHeight(height, now)
+}
+
+//-----------------------------------------------------------------------------
+
+// rpcProvider is a light client provider backed by a JSON-RPC client. Unlike the light client's
+// HTTP provider it preserves validator proposer priorities, which are needed to bootstrap
+// consensus, and it does not depend on the rpc/client package (which depends on the node).
+type rpcProvider struct {
+	chainID string
+	client  *rpcclient.JSONRPCClient
+}
+
+var _ liteprovider.Provider = (*rpcProvider)(nil)
+
+// newRPCProvider creates a new rpcProvider for the given server address.
+func newRPCProvider(chainID, server string) (*rpcProvider, error) {
+	if !strings.Contains(server, "://") {
+		server = "http://" + server
+	}
+	client, err := rpcclient.NewJSONRPCClient(server)
+	if err != nil {
+		return nil, err
+	}
+	cdc := client.Codec()
+	ctypes.RegisterAmino(cdc)
+	client.SetCodec(cdc)
+	return &rpcProvider{chainID: chainID, client: client}, nil
+}
+
+// ChainID implements provider.Provider.
+func (p *rpcProvider) ChainID() string {
+	return p.chainID
+}
+
+// SignedHeader implements provider.Provider.
+func (p *rpcProvider) SignedHeader(height int64) (*types.SignedHeader, error) {
+	result := new(ctypes.ResultCommit)
+	_, err := p.client.Call("commit", map[string]interface{}{"height": heightPtr(height)}, result)
+	if err != nil {
+		if strings.Contains(err.Error(), "height must be less than or equal") {
+			return nil, liteprovider.ErrSignedHeaderNotFound
+		}
+		return nil, err
+	}
+	if result.Header.ChainID != p.chainID {
+		return nil, errors.Errorf("expected chainID %s, got %s", p.chainID, result.Header.ChainID)
+	}
+	return &result.SignedHeader, nil
+}
+
+// ValidatorSet implements provider.Provider. Proposer priorities are preserved.
+func (p *rpcProvider) ValidatorSet(height int64) (*types.ValidatorSet, error) {
+	const maxPerPage = 100
+	var vals []*types.Validator
+	for page := 1; ; page++ {
+		result := new(ctypes.ResultValidators)
+		_, err := p.client.Call("validators", map[string]interface{}{
+			"height":   heightPtr(height),
+			"page":     page,
+			"per_page": maxPerPage,
+		}, result)
+		if err != nil {
+			switch {
+			case strings.Contains(err.Error(), "height must be less than or equal"):
+				return nil, liteprovider.ErrValidatorSetNotFound
+			case page > 1:
+				// The RPC server errors on pages past the end, which happens when the last page
+				// was full. Callers verify the validator set hash against a trusted header.
+				return types.ValidatorSetFromExistingValidators(vals)
+			default:
+				return nil, err
+			}
+		}
+		vals = append(vals, result.Validators...)
+		if len(result.Validators) < maxPerPage {
+			break
+		}
+	}
+	return types.ValidatorSetFromExistingValidators(vals)
+}
+
+// ReportEvidence implements provider.Provider.
+func (p *rpcProvider) ReportEvidence(ev types.Evidence) error {
+	result := new(ctypes.ResultBroadcastEvidence)
+	_, err := p.client.Call("broadcast_evidence", map[string]interface{}{"evidence": ev}, result)
+	return err
+}
+
+// verifiedValidatorSet fetches the validator set at the given height and checks that it matches
+// the given trusted validators hash.
+func (p *rpcProvider) verifiedValidatorSet(height int64, hash []byte) (*types.ValidatorSet, error) {
+	valSet, err := p.ValidatorSet(height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to fetch validators for height %v", height)
+	}
+	if !bytes.Equal(valSet.Hash(), hash) {
+		return nil, errors.Errorf("validator set for height %v does not match header hash %X",
+			height, hash)
+	}
+	return valSet, nil
+}
+
+// verifiedConsensusParams fetches the consensus params at the given height and checks that they
+// match the given trusted consensus hash.
+func (p *rpcProvider) verifiedConsensusParams(height int64, hash []byte) (types.ConsensusParams, error) {
+	result := new(ctypes.ResultConsensusParams)
+	_, err := p.client.Call("consensus_params", map[string]interface{}{"height": &height}, result)
+	if err != nil {
+		return types.ConsensusParams{}, errors.Wrapf(err,
+			"unable to fetch consensus parameters for height %v", height)
+	}
+	if !bytes.Equal(result.ConsensusParams.Hash(), hash) {
+		return types.ConsensusParams{}, errors.Errorf(
+			"consensus parameters for height %v do not match header hash %X", height, hash)
+	}
+	return result.ConsensusParams, nil
+}
+
+// heightPtr converts a provider height to an RPC height parameter, where 0 means latest.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
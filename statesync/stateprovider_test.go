@@ -0,0 +1,129 @@
+package statesync
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/libs/log"
+	liteprovider "github.com/tendermint/tendermint/lite2/provider"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const testRPCHeight = 10
+
+// startTestRPCServer starts an RPC server for the given chain at height testRPCHeight, with the
+// given validators at all heights, and returns an rpcProvider for it.
+func startTestRPCServer(t *testing.T, chainID string, valSet *types.ValidatorSet) (*rpcProvider, func()) {
+	checkHeight := func(heightPtr *int64) error {
+		if heightPtr != nil && *heightPtr > testRPCHeight {
+			return errors.New("height must be less than or equal to the current blockchain height")
+		}
+		return nil
+	}
+	routes := map[string]*rpcserver.RPCFunc{
+		"commit": rpcserver.NewRPCFunc(func(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
+			if err := checkHeight(heightPtr); err != nil {
+				return nil, err
+			}
+			header := &types.Header{ChainID: chainID, Height: *heightPtr}
+			return ctypes.NewResultCommit(header, &types.Commit{Height: *heightPtr}, true), nil
+		}, "height"),
+		"validators": rpcserver.NewRPCFunc(func(ctx *rpctypes.Context, heightPtr *int64, page, perPage int) (
+			*ctypes.ResultValidators, error) {
+			if err := checkHeight(heightPtr); err != nil {
+				return nil, err
+			}
+			skip := (page - 1) * perPage
+			if skip >= valSet.Size() {
+				return nil, errors.New("page should be within [1, 1] range")
+			}
+			end := skip + perPage
+			if end > valSet.Size() {
+				end = valSet.Size()
+			}
+			return &ctypes.ResultValidators{BlockHeight: *heightPtr, Validators: valSet.Validators[skip:end]}, nil
+		}, "height,page,per_page"),
+		"consensus_params": rpcserver.NewRPCFunc(func(ctx *rpctypes.Context, heightPtr *int64) (
+			*ctypes.ResultConsensusParams, error) {
+			return &ctypes.ResultConsensusParams{
+				BlockHeight:     *heightPtr,
+				ConsensusParams: *types.DefaultConsensusParams(),
+			}, nil
+		}, "height"),
+	}
+	cdc := amino.NewCodec()
+	ctypes.RegisterAmino(cdc)
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, routes, cdc, log.TestingLogger())
+	srv := httptest.NewServer(mux)
+
+	provider, err := newRPCProvider(chainID, srv.URL)
+	require.NoError(t, err)
+	return provider, srv.Close
+}
+
+func TestRPCProvider_SignedHeader(t *testing.T) {
+	valSet, _ := types.RandValidatorSet(1, 10)
+	provider, stop := startTestRPCServer(t, "test-chain", valSet)
+	defer stop()
+
+	sh, err := provider.SignedHeader(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sh.Height)
+
+	_, err = provider.SignedHeader(testRPCHeight + 1)
+	assert.Equal(t, liteprovider.ErrSignedHeaderNotFound, err)
+
+	// headers of other chains are rejected
+	provider.chainID = "other-chain"
+	_, err = provider.SignedHeader(3)
+	assert.Error(t, err)
+}
+
+func TestRPCProvider_ValidatorSet(t *testing.T) {
+	// validator sets are paged by 100
+	for _, size := range []int{1, 100, 150} {
+		valSet, _ := types.RandValidatorSet(size, 10)
+		valSet.IncrementProposerPriority(3)
+		provider, stop := startTestRPCServer(t, "test-chain", valSet)
+
+		fetched, err := provider.ValidatorSet(3)
+		require.NoError(t, err, "size %v", size)
+		assert.Equal(t, valSet.Hash(), fetched.Hash(), "size %v", size)
+		// proposer priorities are preserved
+		for i, val := range valSet.Validators {
+			assert.Equal(t, val.ProposerPriority, fetched.Validators[i].ProposerPriority, "size %v", size)
+		}
+
+		_, err = provider.ValidatorSet(testRPCHeight + 1)
+		assert.Equal(t, liteprovider.ErrValidatorSetNotFound, err, "size %v", size)
+		stop()
+	}
+}
+
+func TestRPCProvider_Verified(t *testing.T) {
+	valSet, _ := types.RandValidatorSet(3, 10)
+	provider, stop := startTestRPCServer(t, "test-chain", valSet)
+	defer stop()
+
+	fetched, err := provider.verifiedValidatorSet(3, valSet.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, valSet.Hash(), fetched.Hash())
+	_, err = provider.verifiedValidatorSet(3, []byte("other hash"))
+	assert.Error(t, err)
+
+	params, err := provider.verifiedConsensusParams(3, types.DefaultConsensusParams().Hash())
+	require.NoError(t, err)
+	assert.Equal(t, *types.DefaultConsensusParams(), params)
+	_, err = provider.verifiedConsensusParams(3, []byte("other hash"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,218 @@
+package statesync
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/mock"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+// testSnapshotApp is an ABCI app which restores snapshots, replying to offers and applied chunks
+// with the given results (accepting them by default).
+type testSnapshotApp struct {
+	abci.BaseApplication
+
+	mtx          sync.Mutex
+	offerResults []abci.ResponseOfferSnapshot_Result
+	applyResults []abci.ResponseApplySnapshotChunk_Result
+	offered      []uint64 // heights of the offered snapshots
+	applied      []uint32 // indexes of the applied chunks
+	appHash      []byte
+	height       int64
+	appVersion   uint64
+}
+
+func (app *testSnapshotApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return abci.ResponseInfo{
+		LastBlockHeight:  app.height,
+		LastBlockAppHash: app.appHash,
+		AppVersion:       app.appVersion,
+	}
+}
+
+func (app *testSnapshotApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.offered = append(app.offered, req.Snapshot.Height)
+	result := abci.ResponseOfferSnapshot_ACCEPT
+	if len(app.offerResults) > 0 {
+		result, app.offerResults = app.offerResults[0], app.offerResults[1:]
+	}
+	if result == abci.ResponseOfferSnapshot_ACCEPT {
+		app.height = int64(req.Snapshot.Height)
+	}
+	return abci.ResponseOfferSnapshot{Result: result}
+}
+
+func (app *testSnapshotApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.applied = append(app.applied, req.Index)
+	result := abci.ResponseApplySnapshotChunk_ACCEPT
+	if len(app.applyResults) > 0 {
+		result, app.applyResults = app.applyResults[0], app.applyResults[1:]
+	}
+	return abci.ResponseApplySnapshotChunk{Result: result}
+}
+
+// chunkPeer is a peer which replies to chunk requests with the given chunks.
+type chunkPeer struct {
+	*mock.Peer
+	syncer *syncer
+	chunks [][]byte
+}
+
+var _ p2p.Peer = (*chunkPeer)(nil)
+
+func (p *chunkPeer) Send(chID byte, msgBytes []byte) bool {
+	if chID != ChunkChannel {
+		return true
+	}
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		panic(err)
+	}
+	req := msg.(*chunkRequestMessage)
+	go p.syncer.AddChunk(&chunk{ // nolint: errcheck
+		Height: req.Height,
+		Format: req.Format,
+		Index:  req.Index,
+		Chunk:  p.chunks[req.Index],
+		Sender: p.ID(),
+	})
+	return true
+}
+
+func setupSyncer(t *testing.T, app *testSnapshotApp) (*syncer, string, func()) {
+	tempDir, err := ioutil.TempDir("", "syncer")
+	require.NoError(t, err)
+
+	client := abcicli.NewLocalClient(nil, app)
+	stateProvider := &testStateProvider{appHash: app.appHash}
+	s := newSyncer(log.TestingLogger(), proxy.NewAppConnSnapshot(client), proxy.NewAppConnQuery(client),
+		stateProvider, tempDir)
+	return s, tempDir, func() { os.RemoveAll(tempDir) }
+}
+
+func addSnapshots(t *testing.T, s *syncer, heights ...uint64) {
+	peer := &chunkPeer{Peer: mock.NewPeer(nil), syncer: s, chunks: [][]byte{{1}, {2}, {3}}}
+	for _, height := range heights {
+		added, err := s.AddSnapshot(peer, &snapshot{Height: height, Format: 1, Chunks: 3, Hash: []byte{1}})
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+}
+
+func assertEmptyDir(t *testing.T, dir string) {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "expected the chunk queues to be closed")
+}
+
+func TestSyncer_SyncAny(t *testing.T) {
+	app := &testSnapshotApp{appHash: []byte("app_hash"), appVersion: 9}
+	s, tempDir, cleanup := setupSyncer(t, app)
+	defer cleanup()
+	addSnapshots(t, s, 1, 2)
+
+	state, commit, err := s.SyncAny(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, state.LastBlockHeight)
+	assert.EqualValues(t, 9, state.Version.Consensus.App)
+	assert.EqualValues(t, 2, commit.Height)
+	assert.Equal(t, []uint64{2}, app.offered)
+	assert.Equal(t, []uint32{0, 1, 2}, app.applied)
+	assertEmptyDir(t, tempDir)
+}
+
+func TestSyncer_SyncAny_NoSnapshots(t *testing.T) {
+	s, _, cleanup := setupSyncer(t, &testSnapshotApp{})
+	defer cleanup()
+
+	_, _, err := s.SyncAny(0)
+	assert.Equal(t, errNoSnapshots, err)
+}
+
+func TestSyncer_SyncAny_RejectSnapshot(t *testing.T) {
+	app := &testSnapshotApp{
+		appHash:      []byte("app_hash"),
+		offerResults: []abci.ResponseOfferSnapshot_Result{abci.ResponseOfferSnapshot_REJECT},
+		applyResults: []abci.ResponseApplySnapshotChunk_Result{
+			abci.ResponseApplySnapshotChunk_ACCEPT, abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT,
+		},
+	}
+	s, tempDir, cleanup := setupSyncer(t, app)
+	defer cleanup()
+	addSnapshots(t, s, 1, 2, 3)
+
+	// the snapshot at height 3 is rejected when offered, the one at height 2 after applying chunks
+	state, _, err := s.SyncAny(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, state.LastBlockHeight)
+	assert.Equal(t, []uint64{3, 2, 1}, app.offered)
+	assert.Equal(t, []uint32{0, 1, 0, 1, 2}, app.applied)
+	assertEmptyDir(t, tempDir)
+}
+
+func TestSyncer_SyncAny_RetrySnapshot(t *testing.T) {
+	app := &testSnapshotApp{
+		appHash: []byte("app_hash"),
+		applyResults: []abci.ResponseApplySnapshotChunk_Result{
+			abci.ResponseApplySnapshotChunk_ACCEPT, abci.ResponseApplySnapshotChunk_RETRY_SNAPSHOT,
+		},
+	}
+	s, tempDir, cleanup := setupSyncer(t, app)
+	defer cleanup()
+	addSnapshots(t, s, 1)
+
+	// the snapshot is offered again, with the chunks already fetched
+	state, _, err := s.SyncAny(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, state.LastBlockHeight)
+	assert.Equal(t, []uint64{1, 1}, app.offered)
+	assert.Equal(t, []uint32{0, 1, 0, 1, 2}, app.applied)
+	assertEmptyDir(t, tempDir)
+}
+
+func TestSyncer_SyncAny_Abort(t *testing.T) {
+	app := &testSnapshotApp{
+		appHash:      []byte("app_hash"),
+		offerResults: []abci.ResponseOfferSnapshot_Result{abci.ResponseOfferSnapshot_ABORT},
+	}
+	s, tempDir, cleanup := setupSyncer(t, app)
+	defer cleanup()
+	addSnapshots(t, s, 1, 2)
+
+	_, _, err := s.SyncAny(0)
+	assert.Equal(t, errAbort, err)
+	assert.Equal(t, []uint64{2}, app.offered)
+	assertEmptyDir(t, tempDir)
+}
+
+func TestSyncer_SyncAny_VerifyFailed(t *testing.T) {
+	app := &testSnapshotApp{appHash: []byte("app_hash")}
+	s, tempDir, cleanup := setupSyncer(t, app)
+	defer cleanup()
+	addSnapshots(t, s, 1)
+
+	// the app ends up with a different app hash than the trusted one
+	app.appHash = []byte("other_hash")
+
+	_, _, err := s.SyncAny(0)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, errVerifyFailed), "unexpected error %v", err)
+	assertEmptyDir(t, tempDir)
+}
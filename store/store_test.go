@@ -66,7 +66,7 @@ func makeStateAndBlockStore(logger log.Logger) (sm.State, *BlockStore, cleanupFu
 func TestLoadBlockStoreStateJSON(t *testing.T) {
 	db := db.NewMemDB()
 
-	bsj := &BlockStoreStateJSON{Height: 1000}
+	bsj := &BlockStoreStateJSON{Base: 100, Height: 1000}
 	bsj.Save(db)
 
 	retrBSJ := LoadBlockStoreStateJSON(db)
@@ -74,11 +74,24 @@ func TestLoadBlockStoreStateJSON(t *testing.T) {
 	assert.Equal(t, *bsj, retrBSJ, "expected the retrieved DBs to match")
 }
 
+func TestLoadBlockStoreStateJSON_NoBase(t *testing.T) {
+	db := db.NewMemDB()
+
+	bsj := &BlockStoreStateJSON{Height: 1000}
+	bsj.Save(db)
+
+	retrBSJ := LoadBlockStoreStateJSON(db)
+
+	// Stores persisted before Base existed start at height 1.
+	assert.Equal(t, BlockStoreStateJSON{Base: 1, Height: 1000}, retrBSJ)
+}
+
 func TestNewBlockStore(t *testing.T) {
 	db := db.NewMemDB()
 	err := db.Set(blockStoreKey, []byte(`{"height": "10000"}`))
 	require.NoError(t, err)
 	bs := NewBlockStore(db)
+	require.Equal(t, int64(1), bs.Base(), "failed to properly parse blockstore")
 	require.Equal(t, int64(10000), bs.Height(), "failed to properly parse blockstore")
 
 	panicCausers := []struct {
@@ -158,7 +171,7 @@ func TestBlockStoreSaveLoadBlock(t *testing.T) {
 	require.Equal(t, bs.Height(), block.Header.Height, "expecting the new height to be changed")
 
 	incompletePartSet := types.NewPartSetFromHeader(types.PartSetHeader{Total: 2})
-	uncontiguousPartSet := types.NewPartSetFromHeader(types.PartSetHeader{Total: 0})
+	uncontiguousPartSet := types.NewPartSetFromHeader(types.PartSetHeader{Total: 2})
 	uncontiguousPartSet.AddPart(part2)
 
 	header1 := types.Header{
@@ -199,7 +212,7 @@ func TestBlockStoreSaveLoadBlock(t *testing.T) {
 		{
 			block:     newBlock(header2, commitAtH10),
 			parts:     uncontiguousPartSet,
-			wantPanic: "only save contiguous blocks", // and incomplete and uncontiguous parts
+			wantPanic: "only save complete block", // incomplete and uncontiguous parts
 		},
 
 		{
@@ -420,6 +433,133 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestBlockStoreVoteExtensions(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	// The vote extensions are stored in both the seen commit and the block's
+	// last commit.
+	seenCommit := makeTestCommit(1, tmtime.Now())
+	seenCommit.Signatures[0].Extension = []byte("price=1")
+	block := makeBlock(1, state, new(types.Commit))
+	bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	assert.Equal(t, []byte("price=1"), bs.LoadSeenCommit(1).Signatures[0].Extension)
+
+	block = makeBlock(2, state, seenCommit)
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(2, tmtime.Now()))
+	assert.Equal(t, []byte("price=1"), bs.LoadBlockCommit(1).Signatures[0].Extension)
+	assert.Equal(t, []byte("price=1"), bs.LoadBlock(2).LastCommit.Signatures[0].Extension)
+}
+
+func TestBlockStoreSaveFromNonZeroBase(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	// An empty store, e.g. after state sync, accepts a block at any height.
+	seenCommit := makeTestCommit(10, tmtime.Now())
+	require.NoError(t, bs.SaveSeenCommit(9, seenCommit))
+	require.Equal(t, cdc.MustMarshalBinaryBare(seenCommit), cdc.MustMarshalBinaryBare(bs.LoadSeenCommit(9)))
+
+	block := makeBlock(10, state, new(types.Commit))
+	bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	require.EqualValues(t, 10, bs.Base())
+	require.EqualValues(t, 10, bs.Height())
+	require.Equal(t, BlockStoreStateJSON{Base: 10, Height: 10}, LoadBlockStoreStateJSON(bs.db))
+
+	// Subsequent blocks must be contiguous.
+	block = makeBlock(12, state, new(types.Commit))
+	require.Panics(t, func() { bs.SaveBlock(block, block.MakePartSet(2), seenCommit) })
+}
+
+func TestPruneBlocks(t *testing.T) {
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	state, err := sm.LoadStateFromDBOrGenesisFile(dbm.NewMemDB(), config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db)
+	assert.EqualValues(t, 0, bs.Base())
+	assert.EqualValues(t, 0, bs.Height())
+
+	// pruning an empty store should error, even when pruning to 0
+	_, err = bs.PruneBlocks(1)
+	require.Error(t, err)
+
+	_, err = bs.PruneBlocks(0)
+	require.Error(t, err)
+
+	// make more than 1000 blocks, to test batch deletions
+	for h := int64(1); h <= 1500; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+
+	assert.EqualValues(t, 1, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+
+	prunedBlock := bs.LoadBlock(1199)
+
+	// Check that basic pruning works
+	pruned, err := bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1199, pruned)
+	assert.EqualValues(t, 1200, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+	assert.EqualValues(t, BlockStoreStateJSON{
+		Base:   1200,
+		Height: 1500,
+	}, LoadBlockStoreStateJSON(db))
+
+	require.NotNil(t, bs.LoadBlock(1200))
+	require.Nil(t, bs.LoadBlock(1199))
+	require.Nil(t, bs.LoadBlockByHash(prunedBlock.Hash()))
+	require.Nil(t, bs.LoadBlockCommit(1199))
+	require.Nil(t, bs.LoadBlockMeta(1199))
+	require.Nil(t, bs.LoadBlockPart(1199, 1))
+	require.Nil(t, bs.LoadSeenCommit(1199))
+
+	for i := int64(1); i < 1200; i++ {
+		require.Nil(t, bs.LoadBlock(i))
+	}
+	for i := int64(1200); i <= 1500; i++ {
+		require.NotNil(t, bs.LoadBlock(i))
+	}
+
+	// Pruning below the current base should error
+	_, err = bs.PruneBlocks(1199)
+	require.Error(t, err)
+
+	// Pruning to the current base should work
+	pruned, err = bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// Pruning again should work
+	pruned, err = bs.PruneBlocks(1300)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, pruned)
+	assert.EqualValues(t, 1300, bs.Base())
+
+	// Pruning beyond the current height should error
+	_, err = bs.PruneBlocks(1501)
+	require.Error(t, err)
+
+	// Pruning to the current height should work
+	pruned, err = bs.PruneBlocks(1500)
+	require.NoError(t, err)
+	assert.EqualValues(t, 200, pruned)
+	assert.Nil(t, bs.LoadBlock(1499))
+	assert.NotNil(t, bs.LoadBlock(1500))
+	assert.Nil(t, bs.LoadBlock(1501))
+
+	// The pruned store should survive a reload
+	bs = NewBlockStore(db)
+	assert.EqualValues(t, 1500, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
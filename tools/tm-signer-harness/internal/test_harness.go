@@ -11,6 +11,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/privval"
+	privvalgrpc "github.com/tendermint/tendermint/privval/grpc"
 	"github.com/tendermint/tendermint/state"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -46,11 +47,18 @@ type TestHarnessError struct {
 
 var _ error = (*TestHarnessError)(nil)
 
+// signerClient is implemented by both the socket and the gRPC signer clients.
+type signerClient interface {
+	types.PrivValidator
+	WaitForConnection(maxWait time.Duration) error
+	Close() error
+}
+
 // TestHarness allows for testing of a remote signer to ensure compatibility
 // with this version of Tendermint.
 type TestHarness struct {
 	addr             string
-	signerClient     *privval.SignerClient
+	signerClient     signerClient
 	fpv              *privval.FilePV
 	chainID          string
 	acceptRetries    int
@@ -74,6 +82,11 @@ type TestHarnessConfig struct {
 
 	SecretConnKey ed25519.PrivKeyEd25519
 
+	// TLS certificates used to dial a gRPC remote signer (grpc:// BindAddr).
+	ClientCertFile string
+	ClientKeyFile  string
+	RootCAFile     string
+
 	ExitWhenComplete bool // Whether or not to call os.Exit when the harness has completed.
 }
 
@@ -102,14 +115,9 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 	}
 	logger.Info("Loaded genesis file", "chainID", st.ChainID)
 
-	spv, err := newTestHarnessListener(logger, cfg)
-	if err != nil {
-		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
-	}
-
-	signerClient, err := privval.NewSignerClient(spv)
+	signerClient, err := newTestHarnessSignerClient(logger, cfg, st.ChainID)
 	if err != nil {
-		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+		return nil, err
 	}
 
 	return &TestHarness{
@@ -320,6 +328,35 @@ func (th *TestHarness) Shutdown(err error) {
 	}
 }
 
+// newTestHarnessSignerClient either dials the gRPC remote signer at
+// cfg.BindAddr, or listens for a socket remote signer to connect to it.
+func newTestHarnessSignerClient(logger log.Logger, cfg TestHarnessConfig, chainID string) (signerClient, error) {
+	proto, addr := tmnet.ProtocolAndAddress(cfg.BindAddr)
+	if proto == "grpc" {
+		tlsConfig, err := privvalgrpc.ClientTLSConfig(
+			ExpandPath(cfg.ClientCertFile), ExpandPath(cfg.ClientKeyFile), ExpandPath(cfg.RootCAFile))
+		if err != nil {
+			return nil, newTestHarnessError(ErrInvalidParameters, err, "")
+		}
+		logger.Info("Dialing gRPC remote signer", "addr", addr)
+		sc, err := privvalgrpc.DialRemoteSigner(addr, tlsConfig, chainID, logger)
+		if err != nil {
+			return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+		}
+		return sc, nil
+	}
+
+	spv, err := newTestHarnessListener(logger, cfg)
+	if err != nil {
+		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+	}
+	sc, err := privval.NewSignerClient(spv)
+	if err != nil {
+		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
+	}
+	return sc, nil
+}
+
 // newTestHarnessListener creates our client instance which we will use for testing.
 func newTestHarnessListener(logger log.Logger, cfg TestHarnessConfig) (*privval.SignerListenerEndpoint, error) {
 	proto, addr := tmnet.ProtocolAndAddress(cfg.BindAddr)
@@ -351,7 +388,7 @@ func newTestHarnessListener(logger log.Logger, cfg TestHarnessConfig) (*privval.
 		logger.Info("Resolved TCP address for listener", "addr", tcpLn.Addr())
 		svln = tcpLn
 	default:
-		logger.Error("Unsupported protocol (must be unix:// or tcp://)", "proto", proto)
+		logger.Error("Unsupported protocol (must be unix://, tcp:// or grpc://)", "proto", proto)
 		return nil, newTestHarnessError(ErrInvalidParameters, nil, fmt.Sprintf("Unsupported protocol: %s", proto))
 	}
 	return privval.NewSignerListenerEndpoint(logger, svln), nil
@@ -32,6 +32,9 @@ var (
 	flagBindAddr      string
 	flagTMHome        string
 	flagKeyOutputPath string
+	flagTLSCert       string
+	flagTLSKey        string
+	flagTLSRootCA     string
 )
 
 // Command line commands
@@ -65,8 +68,14 @@ Use "tm-signer-harness help <command>" for more information about that command.`
 		"accept-retries",
 		defaultAcceptRetries,
 		"The number of attempts to listen for incoming connections")
-	runCmd.StringVar(&flagBindAddr, "addr", defaultBindAddr, "Bind to this address for the testing")
+	runCmd.StringVar(&flagBindAddr,
+		"addr",
+		defaultBindAddr,
+		"Bind to this address for the testing, or dial this grpc:// address of the remote signer")
 	runCmd.StringVar(&flagTMHome, "tmhome", defaultTMHome, "Path to the Tendermint home directory")
+	runCmd.StringVar(&flagTLSCert, "tls-cert", "", "Client certificate used to dial a gRPC remote signer")
+	runCmd.StringVar(&flagTLSKey, "tls-key", "", "Client key used to dial a gRPC remote signer")
+	runCmd.StringVar(&flagTLSRootCA, "tls-root-ca", "", "CA certificate used to verify a gRPC remote signer")
 	runCmd.Usage = func() {
 		fmt.Println(`Runs the remote signer test harness for Tendermint.
 
@@ -118,6 +127,9 @@ func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
 		AcceptRetries:    acceptRetries,
 		ConnDeadline:     time.Duration(defaultConnDeadline) * time.Second,
 		SecretConnKey:    ed25519.GenPrivKey(),
+		ClientCertFile:   flagTLSCert,
+		ClientKeyFile:    flagTLSKey,
+		RootCAFile:       flagTLSRootCA,
 		ExitWhenComplete: true,
 	}
 	harness, err := internal.NewTestHarness(logger, cfg)
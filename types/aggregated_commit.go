@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/bls12381"
+)
+
+// AggregateCommit returns a copy of the commit in which the signatures of the
+// validators with BLS12-381 keys are aggregated into one AggregatedSignature,
+// and removed from Signatures. The signatures of the other validators are
+// kept. The commit is returned as is if no validator has a BLS12-381 key, or
+// if it is already aggregated.
+//
+// The signatures aren't verified: aggregating an invalid signature gives an
+// invalid aggregated signature.
+func AggregateCommit(commit *Commit, vals *ValidatorSet) (*Commit, error) {
+	if vals.Size() != len(commit.Signatures) {
+		return nil, NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
+	}
+	if len(commit.AggregatedSignature) != 0 {
+		return commit, nil
+	}
+
+	var (
+		commitSigs = make([]CommitSig, len(commit.Signatures))
+		sigs       [][]byte
+	)
+	for idx, commitSig := range commit.Signatures {
+		commitSigs[idx] = commitSig
+		if commitSig.Absent() {
+			continue
+		}
+		if _, ok := vals.Validators[idx].PubKey.(bls12381.PubKeyBls12381); ok {
+			sigs = append(sigs, commitSig.Signature)
+			commitSigs[idx].Signature = nil
+		}
+	}
+	if len(sigs) == 0 {
+		return commit, nil
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+	aggCommit := NewCommit(commit.Height, commit.Round, commit.BlockID, commitSigs)
+	aggCommit.AggregatedSignature = aggSig
+	return aggCommit, nil
+}
+
+// aggregatedSigners collects the keys of the validators whose signatures are
+// part of a commit's aggregated signature, and the messages they signed, to
+// verify them all at once.
+type aggregatedSigners struct {
+	pubKeys []bls12381.PubKeyBls12381
+	msgs    [][]byte
+}
+
+func (as *aggregatedSigners) add(idx int, val *Validator, voteSignBytes []byte) error {
+	pubKey, ok := val.PubKey.(bls12381.PubKeyBls12381)
+	if !ok {
+		return fmt.Errorf("missing signature (#%d) of validator %X with a non-aggregatable %T key",
+			idx, val.Address, val.PubKey)
+	}
+	as.pubKeys = append(as.pubKeys, pubKey)
+	as.msgs = append(as.msgs, voteSignBytes)
+	return nil
+}
+
+// verify checks the aggregated signature of the commit, if any. It must be
+// there if signatures are missing from the commit's Signatures.
+func (as *aggregatedSigners) verify(commit *Commit) error {
+	if len(commit.AggregatedSignature) == 0 {
+		if len(as.pubKeys) > 0 {
+			return fmt.Errorf("missing aggregated signature of %d validators", len(as.pubKeys))
+		}
+		return nil
+	}
+	if !bls12381.VerifyAggregateSignature(as.pubKeys, as.msgs, commit.AggregatedSignature) {
+		return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	tmmath "github.com/tendermint/tendermint/libs/math"
+)
+
+// aggregatableCommit returns a commit signed by three validators with
+// BLS12-381 keys and one with an ed25519 key, and their validator set.
+func aggregatableCommit(t *testing.T, chainID string, blockID BlockID, height int64) (*Commit, *ValidatorSet) {
+	privVals := []PrivValidator{
+		NewMockPVWithParams(bls12381.GenPrivKey(), false, false),
+		NewMockPVWithParams(bls12381.GenPrivKey(), false, false),
+		NewMockPVWithParams(bls12381.GenPrivKey(), false, false),
+		NewMockPV(),
+	}
+	vals := make([]*Validator, len(privVals))
+	for i, privVal := range privVals {
+		vals[i] = NewValidator(privVal.GetPubKey(), 10)
+	}
+	valSet := NewValidatorSet(vals)
+	sort.Sort(PrivValidatorsByAddress(privVals))
+
+	voteSet := NewVoteSet(chainID, height, 0, PrecommitType, valSet)
+	commit, err := MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	return commit, valSet
+}
+
+func TestAggregateCommit(t *testing.T) {
+	var (
+		chainID = "mychainID"
+		blockID = makeBlockIDRandom()
+		height  = int64(5)
+	)
+	commit, valSet := aggregatableCommit(t, chainID, blockID, height)
+
+	aggCommit, err := AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+	assert.Len(t, aggCommit.AggregatedSignature, bls12381.SignatureSize)
+	for idx, commitSig := range aggCommit.Signatures {
+		_, isBls := valSet.Validators[idx].PubKey.(bls12381.PubKeyBls12381)
+		assert.Equal(t, isBls, commitSig.Aggregated(), idx)
+		assert.Equal(t, commit.Signatures[idx].Timestamp, commitSig.Timestamp, idx)
+	}
+	assert.NoError(t, aggCommit.ValidateBasic())
+	assert.NotEqual(t, commit.Hash(), aggCommit.Hash())
+
+	// The aggregated commit verifies like the commit.
+	assert.NoError(t, valSet.VerifyCommit(chainID, blockID, height, aggCommit))
+	assert.NoError(t, valSet.VerifyCommitTrusting(chainID, blockID, height, aggCommit,
+		tmmath.Fraction{Numerator: 1, Denominator: 3}))
+
+	// It isn't aggregated again.
+	again, err := AggregateCommit(aggCommit, valSet)
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit, again)
+
+	// A wrong aggregated signature doesn't verify.
+	badCommit := *aggCommit
+	badCommit.AggregatedSignature = commit.Signatures[0].Signature
+	assert.Error(t, valSet.VerifyCommit(chainID, blockID, height, &badCommit))
+
+	// Nor does a wrong timestamp.
+	badCommit = *aggCommit
+	badCommit.Signatures = append([]CommitSig{}, aggCommit.Signatures...)
+	for idx, commitSig := range badCommit.Signatures {
+		if commitSig.Aggregated() {
+			badCommit.Signatures[idx].Timestamp = commitSig.Timestamp.Add(1)
+			break
+		}
+	}
+	assert.Error(t, valSet.VerifyCommit(chainID, blockID, height, &badCommit))
+
+	// Nor a missing signature of a validator without a BLS12-381 key.
+	badCommit = *aggCommit
+	badCommit.Signatures = append([]CommitSig{}, aggCommit.Signatures...)
+	for idx, commitSig := range badCommit.Signatures {
+		if !commitSig.Aggregated() {
+			badCommit.Signatures[idx].Signature = nil
+		}
+	}
+	assert.Error(t, valSet.VerifyCommit(chainID, blockID, height, &badCommit))
+
+	// Signatures can only be missing with an aggregated signature.
+	badCommit = *aggCommit
+	badCommit.AggregatedSignature = nil
+	assert.Error(t, badCommit.ValidateBasic())
+	verifier := newCommitVerifier(chainID, &badCommit)
+	for idx := range badCommit.Signatures {
+		require.NoError(t, verifier.add(idx, valSet.Validators[idx]), idx)
+	}
+	assert.Error(t, verifier.verify())
+}
+
+func TestVerifyAggregatedCommitTrusting(t *testing.T) {
+	var (
+		chainID    = "mychainID"
+		blockID    = makeBlockIDRandom()
+		height     = int64(5)
+		trustLevel = tmmath.Fraction{Numerator: 1, Denominator: 3}
+	)
+	commit, valSet := aggregatableCommit(t, chainID, blockID, height)
+	aggCommit, err := AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+
+	// Trusted validators, some of which signed the commit.
+	var trustedVals []*Validator
+	for idx, commitSig := range aggCommit.Signatures {
+		if commitSig.Aggregated() {
+			trustedVals = append(trustedVals, valSet.Validators[idx].Copy())
+			break
+		}
+	}
+	trustedVals = append(trustedVals, NewValidator(bls12381.GenPrivKey().PubKey(), 10))
+	trustedValSet := NewValidatorSet(trustedVals)
+
+	// The aggregated signature can't be verified without all its signers...
+	err = trustedValSet.VerifyCommitTrusting(chainID, blockID, height, aggCommit, trustLevel)
+	assert.Error(t, err)
+
+	// ... which are taken from the validator set of the commit.
+	err = trustedValSet.VerifyAggregatedCommitTrusting(chainID, blockID, height, aggCommit, trustLevel, valSet)
+	assert.NoError(t, err)
+
+	// Which must match the trusted validators.
+	otherValSet, _ := RandValidatorSet(4, 10)
+	err = trustedValSet.VerifyAggregatedCommitTrusting(chainID, blockID, height, aggCommit, trustLevel, otherValSet)
+	assert.Error(t, err)
+}
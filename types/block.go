@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/bits"
@@ -267,15 +268,16 @@ func (b *Block) Unmarshal(bs []byte) error {
 
 //-----------------------------------------------------------------------------
 
-// MaxDataBytes returns the maximum size of block's data.
+// MaxDataBytes returns the maximum size of block's data, for validators with
+// the key types allowed by valParams.
 //
 // XXX: Panics on negative result.
-func MaxDataBytes(maxBytes int64, valsCount, evidenceCount int) int64 {
+func MaxDataBytes(maxBytes int64, valParams ValidatorParams, valsCount, evidenceCount int) int64 {
 	maxDataBytes := maxBytes -
 		MaxAminoOverheadForBlock -
 		MaxHeaderBytes -
-		int64(valsCount)*MaxVoteBytes -
-		int64(evidenceCount)*MaxEvidenceBytes
+		int64(valsCount)*valParams.MaxVoteBytes() -
+		int64(evidenceCount)*valParams.MaxEvidenceBytes()
 
 	if maxDataBytes < 0 {
 		panic(fmt.Sprintf(
@@ -294,12 +296,12 @@ func MaxDataBytes(maxBytes int64, valsCount, evidenceCount int) int64 {
 // of evidence.
 //
 // XXX: Panics on negative result.
-func MaxDataBytesUnknownEvidence(maxBytes int64, valsCount int) int64 {
-	_, maxEvidenceBytes := MaxEvidencePerBlock(maxBytes)
+func MaxDataBytesUnknownEvidence(maxBytes int64, valParams ValidatorParams, valsCount int) int64 {
+	_, maxEvidenceBytes := MaxEvidencePerBlock(maxBytes, valParams)
 	maxDataBytes := maxBytes -
 		MaxAminoOverheadForBlock -
 		MaxHeaderBytes -
-		int64(valsCount)*MaxVoteBytes -
+		int64(valsCount)*valParams.MaxVoteBytes() -
 		maxEvidenceBytes
 
 	if maxDataBytes < 0 {
@@ -313,6 +315,27 @@ func MaxDataBytesUnknownEvidence(maxBytes int64, valsCount int) int64 {
 	return maxDataBytes
 }
 
+// MaxVoteExtensionBytes returns the maximum size of the vote extension of each
+// of valsCount validators, such that a commit with all their extensions fits in
+// a block along with the header and the maximum evidence. It is at most
+// MaxVoteExtensionSize.
+//
+// XXX: Panics if the block can't fit the commit without extensions.
+func MaxVoteExtensionBytes(maxBytes int64, valParams ValidatorParams, valsCount int) int64 {
+	if valsCount == 0 {
+		return MaxVoteExtensionSize
+	}
+	maxExtensionBytes := MaxDataBytesUnknownEvidence(maxBytes, valParams, valsCount)/int64(valsCount) -
+		maxVoteExtensionOverheadBytes
+	if maxExtensionBytes > MaxVoteExtensionSize {
+		return MaxVoteExtensionSize
+	}
+	if maxExtensionBytes < 0 {
+		return 0
+	}
+	return maxExtensionBytes
+}
+
 //-----------------------------------------------------------------------------
 
 // Header defines the structure of a Tendermint block header.
@@ -454,6 +477,7 @@ type CommitSig struct {
 	ValidatorAddress Address     `json:"validator_address"`
 	Timestamp        time.Time   `json:"timestamp"`
 	Signature        []byte      `json:"signature"`
+	Extension        []byte      `json:"extension"` // only for BlockIDFlagCommit
 }
 
 // NewCommitSigForBlock returns new CommitSig with BlockIDFlagCommit.
@@ -484,6 +508,12 @@ func (cs CommitSig) Absent() bool {
 	return cs.BlockIDFlag == BlockIDFlagAbsent
 }
 
+// Aggregated returns true if the signature of the CommitSig is part of the
+// AggregatedSignature of its Commit.
+func (cs CommitSig) Aggregated() bool {
+	return !cs.Absent() && len(cs.Signature) == 0
+}
+
 func (cs CommitSig) String() string {
 	return fmt.Sprintf("CommitSig{%X by %X on %v @ %s}",
 		tmbytes.Fingerprint(cs.Signature),
@@ -511,6 +541,12 @@ func (cs CommitSig) BlockID(commitBlockID BlockID) BlockID {
 
 // ValidateBasic performs basic validation.
 func (cs CommitSig) ValidateBasic() error {
+	return cs.validateBasic(false)
+}
+
+// validateBasic performs basic validation. The signature may be missing if
+// the commit has an aggregated signature.
+func (cs CommitSig) validateBasic(aggregated bool) error {
 	switch cs.BlockIDFlag {
 	case BlockIDFlagAbsent:
 	case BlockIDFlagCommit:
@@ -530,6 +566,9 @@ func (cs CommitSig) ValidateBasic() error {
 		if len(cs.Signature) != 0 {
 			return errors.New("signature is present")
 		}
+		if len(cs.Extension) != 0 {
+			return errors.New("extension is present")
+		}
 	default:
 		if len(cs.ValidatorAddress) != crypto.AddressSize {
 			return fmt.Errorf("expected ValidatorAddress size to be %d bytes, got %d bytes",
@@ -538,12 +577,18 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
-		if len(cs.Signature) == 0 {
+		if len(cs.Signature) == 0 && !aggregated {
 			return errors.New("signature is missing")
 		}
 		if len(cs.Signature) > MaxSignatureSize {
 			return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 		}
+		if cs.BlockIDFlag == BlockIDFlagNil && len(cs.Extension) != 0 {
+			return errors.New("extension is present for nil")
+		}
+		if len(cs.Extension) > MaxVoteExtensionSize {
+			return fmt.Errorf("extension is too big (max: %d)", MaxVoteExtensionSize)
+		}
 	}
 
 	return nil
@@ -563,6 +608,10 @@ type Commit struct {
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
 
+	// AggregatedSignature aggregates the BLS12-381 signatures missing from
+	// Signatures (see AggregateCommit). It's empty for most commits.
+	AggregatedSignature []byte `json:"aggregated_signature"`
+
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
 	// unmarshaling.
@@ -581,7 +630,8 @@ func NewCommit(height int64, round int, blockID BlockID, commitSigs []CommitSig)
 }
 
 // CommitToVoteSet constructs a VoteSet from the Commit and validator set.
-// Panics if signatures from the commit can't be added to the voteset.
+// Panics if signatures from the commit can't be added to the voteset, which
+// is the case for a commit with an aggregated signature.
 // Inverse of VoteSet.MakeCommit().
 func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSet {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
@@ -598,7 +648,8 @@ func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSe
 }
 
 // GetVote converts the CommitSig for the given valIdx to a Vote.
-// Returns nil if the precommit at valIdx is nil.
+// Returns nil if the precommit at valIdx is nil. The vote has no signature
+// if it's part of the commit's aggregated signature.
 // Panics if valIdx >= commit.Size().
 func (commit *Commit) GetVote(valIdx int) *Vote {
 	commitSig := commit.Signatures[valIdx]
@@ -611,17 +662,37 @@ func (commit *Commit) GetVote(valIdx int) *Vote {
 		ValidatorAddress: commitSig.ValidatorAddress,
 		ValidatorIndex:   valIdx,
 		Signature:        commitSig.Signature,
+		Extension:        commitSig.Extension,
 	}
 }
 
 // VoteSignBytes constructs the SignBytes for the given CommitSig.
-// The only unique part of the SignBytes is the Timestamp - all other fields
-// signed over are otherwise the same for all validators.
+// The only unique parts of the SignBytes are the Timestamp and Extension - all
+// other fields signed over are otherwise the same for all validators.
 // Panics if valIdx >= commit.Size().
 func (commit *Commit) VoteSignBytes(chainID string, valIdx int) []byte {
 	return commit.GetVote(valIdx).SignBytes(chainID)
 }
 
+// ExtensionsSize returns the number of bytes the vote extensions add to the
+// encoded commit.
+func (commit *Commit) ExtensionsSize() int64 {
+	stripped := make([]CommitSig, len(commit.Signatures))
+	hasExtensions := false
+	for i, commitSig := range commit.Signatures {
+		hasExtensions = hasExtensions || len(commitSig.Extension) > 0
+		stripped[i] = commitSig
+		stripped[i].Extension = nil
+	}
+	if !hasExtensions {
+		return 0
+	}
+	withoutExtensions := NewCommit(commit.Height, commit.Round, commit.BlockID, stripped)
+	withoutExtensions.AggregatedSignature = commit.AggregatedSignature
+	return int64(len(cdc.MustMarshalBinaryLengthPrefixed(commit)) -
+		len(cdc.MustMarshalBinaryLengthPrefixed(withoutExtensions)))
+}
+
 // Type returns the vote type of the commit, which is always VoteTypePrecommit
 // Implements VoteSetReader.
 func (commit *Commit) Type() byte {
@@ -693,8 +764,15 @@ func (commit *Commit) ValidateBasic() error {
 	if len(commit.Signatures) == 0 {
 		return errors.New("no signatures in commit")
 	}
+	aggregated := len(commit.AggregatedSignature) != 0
+	if aggregated && len(commit.AggregatedSignature) != bls12381.SignatureSize {
+		return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+			bls12381.SignatureSize,
+			len(commit.AggregatedSignature),
+		)
+	}
 	for i, commitSig := range commit.Signatures {
-		if err := commitSig.ValidateBasic(); err != nil {
+		if err := commitSig.validateBasic(aggregated); err != nil {
 			return fmt.Errorf("wrong CommitSig #%d: %v", i, err)
 		}
 	}
@@ -712,6 +790,9 @@ func (commit *Commit) Hash() tmbytes.HexBytes {
 		for i, commitSig := range commit.Signatures {
 			bs[i] = cdcEncode(commitSig)
 		}
+		if len(commit.AggregatedSignature) != 0 {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.SimpleHashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -5,6 +5,7 @@ import (
 	// number generator here and we can run the tests a bit faster
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"math"
 	"os"
 	"reflect"
@@ -248,6 +249,51 @@ func TestCommitValidateBasic(t *testing.T) {
 	}
 }
 
+func TestCommitVoteExtensions(t *testing.T) {
+	blockID := makeBlockIDRandom()
+	h := int64(3)
+	voteSet, valSet, vals := randVoteSet(h, 1, PrecommitType, 4, 1)
+	for i, val := range vals {
+		vote := &Vote{
+			ValidatorAddress: val.GetPubKey().Address(),
+			ValidatorIndex:   i,
+			Height:           h,
+			Round:            1,
+			Type:             PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        tmtime.Now(),
+			Extension:        []byte(fmt.Sprintf("price=%d", i)),
+		}
+		if i == 3 {
+			vote.Extension = nil
+		}
+		_, err := signAddVote(val, vote, voteSet)
+		require.NoError(t, err)
+	}
+	commit := voteSet.MakeCommit()
+	require.NoError(t, commit.ValidateBasic())
+
+	// the extensions are kept in the commit, and covered by the signatures
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, []byte(fmt.Sprintf("price=%d", i)), commit.Signatures[i].Extension)
+		assert.Equal(t, commit.Signatures[i].Extension, commit.GetVote(i).Extension)
+	}
+	assert.Empty(t, commit.Signatures[3].Extension)
+	assert.NoError(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, h, commit))
+
+	commit.Signatures[0].Extension = []byte("price=100")
+	assert.Error(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, h, commit))
+	commit.Signatures[0].Extension = []byte("price=0")
+
+	// and take space in the block
+	assert.True(t, commit.ExtensionsSize() >= int64(3*len("price=0")))
+	commit.Signatures[3].Extension = make([]byte, MaxVoteExtensionSize+1)
+	assert.Error(t, commit.ValidateBasic())
+	commit.Signatures[3] = NewCommitSigAbsent()
+	commit.Signatures[3].Extension = []byte("ext")
+	assert.Error(t, commit.ValidateBasic())
+}
+
 func TestHeaderHash(t *testing.T) {
 	testCases := []struct {
 		desc       string
@@ -367,65 +413,126 @@ func hexBytesFromString(s string) bytes.HexBytes {
 	return bytes.HexBytes(b)
 }
 
+// thresholdValidatorParams allow threshold multisig validator keys.
+var thresholdValidatorParams = ValidatorParams{
+	PubKeyTypes: []string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeMultisigThreshold},
+}
+
 func TestBlockMaxDataBytes(t *testing.T) {
+	defaultParams := DefaultValidatorParams()
 	testCases := []struct {
 		maxBytes      int64
+		valParams     ValidatorParams
 		valsCount     int
 		evidenceCount int
 		panics        bool
 		result        int64
 	}{
-		0: {-10, 1, 0, true, 0},
-		1: {10, 1, 0, true, 0},
-		2: {865, 1, 0, true, 0},
-		3: {866, 1, 0, false, 0},
-		4: {867, 1, 0, false, 1},
+		0: {-10, defaultParams, 1, 0, true, 0},
+		1: {10, defaultParams, 1, 0, true, 0},
+		2: {897, defaultParams, 1, 0, true, 0},
+		3: {898, defaultParams, 1, 0, false, 0},
+		4: {899, defaultParams, 1, 0, false, 1},
+		5: {1201, thresholdValidatorParams, 1, 0, true, 0},
+		6: {1202, thresholdValidatorParams, 1, 0, false, 0},
+		7: {1203, thresholdValidatorParams, 1, 0, false, 1},
 	}
 
 	for i, tc := range testCases {
 		tc := tc
 		if tc.panics {
 			assert.Panics(t, func() {
-				MaxDataBytes(tc.maxBytes, tc.valsCount, tc.evidenceCount)
+				MaxDataBytes(tc.maxBytes, tc.valParams, tc.valsCount, tc.evidenceCount)
 			}, "#%v", i)
 		} else {
 			assert.Equal(t,
 				tc.result,
-				MaxDataBytes(tc.maxBytes, tc.valsCount, tc.evidenceCount),
+				MaxDataBytes(tc.maxBytes, tc.valParams, tc.valsCount, tc.evidenceCount),
 				"#%v", i)
 		}
 	}
 }
 
 func TestBlockMaxDataBytesUnknownEvidence(t *testing.T) {
+	defaultParams := DefaultValidatorParams()
 	testCases := []struct {
 		maxBytes  int64
+		valParams ValidatorParams
 		valsCount int
 		panics    bool
 		result    int64
 	}{
-		0: {-10, 1, true, 0},
-		1: {10, 1, true, 0},
-		2: {961, 1, true, 0},
-		3: {962, 1, false, 0},
-		4: {963, 1, false, 1},
+		0: {-10, defaultParams, 1, true, 0},
+		1: {10, defaultParams, 1, true, 0},
+		2: {996, defaultParams, 1, true, 0},
+		3: {997, defaultParams, 1, false, 0},
+		4: {998, defaultParams, 1, false, 1},
+		5: {1334, thresholdValidatorParams, 1, true, 0},
+		6: {1335, thresholdValidatorParams, 1, false, 0},
+		7: {1336, thresholdValidatorParams, 1, false, 1},
 	}
 
 	for i, tc := range testCases {
 		tc := tc
 		if tc.panics {
 			assert.Panics(t, func() {
-				MaxDataBytesUnknownEvidence(tc.maxBytes, tc.valsCount)
+				MaxDataBytesUnknownEvidence(tc.maxBytes, tc.valParams, tc.valsCount)
 			}, "#%v", i)
 		} else {
 			assert.Equal(t,
 				tc.result,
-				MaxDataBytesUnknownEvidence(tc.maxBytes, tc.valsCount),
+				MaxDataBytesUnknownEvidence(tc.maxBytes, tc.valParams, tc.valsCount),
 				"#%v", i)
 		}
 	}
 }
 
+func TestMaxVoteExtensionBytes(t *testing.T) {
+	defaultParams := DefaultValidatorParams()
+	maxBytes := DefaultBlockParams().MaxBytes
+	testCases := []struct {
+		maxBytes  int64
+		valsCount int
+		result    int64
+	}{
+		0: {997, 1, 0},
+		1: {1001, 1, 0},
+		2: {1011, 1, 8},
+		3: {maxBytes, 0, MaxVoteExtensionSize},
+		4: {maxBytes, 100, MaxVoteExtensionSize},
+		5: {maxBytes, 10000, 1722},
+	}
+
+	for i, tc := range testCases {
+		result := MaxVoteExtensionBytes(tc.maxBytes, defaultParams, tc.valsCount)
+		assert.Equal(t, tc.result, result, "#%v", i)
+		if tc.valsCount > 0 && result > 0 {
+			assert.True(t, int64(tc.valsCount)*(result+maxVoteExtensionOverheadBytes) <=
+				MaxDataBytesUnknownEvidence(tc.maxBytes, defaultParams, tc.valsCount), "#%v", i)
+		}
+	}
+
+	// the overhead of the extensions in a commit is accounted for
+	blockID := makeBlockIDRandom()
+	h := int64(3)
+	voteSet, _, vals := randVoteSet(h, 1, PrecommitType, 4, 1)
+	for i, val := range vals {
+		vote := &Vote{
+			ValidatorAddress: val.GetPubKey().Address(),
+			ValidatorIndex:   i,
+			Height:           h,
+			Round:            1,
+			Type:             PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        tmtime.Now(),
+			Extension:        make([]byte, 200),
+		}
+		_, err := signAddVote(val, vote, voteSet)
+		require.NoError(t, err)
+	}
+	assert.True(t, voteSet.MakeCommit().ExtensionsSize() <= 4*(200+maxVoteExtensionOverheadBytes))
+}
+
 func TestCommitToVoteSet(t *testing.T) {
 	lastID := makeBlockIDRandom()
 	h := int64(3)
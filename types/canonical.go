@@ -39,6 +39,7 @@ type CanonicalVote struct {
 	BlockID   CanonicalBlockID
 	Timestamp time.Time
 	ChainID   string
+	Extension []byte // omitted when empty, so votes without one sign the same bytes
 }
 
 //-----------------------------------
@@ -78,6 +79,7 @@ func CanonicalizeVote(chainID string, vote *Vote) CanonicalVote {
 		BlockID:   CanonicalizeBlockID(vote.BlockID),
 		Timestamp: vote.Timestamp,
 		ChainID:   chainID,
+		Extension: vote.Extension,
 	}
 }
 
@@ -133,6 +133,9 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// BlockHeightKey is a reserved key, used to specify a block's height when
+	// searching blocks by their BeginBlock and EndBlock events.
+	BlockHeightKey = "block.height"
 )
 
 var (
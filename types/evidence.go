@@ -13,11 +13,16 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/merkle"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 )
 
 const (
 	// MaxEvidenceBytes is a maximum size of any evidence (including amino overhead).
-	MaxEvidenceBytes int64 = 484
+	MaxEvidenceBytes int64 = 563
+
+	// MaxThresholdEvidenceBytes is a maximum size of any evidence (including
+	// amino overhead) on chains which allow threshold multisig validator keys.
+	MaxThresholdEvidenceBytes int64 = 1345
 )
 
 // ErrEvidenceInvalid wraps a piece of evidence and the error denoting how or why it is invalid.
@@ -71,6 +76,7 @@ type Evidence interface {
 func RegisterEvidences(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Evidence)(nil), nil)
 	cdc.RegisterConcrete(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence", nil)
+	cdc.RegisterConcrete(&ConflictingHeadersEvidence{}, "tendermint/ConflictingHeadersEvidence", nil)
 }
 
 func RegisterMockEvidences(cdc *amino.Codec) {
@@ -84,12 +90,13 @@ const (
 
 // MaxEvidencePerBlock returns the maximum number of evidences
 // allowed in the block and their maximum total size (limitted to 1/10th
-// of the maximum block size).
+// of the maximum block size), for validators with the key types allowed by
+// valParams.
 // TODO: change to a constant, or to a fraction of the validator set size.
 // See https://github.com/tendermint/tendermint/issues/2590
-func MaxEvidencePerBlock(blockMaxBytes int64) (int64, int64) {
+func MaxEvidencePerBlock(blockMaxBytes int64, valParams ValidatorParams) (int64, int64) {
 	maxBytes := blockMaxBytes / MaxEvidenceBytesDenominator
-	maxNum := maxBytes / MaxEvidenceBytes
+	maxNum := maxBytes / valParams.MaxEvidenceBytes()
 	return maxNum, maxBytes
 }
 
@@ -246,6 +253,148 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 
 //-----------------------------------------------------------------
 
+// CompositeEvidence is evidence of misbehaviour by several validators at
+// once. It is not committed itself, but verified against the validator set
+// at its height and split into evidence against individual validators.
+type CompositeEvidence interface {
+	Evidence
+
+	// VerifyComposite returns an error if the evidence is invalid for the
+	// given validator set, which must be the one at Height().
+	VerifyComposite(chainID string, valSet *ValidatorSet) error
+	// Split returns evidence against the individual validators from valSet
+	// which misbehaved.
+	Split(valSet *ValidatorSet) []Evidence
+}
+
+// ConflictingHeadersEvidence contains two signed headers for the same height
+// which are both signed by more than 1/3 of the validators, i.e. a fork. It
+// is usually submitted by light clients, which detect a fork when their
+// primary and a witness return different headers.
+type ConflictingHeadersEvidence struct {
+	H1 *SignedHeader
+	H2 *SignedHeader
+}
+
+var _ CompositeEvidence = &ConflictingHeadersEvidence{}
+
+// String returns a string representation of the evidence.
+func (ev *ConflictingHeadersEvidence) String() string {
+	return fmt.Sprintf("ConflictingHeadersEvidence{H1: %d#%X, H2: %d#%X}",
+		ev.H1.Height, ev.H1.Hash(), ev.H2.Height, ev.H2.Hash())
+}
+
+// Height returns the height of the headers.
+func (ev *ConflictingHeadersEvidence) Height() int64 {
+	return ev.H1.Height
+}
+
+// Time returns the time of the first header.
+func (ev *ConflictingHeadersEvidence) Time() time.Time {
+	return ev.H1.Time
+}
+
+// Address returns nil, as the evidence may concern several validators.
+func (ev *ConflictingHeadersEvidence) Address() []byte {
+	return nil
+}
+
+// Bytes returns the evidence as byte slice.
+func (ev *ConflictingHeadersEvidence) Bytes() []byte {
+	return cdcEncode(ev)
+}
+
+// Hash returns the hash of the evidence.
+func (ev *ConflictingHeadersEvidence) Hash() []byte {
+	return tmhash.Sum(cdcEncode(ev))
+}
+
+// Verify always returns an error, as the evidence does not concern a single
+// validator. Use VerifyComposite instead.
+func (ev *ConflictingHeadersEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	return errors.New("conflictingHeadersEvidence must be verified with VerifyComposite")
+}
+
+// VerifyComposite checks both headers belong to chainID and are signed by
+// more than 1/3 of valSet, the validator set at their height.
+func (ev *ConflictingHeadersEvidence) VerifyComposite(chainID string, valSet *ValidatorSet) error {
+	if err := ev.ValidateBasic(); err != nil {
+		return err
+	}
+	oneThird := tmmath.Fraction{Numerator: 1, Denominator: 3}
+	for i, h := range []*SignedHeader{ev.H1, ev.H2} {
+		if err := h.ValidateBasic(chainID); err != nil {
+			return fmt.Errorf("invalid H%d: %v", i+1, err)
+		}
+		if err := valSet.VerifyCommitTrusting(chainID, h.Commit.BlockID, h.Height, h.Commit, oneThird); err != nil {
+			return fmt.Errorf("H%d is not signed by more than 1/3 of the validators: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Split returns DuplicateVoteEvidence for every validator from valSet which
+// signed both commits in the same round. Validators which signed the headers
+// in different rounds, or the header of a different validator set, or whose
+// signatures are aggregated, are not accounted for.
+func (ev *ConflictingHeadersEvidence) Split(valSet *ValidatorSet) []Evidence {
+	if ev.H1.Commit.Round != ev.H2.Commit.Round {
+		return nil
+	}
+
+	var evList []Evidence
+	for valIdx, val := range valSet.Validators {
+		vote1 := commitVoteByAddress(ev.H1.Commit, val.Address)
+		vote2 := commitVoteByAddress(ev.H2.Commit, val.Address)
+		if vote1 == nil || vote2 == nil || vote1.BlockID.Equals(vote2.BlockID) {
+			continue
+		}
+		// Commits may order validators differently, so use the index from
+		// valSet for both votes. It is not part of the sign bytes.
+		vote1.ValidatorIndex, vote2.ValidatorIndex = valIdx, valIdx
+		evList = append(evList, NewDuplicateVoteEvidence(val.PubKey, vote1, vote2))
+	}
+	return evList
+}
+
+// commitVoteByAddress returns the signed precommit for the commit's block
+// from the given validator, or nil.
+func commitVoteByAddress(commit *Commit, addr Address) *Vote {
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.ForBlock() && !commitSig.Aggregated() && bytes.Equal(commitSig.ValidatorAddress, addr) {
+			return commit.GetVote(idx)
+		}
+	}
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (ev *ConflictingHeadersEvidence) Equal(ev2 Evidence) bool {
+	if _, ok := ev2.(*ConflictingHeadersEvidence); !ok {
+		return false
+	}
+	return bytes.Equal(ev.Hash(), ev2.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (ev *ConflictingHeadersEvidence) ValidateBasic() error {
+	if ev.H1 == nil || ev.H2 == nil {
+		return errors.New("one or both of the headers are empty")
+	}
+	if ev.H1.Header == nil || ev.H2.Header == nil || ev.H1.Commit == nil || ev.H2.Commit == nil {
+		return errors.New("one or both of the signed headers are incomplete")
+	}
+	if ev.H1.Height != ev.H2.Height {
+		return fmt.Errorf("headers are for different heights: %d and %d", ev.H1.Height, ev.H2.Height)
+	}
+	if bytes.Equal(ev.H1.Hash(), ev.H2.Hash()) {
+		return errors.New("headers are the same")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------
+
 // UNSTABLE
 type MockRandomEvidence struct {
 	MockEvidence
@@ -7,8 +7,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 type voteData struct {
@@ -97,20 +99,32 @@ func TestEvidenceList(t *testing.T) {
 }
 
 func TestMaxEvidenceBytes(t *testing.T) {
-	val := NewMockPV()
 	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt64, tmhash.Sum([]byte("partshash")))
 	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt64, tmhash.Sum([]byte("partshash")))
 	const chainID = "mychain"
-	ev := &DuplicateVoteEvidence{
-		PubKey: secp256k1.GenPrivKey().PubKey(), // use secp because it's pubkey is longer
-		VoteA:  makeVote(val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID),
-		VoteB:  makeVote(val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID2),
+
+	testCases := []struct {
+		name     string
+		val      PrivValidator
+		maxBytes int64
+	}{
+		// bls12381 keys have the longest pubkey and signatures of single keys
+		{"single key", NewMockPVWithParams(bls12381.GenPrivKey(), false, false), MaxEvidenceBytes},
+		// and threshold keys have the longest ones when they are allowed
+		{"threshold key", maxThresholdMockPV(), MaxThresholdEvidenceBytes},
 	}
+	for _, tc := range testCases {
+		ev := &DuplicateVoteEvidence{
+			PubKey: tc.val.GetPubKey(),
+			VoteA:  makeVote(tc.val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID),
+			VoteB:  makeVote(tc.val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID2),
+		}
 
-	bz, err := cdc.MarshalBinaryLengthPrefixed(ev)
-	require.NoError(t, err)
+		bz, err := cdc.MarshalBinaryLengthPrefixed(ev)
+		require.NoError(t, err)
 
-	assert.EqualValues(t, MaxEvidenceBytes, len(bz))
+		assert.EqualValues(t, tc.maxBytes, len(bz), tc.name)
+	}
 }
 
 func randomDuplicatedVoteEvidence() *DuplicateVoteEvidence {
@@ -164,6 +178,64 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 	}
 }
 
+func TestConflictingHeadersEvidence(t *testing.T) {
+	const chainID = "mychain"
+	valSet, privVals := RandValidatorSet(4, 10)
+
+	ev := &ConflictingHeadersEvidence{
+		H1: makeSignedHeader(t, chainID, valSet, privVals, []byte("app_hash1"), 0),
+		H2: makeSignedHeader(t, chainID, valSet, privVals, []byte("app_hash2"), 0),
+	}
+	require.NoError(t, ev.ValidateBasic())
+	require.NoError(t, ev.VerifyComposite(chainID, valSet))
+	assert.Error(t, ev.VerifyComposite("otherchain", valSet))
+	assert.Error(t, ev.Verify(chainID, privVals[0].GetPubKey()))
+
+	// every validator signed both headers in the same round
+	evList := ev.Split(valSet)
+	require.Len(t, evList, 4)
+	for _, dve := range evList {
+		require.NoError(t, dve.ValidateBasic())
+		_, val := valSet.GetByAddress(dve.Address())
+		require.NotNil(t, val)
+		assert.NoError(t, dve.Verify(chainID, val.PubKey))
+	}
+
+	// H2 was signed by a different validator set
+	otherValSet, otherPrivVals := RandValidatorSet(4, 10)
+	ev.H2 = makeSignedHeader(t, chainID, otherValSet, otherPrivVals, []byte("app_hash2"), 0)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Error(t, ev.VerifyComposite(chainID, valSet))
+	assert.Empty(t, ev.Split(valSet))
+
+	// H2 was signed in a different round
+	ev.H2 = makeSignedHeader(t, chainID, valSet, privVals, []byte("app_hash2"), 1)
+	require.NoError(t, ev.VerifyComposite(chainID, valSet))
+	assert.Empty(t, ev.Split(valSet))
+
+	// the same header twice
+	ev.H2 = ev.H1
+	assert.Error(t, ev.ValidateBasic())
+	ev.H2 = nil
+	assert.Error(t, ev.ValidateBasic())
+}
+
+func makeSignedHeader(t *testing.T, chainID string, valSet *ValidatorSet, privVals []PrivValidator,
+	appHash []byte, round int) *SignedHeader {
+	header := &Header{
+		ChainID:        chainID,
+		Height:         10,
+		Time:           tmtime.Now(),
+		ValidatorsHash: valSet.Hash(),
+		AppHash:        appHash,
+	}
+	blockID := makeBlockID(header.Hash(), 1, tmhash.Sum([]byte("parts")))
+	voteSet := NewVoteSet(chainID, header.Height, round, PrecommitType, valSet)
+	commit, err := MakeCommit(blockID, header.Height, round, voteSet, privVals)
+	require.NoError(t, err)
+	return &SignedHeader{Header: header, Commit: commit}
+}
+
 func TestMockGoodEvidenceValidateBasic(t *testing.T) {
 	goodEvidence := NewMockEvidence(int64(1), time.Now(), 1, []byte{1})
 	assert.Nil(t, goodEvidence.ValidateBasic())
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -89,6 +90,15 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 		if len(v.Address) == 0 {
 			genDoc.Validators[i].Address = v.PubKey.Address()
 		}
+		if pk, ok := v.PubKey.(multisig.PubKeyMultisigThreshold); ok {
+			if !genDoc.ConsensusParams.Validator.IsValidPubkeyType(ABCIPubKeyTypeMultisigThreshold) {
+				return errors.Errorf("validator %v in the genesis file has a threshold key, "+
+					"which requires %q in consensus_params.validator.pub_key_types", v, ABCIPubKeyTypeMultisigThreshold)
+			}
+			if err := ValidateThresholdPubKey(pk); err != nil {
+				return errors.Wrapf(err, "invalid key for validator %v in the genesis file", v)
+			}
+		}
 	}
 
 	if genDoc.GenesisTime.IsZero() {
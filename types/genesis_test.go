@@ -47,6 +47,24 @@ func TestGenesisBad(t *testing.T) {
 				`},"power":"10","name":""}` +
 				`]}`,
 		),
+		// threshold key with a threshold greater than its number of keys
+		[]byte(
+			`{"chain_id":"mychain", "validators":[` +
+				`{"pub_key":{` +
+				`"type":"tendermint/PubKeyMultisigThreshold","value":{"threshold":"2","pubkeys":[{` +
+				`"type":"tendermint/PubKeyEd25519","value":"AT/+aaL1eB0477Mud9JMm8Sh8BIvOYlPGC9KkIUmFaE="` +
+				`}]}},"power":"10","name":""}` +
+				`]}`,
+		),
+		// threshold key not allowed by the consensus params
+		[]byte(
+			`{"chain_id":"mychain", "validators":[` +
+				`{"pub_key":{` +
+				`"type":"tendermint/PubKeyMultisigThreshold","value":{"threshold":"1","pubkeys":[{` +
+				`"type":"tendermint/PubKeyEd25519","value":"AT/+aaL1eB0477Mud9JMm8Sh8BIvOYlPGC9KkIUmFaE="` +
+				`}]}},"power":"10","name":""}` +
+				`]}`,
+		),
 	}
 
 	for _, testCase := range testCases {
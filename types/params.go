@@ -100,6 +100,26 @@ func (params *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
 	return false
 }
 
+// MaxVoteBytes returns the maximum size of a vote (including amino overhead),
+// not counting the vote extension, of validators with the allowed key types.
+// Threshold multisig keys have the biggest signatures, so they are only
+// accounted for if they are allowed.
+func (params ValidatorParams) MaxVoteBytes() int64 {
+	if params.IsValidPubkeyType(ABCIPubKeyTypeMultisigThreshold) {
+		return MaxThresholdVoteBytes
+	}
+	return MaxVoteBytes
+}
+
+// MaxEvidenceBytes returns the maximum size of any evidence (including amino
+// overhead) against validators with the allowed key types.
+func (params ValidatorParams) MaxEvidenceBytes() int64 {
+	if params.IsValidPubkeyType(ABCIPubKeyTypeMultisigThreshold) {
+		return MaxThresholdEvidenceBytes
+	}
+	return MaxEvidenceBytes
+}
+
 // Validate validates the ConsensusParams to ensure all values are within their
 // allowed limits, and returns an error if they are not.
 func (params *ConsensusParams) Validate() error {
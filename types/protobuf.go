@@ -7,7 +7,9 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
 )
@@ -24,6 +26,9 @@ const (
 	ABCIPubKeyTypeEd25519   = "ed25519"
 	ABCIPubKeyTypeSr25519   = "sr25519"
 	ABCIPubKeyTypeSecp256k1 = "secp256k1"
+	ABCIPubKeyTypeBls12381  = "bls12381"
+	// The data of a threshold multisig key is its amino encoding.
+	ABCIPubKeyTypeMultisigThreshold = "multisig-threshold"
 )
 
 // TODO: Make non-global by allowing for registration of more pubkey types
@@ -31,6 +36,9 @@ var ABCIPubKeyTypesToAminoNames = map[string]string{
 	ABCIPubKeyTypeEd25519:   ed25519.PubKeyAminoName,
 	ABCIPubKeyTypeSr25519:   sr25519.PubKeyAminoName,
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyAminoName,
+	ABCIPubKeyTypeBls12381:  bls12381.PubKeyAminoName,
+
+	ABCIPubKeyTypeMultisigThreshold: multisig.PubKeyMultisigThresholdAminoRoute,
 }
 
 //-------------------------------------------------------
@@ -115,6 +123,16 @@ func (tm2pb) PubKey(pubKey crypto.PubKey) abci.PubKey {
 			Type: ABCIPubKeyTypeSecp256k1,
 			Data: pk[:],
 		}
+	case bls12381.PubKeyBls12381:
+		return abci.PubKey{
+			Type: ABCIPubKeyTypeBls12381,
+			Data: pk[:],
+		}
+	case multisig.PubKeyMultisigThreshold:
+		return abci.PubKey{
+			Type: ABCIPubKeyTypeMultisigThreshold,
+			Data: pk.Bytes(),
+		}
 	default:
 		panic(fmt.Sprintf("unknown pubkey type: %v %v", pubKey, reflect.TypeOf(pubKey)))
 	}
@@ -219,6 +237,23 @@ func (pb2tm) PubKey(pubKey abci.PubKey) (crypto.PubKey, error) {
 		var pk secp256k1.PubKeySecp256k1
 		copy(pk[:], pubKey.Data)
 		return pk, nil
+	case ABCIPubKeyTypeBls12381:
+		if len(pubKey.Data) != bls12381.PubKeyBls12381Size {
+			return nil, fmt.Errorf("invalid size for PubKeyBls12381. Got %d, expected %d",
+				len(pubKey.Data), bls12381.PubKeyBls12381Size)
+		}
+		var pk bls12381.PubKeyBls12381
+		copy(pk[:], pubKey.Data)
+		return pk, nil
+	case ABCIPubKeyTypeMultisigThreshold:
+		var pk multisig.PubKeyMultisigThreshold
+		if err := cdc.UnmarshalBinaryBare(pubKey.Data, &pk); err != nil {
+			return nil, fmt.Errorf("invalid PubKeyMultisigThreshold: %v", err)
+		}
+		if err := ValidateThresholdPubKey(pk); err != nil {
+			return nil, err
+		}
+		return pk, nil
 	default:
 		return nil, fmt.Errorf("unknown pubkey type %v", pubKey.Type)
 	}
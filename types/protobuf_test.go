@@ -10,7 +10,9 @@ import (
 	amino "github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/version"
 )
@@ -18,8 +20,20 @@ import (
 func TestABCIPubKey(t *testing.T) {
 	pkEd := ed25519.GenPrivKey().PubKey()
 	pkSecp := secp256k1.GenPrivKey().PubKey()
+	pkBls := bls12381.GenPrivKey().PubKey()
 	testABCIPubKey(t, pkEd, ABCIPubKeyTypeEd25519)
 	testABCIPubKey(t, pkSecp, ABCIPubKeyTypeSecp256k1)
+	testABCIPubKey(t, pkBls, ABCIPubKeyTypeBls12381)
+	pkMulti := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{pkEd, pkSecp, pkBls})
+	testABCIPubKey(t, pkMulti, ABCIPubKeyTypeMultisigThreshold)
+
+	// threshold keys with too many keys are rejected
+	pks := make([]crypto.PubKey, MaxThresholdPubKeys+1)
+	for i := range pks {
+		pks[i] = ed25519.GenPrivKey().PubKey()
+	}
+	_, err := PB2TM.PubKey(TM2PB.PubKey(multisig.NewPubKeyMultisigThreshold(1, pks)))
+	assert.Error(t, err)
 }
 
 func testABCIPubKey(t *testing.T, pk crypto.PubKey, typeStr string) {
@@ -1,17 +1,64 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 )
 
+const (
+	// MaxThresholdPubKeys is the maximum number of keys in the threshold
+	// multisig key of a validator.
+	MaxThresholdPubKeys = 4
+)
+
 var (
-	// MaxSignatureSize is a maximum allowed signature size for the Proposal
-	// and Vote.
+	// maxSingleSignatureSize is the maximum size of the signature of a key
+	// which isn't a multisig key.
 	// XXX: secp256k1 does not have Size nor MaxSize defined.
-	MaxSignatureSize = tmmath.MaxInt(ed25519.SignatureSize, 64)
+	maxSingleSignatureSize = tmmath.MaxInt(ed25519.SignatureSize, bls12381.SignatureSize)
+
+	// MaxSignatureSize is a maximum allowed signature size for the Proposal
+	// and Vote: a multisignature of all the keys of a threshold multisig key.
+	MaxSignatureSize = maxMultisignatureSize(MaxThresholdPubKeys)
 )
 
+// maxMultisignatureSize returns the size of a multisignature of n keys with
+// signatures of the maximum size.
+func maxMultisignatureSize(n int) int {
+	mSig := multisig.NewMultisig(n)
+	for i := 0; i < n; i++ {
+		mSig.AddSignature(make([]byte, maxSingleSignatureSize), i)
+	}
+	return len(mSig.Marshal())
+}
+
+// ValidateThresholdPubKey returns an error if the threshold multisig key
+// can't be used by a validator, as its multisignatures could be bigger than
+// MaxSignatureSize.
+func ValidateThresholdPubKey(pubKey multisig.PubKeyMultisigThreshold) error {
+	if pubKey.K == 0 || int(pubKey.K) > len(pubKey.PubKeys) {
+		return fmt.Errorf("invalid threshold %d of %d keys", pubKey.K, len(pubKey.PubKeys))
+	}
+	if len(pubKey.PubKeys) > MaxThresholdPubKeys {
+		return fmt.Errorf("too many keys in threshold key: %d, max: %d",
+			len(pubKey.PubKeys), MaxThresholdPubKeys)
+	}
+	for i, pk := range pubKey.PubKeys {
+		switch pk.(type) {
+		case ed25519.PubKeyEd25519, sr25519.PubKeySr25519, secp256k1.PubKeySecp256k1, bls12381.PubKeyBls12381:
+		default:
+			return fmt.Errorf("unsupported key #%d in threshold key: %T", i, pk)
+		}
+	}
+	return nil
+}
+
 // Signable is an interface for all signable things.
 // It typically removes signatures before serializing.
 // SignBytes returns the bytes to be signed
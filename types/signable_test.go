@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+// thresholdMockPV signs with all the keys of a threshold multisig key.
+type thresholdMockPV struct {
+	privKeys []crypto.PrivKey
+	pubKey   multisig.PubKeyMultisigThreshold
+}
+
+func newThresholdMockPV(privKeys ...crypto.PrivKey) *thresholdMockPV {
+	pubKeys := make([]crypto.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+	pubKey := multisig.NewPubKeyMultisigThreshold(len(privKeys), pubKeys).(multisig.PubKeyMultisigThreshold)
+	return &thresholdMockPV{privKeys: privKeys, pubKey: pubKey}
+}
+
+// maxThresholdMockPV returns a thresholdMockPV whose signatures and key are
+// the biggest a validator can have.
+func maxThresholdMockPV() *thresholdMockPV {
+	privKeys := make([]crypto.PrivKey, MaxThresholdPubKeys)
+	for i := range privKeys {
+		privKeys[i] = bls12381.GenPrivKey()
+	}
+	return newThresholdMockPV(privKeys...)
+}
+
+func (pv *thresholdMockPV) GetPubKey() crypto.PubKey {
+	return pv.pubKey
+}
+
+func (pv *thresholdMockPV) sign(msg []byte) ([]byte, error) {
+	mSig := multisig.NewMultisig(len(pv.privKeys))
+	for i, privKey := range pv.privKeys {
+		sig, err := privKey.Sign(msg)
+		if err != nil {
+			return nil, err
+		}
+		mSig.AddSignature(sig, i)
+	}
+	return mSig.Marshal(), nil
+}
+
+func (pv *thresholdMockPV) SignVote(chainID string, vote *Vote) (err error) {
+	vote.Signature, err = pv.sign(vote.SignBytes(chainID))
+	return err
+}
+
+func (pv *thresholdMockPV) SignProposal(chainID string, proposal *Proposal) (err error) {
+	proposal.Signature, err = pv.sign(proposal.SignBytes(chainID))
+	return err
+}
+
+func TestMaxSignatureSize(t *testing.T) {
+	pv := maxThresholdMockPV()
+	vote := examplePrecommit()
+	require.NoError(t, pv.SignVote("test_chain_id", vote))
+	assert.Len(t, vote.Signature, MaxSignatureSize)
+	assert.True(t, pv.GetPubKey().VerifyBytes(vote.SignBytes("test_chain_id"), vote.Signature))
+	assert.NoError(t, vote.ValidateBasic())
+}
+
+func TestValidateThresholdPubKey(t *testing.T) {
+	pubKeys := []crypto.PubKey{
+		ed25519.GenPrivKey().PubKey(),
+		sr25519.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		bls12381.GenPrivKey().PubKey(),
+	}
+	nested := multisig.NewPubKeyMultisigThreshold(1, pubKeys[:2])
+
+	testCases := []struct {
+		pubKey    multisig.PubKeyMultisigThreshold
+		expectErr bool
+	}{
+		{multisig.PubKeyMultisigThreshold{K: 2, PubKeys: pubKeys}, false},
+		{multisig.PubKeyMultisigThreshold{K: 4, PubKeys: pubKeys}, false},
+		{multisig.PubKeyMultisigThreshold{K: 1, PubKeys: pubKeys[:1]}, false},
+		{multisig.PubKeyMultisigThreshold{K: 0, PubKeys: pubKeys}, true},
+		{multisig.PubKeyMultisigThreshold{K: 5, PubKeys: pubKeys}, true},
+		{multisig.PubKeyMultisigThreshold{K: 2, PubKeys: append(pubKeys, pubKeys[0])}, true},
+		{multisig.PubKeyMultisigThreshold{K: 2, PubKeys: []crypto.PubKey{pubKeys[0], nested}}, true},
+	}
+	for i, tc := range testCases {
+		err := ValidateThresholdPubKey(tc.pubKey)
+		assert.Equal(t, tc.expectErr, err != nil, "#%d: %v", i, err)
+	}
+}
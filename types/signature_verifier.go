@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/batch"
+)
+
+// sigVerifier verifies signatures in batches, one per key type supporting
+// batch verification, and the others one by one.
+type sigVerifier struct {
+	entries []sigEntry
+}
+
+type sigEntry struct {
+	id     int
+	pubKey crypto.PubKey
+	msg    []byte
+	sig    []byte
+}
+
+func (sv *sigVerifier) add(id int, pubKey crypto.PubKey, msg []byte, sig []byte) {
+	sv.entries = append(sv.entries, sigEntry{id: id, pubKey: pubKey, msg: msg, sig: sig})
+}
+
+// verify returns the ids of the invalid signatures, in the order they were
+// added.
+func (sv *sigVerifier) verify() []int {
+	var (
+		valid   = make([]bool, len(sv.entries))
+		batches = make(map[reflect.Type][]int) // key type -> entries
+	)
+	for i, entry := range sv.entries {
+		if batch.SupportsBatchVerifier(entry.pubKey) {
+			keyType := reflect.TypeOf(entry.pubKey)
+			batches[keyType] = append(batches[keyType], i)
+		} else {
+			valid[i] = entry.pubKey.VerifyBytes(entry.msg, entry.sig)
+		}
+	}
+
+	for _, batchEntries := range batches {
+		// A batch of one signature is slower to verify than the signature.
+		if len(batchEntries) == 1 {
+			entry := sv.entries[batchEntries[0]]
+			valid[batchEntries[0]] = entry.pubKey.VerifyBytes(entry.msg, entry.sig)
+			continue
+		}
+
+		bv, _ := batch.CreateBatchVerifier(sv.entries[batchEntries[0]].pubKey)
+		for _, i := range batchEntries {
+			entry := sv.entries[i]
+			if err := bv.Add(entry.pubKey, entry.msg, entry.sig); err != nil {
+				panic(err) // all the keys of the batch are of its type
+			}
+		}
+		_, batchValid := bv.Verify()
+		for j, i := range batchEntries {
+			valid[i] = batchValid[j]
+		}
+	}
+
+	var invalid []int
+	for i, entry := range sv.entries {
+		if !valid[i] {
+			invalid = append(invalid, entry.id)
+		}
+	}
+	return invalid
+}
+
+// commitVerifier verifies the signatures of a commit: the signatures which
+// are part of its aggregated signature at once, and the others in batches.
+type commitVerifier struct {
+	chainID    string
+	commit     *Commit
+	sigs       sigVerifier
+	aggregated aggregatedSigners
+}
+
+func newCommitVerifier(chainID string, commit *Commit) *commitVerifier {
+	return &commitVerifier{chainID: chainID, commit: commit}
+}
+
+// add adds the signature of the validator at idx in the commit.
+func (cv *commitVerifier) add(idx int, val *Validator) error {
+	commitSig := cv.commit.Signatures[idx]
+	if commitSig.Aggregated() {
+		return cv.addAggregated(idx, val)
+	}
+	cv.sigs.add(idx, val.PubKey, cv.commit.VoteSignBytes(cv.chainID, idx), commitSig.Signature)
+	return nil
+}
+
+// addAggregated adds the signature of the validator at idx in the commit,
+// which is part of the aggregated signature.
+func (cv *commitVerifier) addAggregated(idx int, val *Validator) error {
+	return cv.aggregated.add(idx, val, cv.commit.VoteSignBytes(cv.chainID, idx))
+}
+
+// verify verifies the signatures added, and returns an error for the first
+// invalid one.
+func (cv *commitVerifier) verify() error {
+	if invalid := cv.sigs.verify(); len(invalid) > 0 {
+		idx := invalid[0]
+		return fmt.Errorf("wrong signature (#%d): %X", idx, cv.commit.Signatures[idx].Signature)
+	}
+	return cv.aggregated.verify(cv.commit)
+}
@@ -66,6 +66,35 @@ func NewValidatorSet(valz []*Validator) *ValidatorSet {
 	return vals
 }
 
+// ValidatorSetFromExistingValidators initializes a ValidatorSet from an
+// existing, already ordered list of validators (e.g. as returned by the RPC
+// /validators endpoint), preserving their proposer priorities. Unlike
+// NewValidatorSet, proposer priorities are not incremented.
+func ValidatorSetFromExistingValidators(valz []*Validator) (*ValidatorSet, error) {
+	if len(valz) == 0 {
+		return nil, errors.New("validator set is empty")
+	}
+	for i, val := range valz {
+		switch {
+		case val == nil:
+			return nil, errors.Errorf("validator #%d is nil", i)
+		case val.PubKey == nil:
+			return nil, errors.Errorf("validator #%d has no public key", i)
+		case !bytes.Equal(val.Address, val.PubKey.Address()):
+			return nil, errors.Errorf("validator #%d address does not match its public key", i)
+		case val.VotingPower <= 0:
+			return nil, errors.Errorf("validator #%d has non-positive voting power", i)
+		}
+	}
+
+	vals := &ValidatorSet{
+		Validators: validatorListCopy(valz),
+	}
+	vals.Proposer = vals.findProposer()
+	vals.updateTotalVotingPower()
+	return vals, nil
+}
+
 // IsNilOrEmpty returns true if validator set is nil or empty.
 func (vals *ValidatorSet) IsNilOrEmpty() bool {
 	return vals == nil || len(vals.Validators) == 0
@@ -625,6 +654,8 @@ func (vals *ValidatorSet) UpdateWithChangeSet(changes []*Validator) error {
 }
 
 // VerifyCommit verifies +2/3 of the set had signed the given commit.
+// The signatures of keys supporting batch verification are verified in
+// batches, and one by one only to find the invalid one if a batch fails.
 func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	height int64, commit *Commit) error {
 
@@ -636,6 +667,7 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	}
 
 	talliedVotingPower := int64(0)
+	verifier := newCommitVerifier(chainID, commit)
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
 			continue // OK, some signatures can be absent.
@@ -645,10 +677,9 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 		// This means we don't need the validator address or to do any lookup.
 		val := vals.Validators[idx]
 
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, idx)
-		if !val.PubKey.VerifyBytes(voteSignBytes, commitSig.Signature) {
-			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+		// Add signature, verified with the others below.
+		if err := verifier.add(idx, val); err != nil {
+			return err
 		}
 		// Good!
 		if blockID.Equals(commitSig.BlockID(commit.BlockID)) {
@@ -659,6 +690,9 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 		// signatures (~votes for nil) to measure validator availability.
 		// }
 	}
+	if err := verifier.verify(); err != nil {
+		return err
+	}
 
 	if got, needed := talliedVotingPower, vals.TotalVotingPower()*2/3; got <= needed {
 		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
@@ -709,6 +743,7 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 	// Check old voting power.
 	oldVotingPower := int64(0)
 	seen := map[int]bool{}
+	verifier := newCommitVerifier(chainID, commit)
 
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
@@ -722,10 +757,14 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 		}
 		seen[oldIdx] = true
 
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, idx)
-		if !val.PubKey.VerifyBytes(voteSignBytes, commitSig.Signature) {
-			return errors.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+		// Add signature. The aggregated signature was verified with the keys
+		// of newSet, which must match.
+		if commitSig.Aggregated() {
+			if !val.PubKey.Equals(newSet.Validators[idx].PubKey) {
+				return errors.Errorf("wrong key for aggregated signature (#%d)", idx)
+			}
+		} else if err := verifier.add(idx, val); err != nil {
+			return err
 		}
 		// Good!
 		if blockID.Equals(commitSig.BlockID(commit.BlockID)) {
@@ -736,6 +775,9 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 		// signatures (~votes for nil) to measure validator availability.
 		// }
 	}
+	if err := verifier.verify(); err != nil {
+		return err
+	}
 
 	if got, needed := oldVotingPower, oldVals.TotalVotingPower()*2/3; got <= needed {
 		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
@@ -746,9 +788,25 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 // VerifyCommitTrusting verifies that trustLevel ([1/3, 1]) of the validator
 // set signed this commit.
 // NOTE the given validators do not necessarily correspond to the validator set
-// for this commit, but there may be some intersection.
+// for this commit, but there may be some intersection. The aggregated
+// signature of the commit can only be verified if all its signers are in the
+// validator set, see VerifyAggregatedCommitTrusting.
 func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 	height int64, commit *Commit, trustLevel tmmath.Fraction) error {
+	return vals.verifyCommitTrusting(chainID, blockID, height, commit, trustLevel, nil)
+}
+
+// VerifyAggregatedCommitTrusting is like VerifyCommitTrusting, except that
+// the aggregated signature of the commit is verified with the keys of
+// commitVals, the validator set for this commit, so it may include validators
+// which are not in the validator set.
+func (vals *ValidatorSet) VerifyAggregatedCommitTrusting(chainID string, blockID BlockID,
+	height int64, commit *Commit, trustLevel tmmath.Fraction, commitVals *ValidatorSet) error {
+	return vals.verifyCommitTrusting(chainID, blockID, height, commit, trustLevel, commitVals)
+}
+
+func (vals *ValidatorSet) verifyCommitTrusting(chainID string, blockID BlockID,
+	height int64, commit *Commit, trustLevel tmmath.Fraction, commitVals *ValidatorSet) error {
 
 	if trustLevel.Numerator*3 < trustLevel.Denominator || // < 1/3
 		trustLevel.Numerator > trustLevel.Denominator { // > 1
@@ -758,10 +816,14 @@ func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 	if err := verifyCommitBasic(commit, height, blockID); err != nil {
 		return err
 	}
+	if commitVals != nil && len(commit.AggregatedSignature) != 0 && commitVals.Size() != len(commit.Signatures) {
+		return NewErrInvalidCommitSignatures(commitVals.Size(), len(commit.Signatures))
+	}
 
 	var (
 		talliedVotingPower int64
 		seenVals           = make(map[int]int, len(commit.Signatures)) // validator index -> commit index
+		verifier           = newCommitVerifier(chainID, commit)
 	)
 
 	for idx, commitSig := range commit.Signatures {
@@ -778,15 +840,30 @@ func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 			return errors.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
 		}
 
+		// Add signature. The keys of the signers of the aggregated
+		// signature are taken from commitVals if given, and must match the
+		// known validators.
+		switch {
+		case commitSig.Aggregated() && commitVals != nil:
+			signer := commitVals.Validators[idx]
+			if val != nil && !val.PubKey.Equals(signer.PubKey) {
+				return errors.Errorf("wrong key for aggregated signature (#%d)", idx)
+			}
+			if err := verifier.addAggregated(idx, signer); err != nil {
+				return err
+			}
+		case val != nil:
+			if err := verifier.add(idx, val); err != nil {
+				return err
+			}
+		case commitSig.Aggregated():
+			return errors.Errorf("can't verify aggregated signature (#%d) of unknown validator %X",
+				idx, commitSig.ValidatorAddress)
+		}
+
 		if val != nil {
 			seenVals[valIdx] = idx
 
-			// Validate signature.
-			voteSignBytes := commit.VoteSignBytes(chainID, idx)
-			if !val.PubKey.VerifyBytes(voteSignBytes, commitSig.Signature) {
-				return errors.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
-			}
-
 			// Good!
 			if blockID.Equals(commitSig.BlockID(commit.BlockID)) {
 				talliedVotingPower += val.VotingPower
@@ -798,6 +875,10 @@ func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 		}
 	}
 
+	if err := verifier.verify(); err != nil {
+		return err
+	}
+
 	got := talliedVotingPower
 	needed := (vals.TotalVotingPower() * trustLevel.Numerator) / trustLevel.Denominator
 	if got <= needed {
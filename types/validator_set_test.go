@@ -10,9 +10,12 @@ import (
 	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -90,6 +93,25 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestValidatorSetFromExistingValidators(t *testing.T) {
+	vset := randValidatorSet(10)
+	vset.IncrementProposerPriority(5)
+
+	existing, err := ValidatorSetFromExistingValidators(vset.Validators)
+	require.NoError(t, err)
+	assert.Equal(t, vset.Hash(), existing.Hash())
+	assert.NotNil(t, existing.GetProposer())
+	assert.Equal(t, vset.TotalVotingPower(), existing.TotalVotingPower())
+	for i, val := range vset.Validators {
+		assert.Equal(t, val.ProposerPriority, existing.Validators[i].ProposerPriority)
+	}
+
+	_, err = ValidatorSetFromExistingValidators(nil)
+	assert.Error(t, err)
+	_, err = ValidatorSetFromExistingValidators([]*Validator{{VotingPower: 1}})
+	assert.Error(t, err)
+}
+
 // Test that IncrementProposerPriority requires positive times.
 func TestIncrementProposerPriorityPositiveTimes(t *testing.T) {
 	vset := NewValidatorSet([]*Validator{
@@ -640,6 +662,47 @@ func TestValidatorSetVerifyCommit(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestValidatorSetVerifyCommitBatch(t *testing.T) {
+	var (
+		chainID = "mychainID"
+		blockID = makeBlockIDRandom()
+		height  = int64(5)
+	)
+	// Keys verified in batches, and one by one.
+	privVals := []PrivValidator{
+		NewMockPV(),
+		NewMockPV(),
+		NewMockPV(),
+		NewMockPVWithParams(sr25519.GenPrivKey(), false, false),
+		NewMockPVWithParams(sr25519.GenPrivKey(), false, false),
+		NewMockPVWithParams(secp256k1.GenPrivKey(), false, false),
+	}
+	vals := make([]*Validator, len(privVals))
+	for i, privVal := range privVals {
+		vals[i] = NewValidator(privVal.GetPubKey(), 10)
+	}
+	valSet := NewValidatorSet(vals)
+	sort.Sort(PrivValidatorsByAddress(privVals))
+
+	voteSet := NewVoteSet(chainID, height, 0, PrecommitType, valSet)
+	commit, err := MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, height, commit))
+
+	// The first invalid signature of a batch is reported.
+	for idx := range commit.Signatures {
+		badCommit := *commit
+		badCommit.Signatures = append([]CommitSig{}, commit.Signatures...)
+		badCommit.Signatures[idx].Signature = append([]byte{}, commit.Signatures[idx].Signature...)
+		badCommit.Signatures[idx].Signature[7] ^= byte(0x01)
+
+		err := valSet.VerifyCommit(chainID, blockID, height, &badCommit)
+		if assert.Error(t, err, idx) {
+			assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", idx))
+		}
+	}
+}
+
 func TestEmptySet(t *testing.T) {
 
 	var valList []*Validator
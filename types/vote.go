@@ -11,9 +11,22 @@ import (
 )
 
 const (
-	// MaxVoteBytes is a maximum vote size (including amino overhead).
-	MaxVoteBytes int64  = 223
-	nilVoteStr   string = "nil-Vote"
+	// MaxVoteBytes is a maximum vote size (including amino overhead), not
+	// counting the vote extension.
+	MaxVoteBytes int64 = 255
+	// MaxThresholdVoteBytes is a maximum vote size (including amino overhead),
+	// not counting the vote extension, on chains which allow threshold multisig
+	// validator keys.
+	MaxThresholdVoteBytes int64 = 559
+
+	nilVoteStr string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of a vote extension.
+	MaxVoteExtensionSize = 4096
+	// maxVoteExtensionOverheadBytes is the maximum amino overhead of a vote
+	// extension in a commit: its field key and length, and the growth of the
+	// length of the commit sig.
+	maxVoteExtensionOverheadBytes int64 = 4
 )
 
 var (
@@ -24,6 +37,7 @@ var (
 	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
 	ErrVoteNil                       = errors.New("nil vote")
+	ErrVoteInvalidExtension          = errors.New("invalid vote extension")
 )
 
 type ErrVoteConflictingVotes struct {
@@ -54,6 +68,9 @@ type Vote struct {
 	ValidatorAddress Address       `json:"validator_address"`
 	ValidatorIndex   int           `json:"validator_index"`
 	Signature        []byte        `json:"signature"`
+	// Extension is application data attached to a precommit for a block. It is
+	// covered by the signature.
+	Extension []byte `json:"extension"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -77,6 +94,7 @@ func (vote *Vote) CommitSig() CommitSig {
 		ValidatorAddress: vote.ValidatorAddress,
 		Timestamp:        vote.Timestamp,
 		Signature:        vote.Signature,
+		Extension:        vote.Extension,
 	}
 }
 
@@ -132,6 +150,27 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	return nil
 }
 
+// VerifyVotes verifies each vote with the key at the same index, like Verify,
+// except that the signatures are verified in batches. It returns nil for the
+// valid votes, and the error of Verify for the others.
+func VerifyVotes(chainID string, votes []*Vote, pubKeys []crypto.PubKey) []error {
+	var (
+		errs     = make([]error, len(votes))
+		verifier sigVerifier
+	)
+	for i, vote := range votes {
+		if !bytes.Equal(pubKeys[i].Address(), vote.ValidatorAddress) {
+			errs[i] = ErrVoteInvalidValidatorAddress
+			continue
+		}
+		verifier.add(i, pubKeys[i], vote.SignBytes(chainID), vote.Signature)
+	}
+	for _, i := range verifier.verify() {
+		errs[i] = ErrVoteInvalidSignature
+	}
+	return errs
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -169,5 +208,13 @@ func (vote *Vote) ValidateBasic() error {
 	if len(vote.Signature) > MaxSignatureSize {
 		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 	}
+	if len(vote.Extension) > 0 {
+		if vote.Type != PrecommitType || vote.BlockID.IsZero() {
+			return errors.New("extension is only allowed on precommits for a block")
+		}
+		if len(vote.Extension) > MaxVoteExtensionSize {
+			return fmt.Errorf("extension is too big (max: %d)", MaxVoteExtensionSize)
+		}
+	}
 	return nil
 }
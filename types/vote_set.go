@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/bits"
 )
 
@@ -146,11 +147,24 @@ func (voteSet *VoteSet) AddVote(vote *Vote) (added bool, err error) {
 	voteSet.mtx.Lock()
 	defer voteSet.mtx.Unlock()
 
-	return voteSet.addVote(vote)
+	return voteSet.addVote(vote, nil)
+}
+
+// AddPreverifiedVote is like AddVote, except that the signature of the vote
+// isn't verified again if verifiedKey, the key it was already verified with
+// (e.g. by VerifyVotes), is the key of its validator.
+func (voteSet *VoteSet) AddPreverifiedVote(vote *Vote, verifiedKey crypto.PubKey) (added bool, err error) {
+	if voteSet == nil {
+		panic("AddPreverifiedVote() on nil VoteSet")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	return voteSet.addVote(vote, verifiedKey)
 }
 
 // NOTE: Validates as much as possible before attempting to verify the signature.
-func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
+func (voteSet *VoteSet) addVote(vote *Vote, verifiedKey crypto.PubKey) (added bool, err error) {
 	if vote == nil {
 		return false, ErrVoteNil
 	}
@@ -197,9 +211,11 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 		return false, errors.Wrapf(ErrVoteNonDeterministicSignature, "Existing vote: %v; New vote: %v", existing, vote)
 	}
 
-	// Check signature.
-	if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
-		return false, errors.Wrapf(err, "Failed to verify vote with ChainID %s and PubKey %s", voteSet.chainID, val.PubKey)
+	// Check signature, unless it was verified with the validator's key.
+	if verifiedKey == nil || !verifiedKey.Equals(val.PubKey) {
+		if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+			return false, errors.Wrapf(err, "Failed to verify vote with ChainID %s and PubKey %s", voteSet.chainID, val.PubKey)
+		}
 	}
 
 	// Add vote and get conflicting vote if any.
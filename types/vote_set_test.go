@@ -111,6 +111,40 @@ func TestAddVote(t *testing.T) {
 	}
 }
 
+func TestAddPreverifiedVote(t *testing.T) {
+	height, round := int64(1), 0
+	voteSet, _, privValidators := randVoteSet(height, round, PrevoteType, 10, 1)
+
+	newVote := func(idx int) *Vote {
+		addr := privValidators[idx].GetPubKey().Address()
+		vote := &Vote{
+			ValidatorAddress: addr,
+			ValidatorIndex:   idx,
+			Height:           height,
+			Round:            round,
+			Type:             PrevoteType,
+			Timestamp:        tmtime.Now(),
+			BlockID:          BlockID{nil, PartSetHeader{}},
+		}
+		vote.Signature = []byte("invalid")
+		return vote
+	}
+
+	// The signature isn't verified again with the validator's key...
+	added, err := voteSet.AddPreverifiedVote(newVote(0), privValidators[0].GetPubKey())
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	// ... but it is with another key.
+	added, err = voteSet.AddPreverifiedVote(newVote(1), privValidators[0].GetPubKey())
+	assert.Error(t, err)
+	assert.False(t, added)
+
+	added, err = voteSet.AddPreverifiedVote(newVote(1), nil)
+	assert.Error(t, err)
+	assert.False(t, added)
+}
+
 func Test2_3Majority(t *testing.T) {
 	height, round := int64(1), 0
 	voteSet, _, privValidators := randVoteSet(height, round, PrevoteType, 10, 1)
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
@@ -124,6 +125,24 @@ func TestVoteSignBytesTestVectors(t *testing.T) {
 				0x32,
 				0xd, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64}, // chainID
 		},
+		// containing an extension:
+		5: {
+			"", &Vote{Height: 1, Round: 1, Type: PrecommitType, Extension: []byte("ext")},
+			[]byte{
+				0x26,                                   // length
+				0x8,                                    // (field_number << 3) | wire_type
+				0x2,                                    // PrecommitType
+				0x11,                                   // (field_number << 3) | wire_type
+				0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, // height
+				0x19,                                   // (field_number << 3) | wire_type
+				0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, // round
+				// remaining fields:
+				0x2a,                                                                // (field_number << 3) | wire_type
+				0xb, 0x8, 0x80, 0x92, 0xb8, 0xc3, 0x98, 0xfe, 0xff, 0xff, 0xff, 0x1, // timestamp
+				// (field_number << 3) | wire_type
+				0x3a,
+				0x3, 0x65, 0x78, 0x74}, // extension
+		},
 	}
 	for i, tc := range tests {
 		got := tc.vote.SignBytes(tc.chainID)
@@ -209,6 +228,30 @@ func TestVoteVerify(t *testing.T) {
 	}
 }
 
+func TestVerifyVotes(t *testing.T) {
+	var (
+		votes   []*Vote
+		pubKeys []crypto.PubKey
+	)
+	for i := 0; i < 4; i++ {
+		privVal := NewMockPV()
+		pubKey := privVal.GetPubKey()
+		vote := examplePrecommit()
+		vote.ValidatorAddress = pubKey.Address()
+		require.NoError(t, privVal.SignVote("test_chain_id", vote))
+		votes = append(votes, vote)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	assert.Equal(t, []error{nil, nil, nil, nil}, VerifyVotes("test_chain_id", votes, pubKeys))
+
+	// A vote with a wrong signature, and one with the key of another validator.
+	votes[1].Signature[7] ^= byte(0x01)
+	pubKeys[3] = pubKeys[2]
+	assert.Equal(t,
+		[]error{nil, ErrVoteInvalidSignature, nil, ErrVoteInvalidValidatorAddress},
+		VerifyVotes("test_chain_id", votes, pubKeys))
+}
+
 func TestMaxVoteBytes(t *testing.T) {
 	// time is varint encoded so need to pick the max.
 	// year int, month Month, day, hour, min, sec, nsec int, loc *Location
@@ -230,14 +273,26 @@ func TestMaxVoteBytes(t *testing.T) {
 		},
 	}
 
-	privVal := NewMockPV()
-	err := privVal.SignVote("test_chain_id", vote)
-	require.NoError(t, err)
+	testCases := []struct {
+		name     string
+		privVal  PrivValidator
+		maxBytes int64
+	}{
+		// bls12381 keys have the longest signatures of single keys
+		{"single key", NewMockPVWithParams(bls12381.GenPrivKey(), false, false), MaxVoteBytes},
+		// and threshold keys have the longest ones when they are allowed
+		{"threshold key", maxThresholdMockPV(), MaxThresholdVoteBytes},
+	}
+	for _, tc := range testCases {
+		vote := vote.Copy()
+		err := tc.privVal.SignVote("test_chain_id", vote)
+		require.NoError(t, err)
 
-	bz, err := cdc.MarshalBinaryLengthPrefixed(vote)
-	require.NoError(t, err)
+		bz, err := cdc.MarshalBinaryLengthPrefixed(vote)
+		require.NoError(t, err)
 
-	assert.EqualValues(t, MaxVoteBytes, len(bz))
+		assert.EqualValues(t, tc.maxBytes, len(bz), tc.name)
+	}
 }
 
 func TestVoteString(t *testing.T) {
@@ -272,6 +327,16 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Extension", func(v *Vote) { v.Extension = []byte("ext") }, false},
+		{"Too big Extension", func(v *Vote) { v.Extension = make([]byte, MaxVoteExtensionSize+1) }, true},
+		{"Extension on Prevote", func(v *Vote) {
+			v.Type = PrevoteType
+			v.Extension = []byte("ext")
+		}, true},
+		{"Extension on nil Precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.Extension = []byte("ext")
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc